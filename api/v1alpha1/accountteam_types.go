@@ -0,0 +1,65 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AccountTeamSpec defines the desired state of AccountTeam
+type AccountTeamSpec struct {
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=36
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// The account the team belongs to
+	AccountID string `json:"accountId"`
+
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=128
+	// Team name. An existing team with the same name is adopted
+	TeamName string `json:"teamName"`
+
+	// Authentication reference to Aiven token in a secret
+	AuthSecretRef *AuthSecretReference `json:"authSecretRef,omitempty"`
+}
+
+// AccountTeamStatus defines the observed state of AccountTeam
+type AccountTeamStatus struct {
+	// Conditions represent the latest available observations of an AccountTeam state
+	Conditions []metav1.Condition `json:"conditions"`
+
+	// Team ID
+	ID string `json:"id,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// AccountTeam is the Schema for the accountteams API
+// +kubebuilder:printcolumn:name="Account",type="string",JSONPath=".spec.accountId"
+// +kubebuilder:printcolumn:name="Team Name",type="string",JSONPath=".spec.teamName"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.id"
+type AccountTeam struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AccountTeamSpec   `json:"spec,omitempty"`
+	Status AccountTeamStatus `json:"status,omitempty"`
+}
+
+func (in *AccountTeam) AuthSecretRef() *AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+// +kubebuilder:object:root=true
+
+// AccountTeamList contains a list of AccountTeam
+type AccountTeamList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccountTeam `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AccountTeam{}, &AccountTeamList{})
+}
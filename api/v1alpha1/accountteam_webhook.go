@@ -0,0 +1,51 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// log is for logging in this package.
+var accountteamlog = logf.Log.WithName("accountteam-resource")
+
+func (r *AccountTeam) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		WithDefaulter(newObjectDefaulter(mgr)).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-aiven-io-v1alpha1-accountteam,mutating=true,failurePolicy=fail,sideEffects=None,groups=aiven.io,resources=accountteams,verbs=create;update,versions=v1alpha1,name=maccountteam.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &AccountTeam{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *AccountTeam) Default() {
+	accountteamlog.Info("default", "name", r.Name)
+}
+
+//+kubebuilder:webhook:path=/validate-aiven-io-v1alpha1-accountteam,mutating=false,failurePolicy=fail,sideEffects=None,groups=aiven.io,resources=accountteams,verbs=create;update,versions=v1alpha1,name=vaccountteam.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &AccountTeam{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *AccountTeam) ValidateCreate() error {
+	accountteamlog.Info("validate create", "name", r.Name)
+	return nil
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *AccountTeam) ValidateUpdate(old runtime.Object) error {
+	accountteamlog.Info("validate update", "name", r.Name)
+	return nil
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *AccountTeam) ValidateDelete() error {
+	accountteamlog.Info("validate delete", "name", r.Name)
+	return nil
+}
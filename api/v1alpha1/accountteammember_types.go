@@ -0,0 +1,96 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// AccountTeamMemberStateInvited the invitation is not accepted yet
+	AccountTeamMemberStateInvited = "Invited"
+
+	// AccountTeamMemberStateMember the user is a team member
+	AccountTeamMemberStateMember = "Member"
+
+	// AccountTeamMemberStateDeclined the invitation is declined or expired, or the user is removed from the team
+	AccountTeamMemberStateDeclined = "Declined"
+)
+
+// AccountTeamMemberReinviteAnnotation invites the user again once set, then the annotation is removed
+const AccountTeamMemberReinviteAnnotation = "controllers.aiven.io/reinvite"
+
+// AccountTeamMemberSpec defines the desired state of AccountTeamMember
+type AccountTeamMemberSpec struct {
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// AccountTeam resource the user is invited to
+	TeamRef ResourceReference `json:"teamRef"`
+
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=254
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Email of the user to invite
+	UserEmail string `json:"userEmail"`
+
+	// Authentication reference to Aiven token in a secret
+	AuthSecretRef *AuthSecretReference `json:"authSecretRef,omitempty"`
+}
+
+// AccountTeamMemberStatus defines the observed state of AccountTeamMember
+type AccountTeamMemberStatus struct {
+	// Conditions represent the latest available observations of an AccountTeamMember state
+	Conditions []metav1.Condition `json:"conditions"`
+
+	// Account ID of the team
+	AccountID string `json:"accountId,omitempty"`
+
+	// Team ID
+	TeamID string `json:"teamId,omitempty"`
+
+	// Membership state: Invited until the user accepts the invitation, then Member.
+	// Declined if the invitation is declined or expired, or the user is removed from the team
+	State string `json:"state,omitempty"`
+
+	// User ID, once the invitation is accepted
+	UserID string `json:"userId,omitempty"`
+
+	// Email of the user who sent the pending invitation
+	InvitedBy string `json:"invitedBy,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// AccountTeamMember is the Schema for the accountteammembers API
+// +kubebuilder:printcolumn:name="Team",type="string",JSONPath=".spec.teamRef.name"
+// +kubebuilder:printcolumn:name="User Email",type="string",JSONPath=".spec.userEmail"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
+type AccountTeamMember struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AccountTeamMemberSpec   `json:"spec,omitempty"`
+	Status AccountTeamMemberStatus `json:"status,omitempty"`
+}
+
+func (in *AccountTeamMember) AuthSecretRef() *AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+// GetRefs returns the AccountTeam, it must exist before the user is invited
+func (in *AccountTeamMember) GetRefs() []*ResourceReferenceObject {
+	return []*ResourceReferenceObject{in.Spec.TeamRef.AccountTeam(in.Namespace)}
+}
+
+// +kubebuilder:object:root=true
+
+// AccountTeamMemberList contains a list of AccountTeamMember
+type AccountTeamMemberList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccountTeamMember `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AccountTeamMember{}, &AccountTeamMemberList{})
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// log is for logging in this package.
+var accountteammemberlog = logf.Log.WithName("accountteammember-resource")
+
+func (r *AccountTeamMember) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		WithDefaulter(newObjectDefaulter(mgr)).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-aiven-io-v1alpha1-accountteammember,mutating=true,failurePolicy=fail,sideEffects=None,groups=aiven.io,resources=accountteammembers,verbs=create;update,versions=v1alpha1,name=maccountteammember.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &AccountTeamMember{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *AccountTeamMember) Default() {
+	accountteammemberlog.Info("default", "name", r.Name)
+}
+
+//+kubebuilder:webhook:path=/validate-aiven-io-v1alpha1-accountteammember,mutating=false,failurePolicy=fail,sideEffects=None,groups=aiven.io,resources=accountteammembers,verbs=create;update,versions=v1alpha1,name=vaccountteammember.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &AccountTeamMember{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *AccountTeamMember) ValidateCreate() error {
+	accountteammemberlog.Info("validate create", "name", r.Name)
+	return nil
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *AccountTeamMember) ValidateUpdate(old runtime.Object) error {
+	accountteammemberlog.Info("validate update", "name", r.Name)
+	return nil
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *AccountTeamMember) ValidateDelete() error {
+	accountteammemberlog.Info("validate delete", "name", r.Name)
+	return nil
+}
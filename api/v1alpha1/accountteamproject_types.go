@@ -0,0 +1,76 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AccountTeamProjectSpec defines the desired state of AccountTeamProject
+type AccountTeamProjectSpec struct {
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// AccountTeam resource to give access to the project
+	TeamRef ResourceReference `json:"teamRef"`
+
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Format="^[a-zA-Z0-9_-]*$"
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Project name
+	Project string `json:"project"`
+
+	// +kubebuilder:validation:Enum=admin;developer;operator;read_only
+	// Team role in the project
+	TeamType string `json:"teamType"`
+
+	// Authentication reference to Aiven token in a secret
+	AuthSecretRef *AuthSecretReference `json:"authSecretRef,omitempty"`
+}
+
+// AccountTeamProjectStatus defines the observed state of AccountTeamProject
+type AccountTeamProjectStatus struct {
+	// Conditions represent the latest available observations of an AccountTeamProject state
+	Conditions []metav1.Condition `json:"conditions"`
+
+	// Account ID of the team
+	AccountID string `json:"accountId,omitempty"`
+
+	// Team ID
+	TeamID string `json:"teamId,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// AccountTeamProject is the Schema for the accountteamprojects API
+// +kubebuilder:printcolumn:name="Team",type="string",JSONPath=".spec.teamRef.name"
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
+// +kubebuilder:printcolumn:name="Team Type",type="string",JSONPath=".spec.teamType"
+type AccountTeamProject struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AccountTeamProjectSpec   `json:"spec,omitempty"`
+	Status AccountTeamProjectStatus `json:"status,omitempty"`
+}
+
+func (in *AccountTeamProject) AuthSecretRef() *AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+// GetRefs returns the AccountTeam, it must exist before the project is added
+func (in *AccountTeamProject) GetRefs() []*ResourceReferenceObject {
+	return []*ResourceReferenceObject{in.Spec.TeamRef.AccountTeam(in.Namespace)}
+}
+
+// +kubebuilder:object:root=true
+
+// AccountTeamProjectList contains a list of AccountTeamProject
+type AccountTeamProjectList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccountTeamProject `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AccountTeamProject{}, &AccountTeamProjectList{})
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// log is for logging in this package.
+var accountteamprojectlog = logf.Log.WithName("accountteamproject-resource")
+
+func (r *AccountTeamProject) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		WithDefaulter(newObjectDefaulter(mgr)).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-aiven-io-v1alpha1-accountteamproject,mutating=true,failurePolicy=fail,sideEffects=None,groups=aiven.io,resources=accountteamprojects,verbs=create;update,versions=v1alpha1,name=maccountteamproject.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &AccountTeamProject{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *AccountTeamProject) Default() {
+	accountteamprojectlog.Info("default", "name", r.Name)
+}
+
+//+kubebuilder:webhook:path=/validate-aiven-io-v1alpha1-accountteamproject,mutating=false,failurePolicy=fail,sideEffects=None,groups=aiven.io,resources=accountteamprojects,verbs=create;update,versions=v1alpha1,name=vaccountteamproject.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &AccountTeamProject{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *AccountTeamProject) ValidateCreate() error {
+	accountteamprojectlog.Info("validate create", "name", r.Name)
+	return nil
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *AccountTeamProject) ValidateUpdate(old runtime.Object) error {
+	accountteamprojectlog.Info("validate update", "name", r.Name)
+	return nil
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *AccountTeamProject) ValidateDelete() error {
+	accountteamprojectlog.Info("validate delete", "name", r.Name)
+	return nil
+}
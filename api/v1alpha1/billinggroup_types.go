@@ -0,0 +1,119 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BillingGroupSpec defines the desired state of BillingGroup
+type BillingGroupSpec struct {
+	// +kubebuilder:validation:MaxLength=128
+	// Billing group name, defaults to the resource name
+	Name string `json:"name,omitempty"`
+
+	// +kubebuilder:validation:MaxLength=36
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Account ID
+	AccountID string `json:"accountId,omitempty"`
+
+	// +kubebuilder:validation:MaxLength=64
+	// Credit card ID; The ID may be either last 4 digits of the card or the actual ID
+	CardID string `json:"cardId,omitempty"`
+
+	// +kubebuilder:validation:MaxLength=64
+	// EU VAT Identification Number
+	VatID string `json:"vatId,omitempty"`
+
+	// +kubebuilder:validation:Enum=AUD;CAD;CHF;DKK;EUR;GBP;NOK;SEK;USD
+	// Billing currency
+	BillingCurrency string `json:"billingCurrency,omitempty"`
+
+	// +kubebuilder:validation:MaxLength=1000
+	// Extra text to be included in all invoices, e.g. purchase order or cost center number
+	BillingExtraText string `json:"billingExtraText,omitempty"`
+
+	// +kubebuilder:validation:MaxItems=10
+	// Billing contact emails
+	BillingEmails []string `json:"billingEmails,omitempty"`
+
+	// +kubebuilder:validation:MaxLength=128
+	// Company name
+	Company string `json:"company,omitempty"`
+
+	// +kubebuilder:validation:MaxItems=3
+	// Address lines
+	AddressLines []string `json:"addressLines,omitempty"`
+
+	// +kubebuilder:validation:MinLength=2
+	// +kubebuilder:validation:MaxLength=2
+	// Two letter country code of the billing address
+	CountryCode string `json:"countryCode,omitempty"`
+
+	// +kubebuilder:validation:MaxLength=128
+	// City
+	City string `json:"city,omitempty"`
+
+	// +kubebuilder:validation:MaxLength=128
+	// State or province
+	State string `json:"state,omitempty"`
+
+	// +kubebuilder:validation:MaxLength=32
+	// Zip or postal code
+	ZipCode string `json:"zipCode,omitempty"`
+
+	// Authentication reference to Aiven token in a secret
+	AuthSecretRef *AuthSecretReference `json:"authSecretRef,omitempty"`
+}
+
+// BillingGroupStatus defines the observed state of BillingGroup
+type BillingGroupStatus struct {
+	// Conditions represent the latest available observations of an BillingGroup state
+	Conditions []metav1.Condition `json:"conditions"`
+
+	// Billing group ID
+	ID string `json:"id,omitempty"`
+
+	// Projects assigned to the billing group
+	Projects []string `json:"projects,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// BillingGroup is the Schema for the billinggroups API
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.id"
+// +kubebuilder:printcolumn:name="Currency",type="string",JSONPath=".spec.billingCurrency"
+// +kubebuilder:printcolumn:name="Projects",type="string",JSONPath=".status.projects"
+type BillingGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BillingGroupSpec   `json:"spec,omitempty"`
+	Status BillingGroupStatus `json:"status,omitempty"`
+}
+
+func (in *BillingGroup) AuthSecretRef() *AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+// GetBillingGroupName returns the billing group name on Aiven side
+func (in *BillingGroup) GetBillingGroupName() string {
+	if in.Spec.Name != "" {
+		return in.Spec.Name
+	}
+	return in.Name
+}
+
+// +kubebuilder:object:root=true
+
+// BillingGroupList contains a list of BillingGroup
+type BillingGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BillingGroup `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&BillingGroup{}, &BillingGroupList{})
+}
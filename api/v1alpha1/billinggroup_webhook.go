@@ -0,0 +1,51 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// log is for logging in this package.
+var billinggrouplog = logf.Log.WithName("billinggroup-resource")
+
+func (r *BillingGroup) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		WithDefaulter(newObjectDefaulter(mgr)).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-aiven-io-v1alpha1-billinggroup,mutating=true,failurePolicy=fail,sideEffects=None,groups=aiven.io,resources=billinggroups,verbs=create;update,versions=v1alpha1,name=mbillinggroup.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &BillingGroup{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *BillingGroup) Default() {
+	billinggrouplog.Info("default", "name", r.Name)
+}
+
+//+kubebuilder:webhook:path=/validate-aiven-io-v1alpha1-billinggroup,mutating=false,failurePolicy=fail,sideEffects=None,groups=aiven.io,resources=billinggroups,verbs=create;update,versions=v1alpha1,name=vbillinggroup.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &BillingGroup{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *BillingGroup) ValidateCreate() error {
+	billinggrouplog.Info("validate create", "name", r.Name)
+	return nil
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *BillingGroup) ValidateUpdate(old runtime.Object) error {
+	billinggrouplog.Info("validate update", "name", r.Name)
+	return nil
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *BillingGroup) ValidateDelete() error {
+	billinggrouplog.Info("validate delete", "name", r.Name)
+	return nil
+}
@@ -18,6 +18,9 @@ type CassandraSpec struct {
 	// The disk space of the service, possible values depend on the service type, the cloud provider and the project. Reducing will result in the service re-balancing.
 	DiskSpace string `json:"disk_space,omitempty"`
 
+	// Increases the disk space when the service is running out of it, never decreases it
+	AutoscaleDisk *AutoscaleDisk `json:"autoscaleDisk,omitempty"`
+
 	// Authentication reference to Aiven token in a secret
 	AuthSecretRef *AuthSecretReference `json:"authSecretRef,omitempty"`
 
@@ -51,6 +54,22 @@ func (in *Cassandra) GetRefs() []*ResourceReferenceObject {
 	return in.Spec.GetRefs(in.GetNamespace())
 }
 
+func (in *Cassandra) getServiceCommonSpec() *ServiceCommonSpec {
+	return &in.Spec.ServiceCommonSpec
+}
+
+func (in *Cassandra) getServiceType() string {
+	return "cassandra"
+}
+
+func (in *Cassandra) getDiskSpace() string {
+	return in.Spec.DiskSpace
+}
+
+func (in *Cassandra) getAutoscaleDisk() *AutoscaleDisk {
+	return in.Spec.AutoscaleDisk
+}
+
 //+kubebuilder:object:root=true
 
 // CassandraList contains a list of Cassandra
@@ -14,9 +14,11 @@ import (
 // log is for logging in this package.
 var cassandralog = logf.Log.WithName("cassandra-resource")
 
-func (in *Cassandra) SetupWebhookWithManager(mgr ctrl.Manager) error {
+func (in *Cassandra) SetupWebhookWithManager(mgr ctrl.Manager, catalog ServiceCatalog) error {
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(in).
+		WithDefaulter(newObjectDefaulter(mgr)).
+		WithValidator(newServiceValidator(catalog)).
 		Complete()
 }
 
@@ -26,6 +28,8 @@ var _ webhook.Defaulter = &Cassandra{}
 
 func (in *Cassandra) Default() {
 	cassandralog.Info("default", "name", in.Name)
+
+	in.Spec.ConnInfoSecretTarget.Name = secretTargetName(in, in.Spec.ConnInfoSecretTarget)
 }
 
 //+kubebuilder:webhook:verbs=create;update;delete,path=/validate-aiven-io-v1alpha1-cassandra,mutating=false,failurePolicy=fail,groups=aiven.io,resources=cassandras,versions=v1alpha1,name=vcassandra.kb.io,sideEffects=none,admissionReviewVersions=v1
@@ -47,7 +51,7 @@ func (in *Cassandra) ValidateUpdate(old runtime.Object) error {
 		return errors.New("cannot update a Cassandra service, project field is immutable and cannot be updated")
 	}
 
-	if in.Spec.ConnInfoSecretTarget.Name != old.(*Cassandra).Spec.ConnInfoSecretTarget.Name {
+	if secretTargetName(in, in.Spec.ConnInfoSecretTarget) != secretTargetName(old.(*Cassandra), old.(*Cassandra).Spec.ConnInfoSecretTarget) {
 		return errors.New("cannot update a Cassandra service, connInfoSecretTarget.name field is immutable and cannot be updated")
 	}
 
@@ -0,0 +1,227 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/docker/go-units"
+	"k8s.io/apimachinery/pkg/runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var cataloglog = logf.Log.WithName("service-catalog")
+
+// ServicePlan is a plan available for a service type in a project
+// +kubebuilder:object:generate=false
+type ServicePlan struct {
+	Name string
+
+	// Clouds the plan is available in
+	Clouds []string
+
+	// DiskSpaceMB is the default (and minimum) disk space
+	DiskSpaceMB int
+
+	// DiskSpaceCapMB is the maximum disk space, zero if the disk space can't be changed
+	DiskSpaceCapMB int
+
+	// DiskSpaceStepMB is the disk space increment
+	DiskSpaceStepMB int
+}
+
+// ServiceCatalog provides plans available for a service type in a project.
+// Returns no plans if the catalog is not available, then the validation is skipped.
+// +kubebuilder:object:generate=false
+type ServiceCatalog interface {
+	GetServicePlans(ctx context.Context, namespace string, auth *AuthSecretReference, project, serviceType string) ([]ServicePlan, error)
+}
+
+var _ admission.CustomValidator = &serviceValidator{}
+
+// serviceValidator runs the kind's validation (Validate* methods)
+// and validates the plan, cloud and disk space against the catalog
+type serviceValidator struct {
+	catalog ServiceCatalog
+}
+
+func newServiceValidator(catalog ServiceCatalog) *serviceValidator {
+	return &serviceValidator{catalog: catalog}
+}
+
+// ValidateCreate implements admission.CustomValidator
+func (v *serviceValidator) ValidateCreate(ctx context.Context, obj runtime.Object) error {
+	if err := obj.(webhook.Validator).ValidateCreate(); err != nil {
+		return err
+	}
+
+	o := obj.(serviceObject)
+	if a := o.getAutoscaleDisk(); a != nil {
+		if err := a.Validate(o.getDiskSpace()); err != nil {
+			return err
+		}
+	}
+	return v.validatePlan(ctx, o)
+}
+
+// ValidateUpdate implements admission.CustomValidator
+func (v *serviceValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) error {
+	if err := newObj.(webhook.Validator).ValidateUpdate(oldObj); err != nil {
+		return err
+	}
+
+	// Validates only changed fields, so plans that are no longer available don't block other updates
+	o, n := oldObj.(serviceObject), newObj.(serviceObject)
+	if a := n.getAutoscaleDisk(); a != nil {
+		if err := a.Validate(n.getDiskSpace()); err != nil {
+			return err
+		}
+	}
+
+	oldSpec, newSpec := o.getServiceCommonSpec(), n.getServiceCommonSpec()
+	if oldSpec.Plan == newSpec.Plan && oldSpec.CloudName == newSpec.CloudName && o.getDiskSpace() == n.getDiskSpace() {
+		return nil
+	}
+	return v.validatePlan(ctx, n)
+}
+
+// ValidateDelete implements admission.CustomValidator
+func (v *serviceValidator) ValidateDelete(_ context.Context, obj runtime.Object) error {
+	return obj.(webhook.Validator).ValidateDelete()
+}
+
+func (v *serviceValidator) validatePlan(ctx context.Context, o serviceObject) error {
+	if v.catalog == nil {
+		return nil
+	}
+
+	spec := o.getServiceCommonSpec()
+	plans, err := v.catalog.GetServicePlans(ctx, o.GetNamespace(), o.AuthSecretRef(), spec.Project, o.getServiceType())
+	if err != nil {
+		// The catalog is optional, the API validates the request anyway
+		cataloglog.Info("unable to get service plans, skipping validation", "name", o.GetName(), "error", err.Error())
+		return nil
+	}
+	return validateServicePlan(plans, o.getServiceType(), spec.Plan, spec.CloudName, o.getDiskSpace())
+}
+
+// validateServicePlan validates the plan, cloud and disk space and suggests the closest valid values
+func validateServicePlan(plans []ServicePlan, serviceType, planName, cloudName, diskSpace string) error {
+	if len(plans) == 0 {
+		return nil
+	}
+
+	var plan *ServicePlan
+	names := make([]string, 0, len(plans))
+	for i := range plans {
+		names = append(names, plans[i].Name)
+		if plans[i].Name == planName {
+			plan = &plans[i]
+		}
+	}
+
+	if plan == nil {
+		return fmt.Errorf("plan %q is not available for service type %q%s", planName, serviceType, suggest(planName, names))
+	}
+
+	if cloudName != "" && !contains(plan.Clouds, cloudName) {
+		return fmt.Errorf("plan %q is not available in cloud %q%s", planName, cloudName, suggest(cloudName, plan.Clouds))
+	}
+
+	mb := ConvertDiscSpace(diskSpace)
+	if mb == 0 {
+		return nil
+	}
+
+	if plan.DiskSpaceCapMB == 0 {
+		return fmt.Errorf("plan %q doesn't support custom disk_space", planName)
+	}
+
+	if mb < plan.DiskSpaceMB || mb > plan.DiskSpaceCapMB {
+		return fmt.Errorf(
+			"disk_space %s is out of range for plan %q, must be between %s and %s",
+			diskSpace, planName, FormatDiskSpace(plan.DiskSpaceMB), FormatDiskSpace(plan.DiskSpaceCapMB),
+		)
+	}
+
+	if step := plan.DiskSpaceStepMB; step > 0 && (mb-plan.DiskSpaceMB)%step != 0 {
+		lower := mb - (mb-plan.DiskSpaceMB)%step
+		return fmt.Errorf(
+			"disk_space %s must be increased in steps of %s for plan %q, did you mean %s?",
+			diskSpace, FormatDiskSpace(step), planName, FormatDiskSpace(lower),
+		)
+	}
+	return nil
+}
+
+// suggest returns a hint with the closest option to the given value
+func suggest(value string, options []string) string {
+	if len(options) == 0 {
+		return ""
+	}
+
+	sorted := append([]string(nil), options...)
+	sort.Strings(sorted)
+
+	closest := sorted[0]
+	for _, o := range sorted[1:] {
+		if levenshtein(value, o) < levenshtein(value, closest) {
+			closest = o
+		}
+	}
+	return fmt.Sprintf(", did you mean %q? Available: %s", closest, strings.Join(sorted, ", "))
+}
+
+// levenshtein returns the edit distance between two strings
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		cur := make([]int, len(b)+1)
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = minInt(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev = cur
+	}
+	return prev[len(b)]
+}
+
+func minInt(values ...int) int {
+	m := values[0]
+	for _, v := range values[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
+
+// FormatDiskSpace formats megabytes like disk_space field, the reverse of ConvertDiscSpace
+func FormatDiskSpace(mb int) string {
+	if mb%(units.GiB/units.MiB) == 0 {
+		return fmt.Sprintf("%dGiB", mb/(units.GiB/units.MiB))
+	}
+	return fmt.Sprintf("%dMiB", mb)
+}
+
+func contains(list []string, v string) bool {
+	for _, s := range list {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
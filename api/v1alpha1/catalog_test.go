@@ -0,0 +1,108 @@
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestValidateServicePlan(t *testing.T) {
+	plans := []ServicePlan{
+		{
+			Name:            "business-4",
+			Clouds:          []string{"aws-eu-west-1", "google-europe-west1"},
+			DiskSpaceMB:     614400,
+			DiskSpaceCapMB:  1843200,
+			DiskSpaceStepMB: 30720,
+		},
+		{
+			Name:        "startup-2",
+			Clouds:      []string{"google-europe-west1"},
+			DiskSpaceMB: 92160,
+		},
+	}
+
+	cases := []struct {
+		name      string
+		plans     []ServicePlan
+		plan      string
+		cloud     string
+		diskSpace string
+		expected  string
+	}{
+		{
+			name:  "catalog is not available",
+			plans: nil,
+			plan:  "foo",
+		},
+		{
+			name:      "valid",
+			plans:     plans,
+			plan:      "business-4",
+			cloud:     "google-europe-west1",
+			diskSpace: "630GiB",
+		},
+		{
+			name:  "empty cloud",
+			plans: plans,
+			plan:  "startup-2",
+		},
+		{
+			name:     "unknown plan",
+			plans:    plans,
+			plan:     "busines-4",
+			expected: `plan "busines-4" is not available for service type "kafka", did you mean "business-4"? Available: business-4, startup-2`,
+		},
+		{
+			name:     "unknown cloud",
+			plans:    plans,
+			plan:     "business-4",
+			cloud:    "google-europe-west2",
+			expected: `plan "business-4" is not available in cloud "google-europe-west2", did you mean "google-europe-west1"? Available: aws-eu-west-1, google-europe-west1`,
+		},
+		{
+			name:      "disk space is not supported",
+			plans:     plans,
+			plan:      "startup-2",
+			diskSpace: "100GiB",
+			expected:  `plan "startup-2" doesn't support custom disk_space`,
+		},
+		{
+			name:      "disk space is out of range",
+			plans:     plans,
+			plan:      "business-4",
+			diskSpace: "2000GiB",
+			expected:  `disk_space 2000GiB is out of range for plan "business-4", must be between 600GiB and 1800GiB`,
+		},
+		{
+			name:      "disk space step",
+			plans:     plans,
+			plan:      "business-4",
+			diskSpace: "640GiB",
+			expected:  `disk_space 640GiB must be increased in steps of 30GiB for plan "business-4", did you mean 630GiB?`,
+		},
+	}
+
+	for _, opt := range cases {
+		t.Run(opt.name, func(t *testing.T) {
+			err := validateServicePlan(opt.plans, "kafka", opt.plan, opt.cloud, opt.diskSpace)
+			if opt.expected == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, opt.expected)
+			}
+		})
+	}
+}
+
+func TestAutoscaleDiskValidate(t *testing.T) {
+	a := &AutoscaleDisk{MaxDiskSpace: "600GiB"}
+	assert.NoError(t, a.Validate("600GiB"))
+	assert.NoError(t, a.Validate(""))
+	assert.EqualError(t, a.Validate("630GiB"), "autoscaleDisk.maxDiskSpace 600GiB must not be less than disk_space 630GiB")
+
+	a.Cooldown = &metav1.Duration{Duration: -time.Minute}
+	assert.EqualError(t, a.Validate(""), "autoscaleDisk.cooldown must not be negative")
+}
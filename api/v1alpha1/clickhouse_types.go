@@ -16,6 +16,9 @@ type ClickhouseSpec struct {
 	// The disk space of the service, possible values depend on the service type, the cloud provider and the project. Reducing will result in the service re-balancing.
 	DiskSpace string `json:"disk_space,omitempty"`
 
+	// Increases the disk space when the service is running out of it, never decreases it
+	AutoscaleDisk *AutoscaleDisk `json:"autoscaleDisk,omitempty"`
+
 	// Authentication reference to Aiven token in a secret
 	AuthSecretRef *AuthSecretReference `json:"authSecretRef,omitempty"`
 
@@ -55,6 +58,22 @@ func (in *Clickhouse) GetRefs() []*ResourceReferenceObject {
 	return in.Spec.GetRefs(in.GetNamespace())
 }
 
+func (in *Clickhouse) getServiceCommonSpec() *ServiceCommonSpec {
+	return &in.Spec.ServiceCommonSpec
+}
+
+func (in *Clickhouse) getServiceType() string {
+	return "clickhouse"
+}
+
+func (in *Clickhouse) getDiskSpace() string {
+	return in.Spec.DiskSpace
+}
+
+func (in *Clickhouse) getAutoscaleDisk() *AutoscaleDisk {
+	return in.Spec.AutoscaleDisk
+}
+
 func init() {
 	SchemeBuilder.Register(&Clickhouse{}, &ClickhouseList{})
 }
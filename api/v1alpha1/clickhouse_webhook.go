@@ -14,9 +14,11 @@ import (
 // log is for logging in this package.
 var clickhouselog = logf.Log.WithName("clickhouse-resource")
 
-func (r *Clickhouse) SetupWebhookWithManager(mgr ctrl.Manager) error {
+func (r *Clickhouse) SetupWebhookWithManager(mgr ctrl.Manager, catalog ServiceCatalog) error {
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(r).
+		WithDefaulter(newObjectDefaulter(mgr)).
+		WithValidator(newServiceValidator(catalog)).
 		Complete()
 }
 
@@ -27,6 +29,8 @@ var _ webhook.Defaulter = &Clickhouse{}
 // Default implements webhook.Defaulter so a webhook will be registered for the type
 func (r *Clickhouse) Default() {
 	clickhouselog.Info("default", "name", r.Name)
+
+	r.Spec.ConnInfoSecretTarget.Name = secretTargetName(r, r.Spec.ConnInfoSecretTarget)
 }
 
 //+kubebuilder:webhook:verbs=create;update;delete,path=/validate-aiven-io-v1alpha1-clickhouse,mutating=false,failurePolicy=fail,groups=aiven.io,resources=clickhouses,versions=v1alpha1,name=vclickhouse.kb.io,sideEffects=none,admissionReviewVersions=v1
@@ -37,6 +41,10 @@ var _ webhook.Validator = &Clickhouse{}
 func (r *Clickhouse) ValidateCreate() error {
 	clickhouselog.Info("validate create", "name", r.Name)
 
+	if len(r.Spec.StaticIPRefs) > 0 {
+		return errors.New("Clickhouse service doesn't support static IPs")
+	}
+
 	return r.Spec.Validate()
 }
 
@@ -48,10 +56,14 @@ func (r *Clickhouse) ValidateUpdate(old runtime.Object) error {
 		return errors.New("cannot update a Clickhouse service, project field is immutable and cannot be updated")
 	}
 
-	if r.Spec.ConnInfoSecretTarget.Name != old.(*Clickhouse).Spec.ConnInfoSecretTarget.Name {
+	if secretTargetName(r, r.Spec.ConnInfoSecretTarget) != secretTargetName(old.(*Clickhouse), old.(*Clickhouse).Spec.ConnInfoSecretTarget) {
 		return errors.New("cannot update a Clickhouse service, connInfoSecretTarget.name field is immutable and cannot be updated")
 	}
 
+	if len(r.Spec.StaticIPRefs) > 0 {
+		return errors.New("Clickhouse service doesn't support static IPs")
+	}
+
 	return r.Spec.Validate()
 }
 
@@ -0,0 +1,103 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClickhouseDatabaseSpec defines the desired state of ClickhouseDatabase
+type ClickhouseDatabaseSpec struct {
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Format="^[a-zA-Z0-9_-]*$"
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Project to link the database to
+	Project string `json:"project"`
+
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// ClickHouse service to link the database to
+	ServiceName string `json:"serviceName"`
+
+	// Clickhouse resource of the service, the database is created once it is running
+	ServiceRef *ResourceReference `json:"serviceRef,omitempty"`
+
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=128
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Database name. If not set, metadata.name is used.
+	// Supports characters that are not allowed in metadata.name
+	DatabaseName string `json:"databaseName,omitempty"`
+
+	// It is a Kubernetes side deletion protections, which prevents the database
+	// from being deleted by Kubernetes. It is recommended to enable this for any production
+	// databases containing critical data.
+	TerminationProtection *bool `json:"terminationProtection,omitempty"`
+
+	// Authentication reference to Aiven token in a secret
+	AuthSecretRef *AuthSecretReference `json:"authSecretRef,omitempty"`
+}
+
+// ClickhouseDatabaseStatus defines the observed state of ClickhouseDatabase
+type ClickhouseDatabaseStatus struct {
+	// Conditions represent the latest available observations of an ClickhouseDatabase state
+	Conditions []metav1.Condition `json:"conditions"`
+
+	// Database engine
+	Engine string `json:"engine,omitempty"`
+
+	// The database is created by a service integration (e.g. Kafka or PostgreSQL) and can't be deleted
+	ManagedByIntegration bool `json:"managedByIntegration,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// ClickhouseDatabase is the Schema for the clickhousedatabases API
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
+// +kubebuilder:printcolumn:name="Service Name",type="string",JSONPath=".spec.serviceName"
+// +kubebuilder:printcolumn:name="Database",type="string",JSONPath=".spec.databaseName"
+// +kubebuilder:printcolumn:name="Integration",type="boolean",JSONPath=".status.managedByIntegration"
+type ClickhouseDatabase struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClickhouseDatabaseSpec   `json:"spec,omitempty"`
+	Status ClickhouseDatabaseStatus `json:"status,omitempty"`
+}
+
+func (in *ClickhouseDatabase) AuthSecretRef() *AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *ClickhouseDatabase) GetRefs() []*ResourceReferenceObject {
+	if in.Spec.ServiceRef == nil {
+		return nil
+	}
+	return []*ResourceReferenceObject{in.Spec.ServiceRef.Clickhouse(in.Namespace)}
+}
+
+// GetDatabaseName returns the database name, metadata.name is used if not set
+func (in *ClickhouseDatabase) GetDatabaseName() string {
+	if in.Spec.DatabaseName != "" {
+		return in.Spec.DatabaseName
+	}
+	return in.Name
+}
+
+func (in *ClickhouseDatabase) getTerminationProtection() **bool {
+	return &in.Spec.TerminationProtection
+}
+
+//+kubebuilder:object:root=true
+
+// ClickhouseDatabaseList contains a list of ClickhouseDatabase
+type ClickhouseDatabaseList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClickhouseDatabase `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClickhouseDatabase{}, &ClickhouseDatabaseList{})
+}
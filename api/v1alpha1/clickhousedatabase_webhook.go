@@ -0,0 +1,70 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// log is for logging in this package.
+var clickhousedatabaselog = logf.Log.WithName("clickhousedatabase-resource")
+
+func (r *ClickhouseDatabase) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		WithDefaulter(newObjectDefaulter(mgr)).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-aiven-io-v1alpha1-clickhousedatabase,mutating=true,failurePolicy=fail,sideEffects=None,groups=aiven.io,resources=clickhousedatabases,verbs=create;update,versions=v1alpha1,name=mclickhousedatabase.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &ClickhouseDatabase{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *ClickhouseDatabase) Default() {
+	clickhousedatabaselog.Info("default", "name", r.Name)
+
+	if r.Spec.DatabaseName == "" {
+		r.Spec.DatabaseName = r.Name
+	}
+}
+
+//+kubebuilder:webhook:path=/validate-aiven-io-v1alpha1-clickhousedatabase,mutating=false,failurePolicy=fail,sideEffects=None,groups=aiven.io,resources=clickhousedatabases,verbs=create;update;delete,versions=v1alpha1,name=vclickhousedatabase.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &ClickhouseDatabase{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *ClickhouseDatabase) ValidateCreate() error {
+	clickhousedatabaselog.Info("validate create", "name", r.Name)
+	return r.validateServiceRef()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *ClickhouseDatabase) ValidateUpdate(old runtime.Object) error {
+	clickhousedatabaselog.Info("validate update", "name", r.Name)
+	return r.validateServiceRef()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *ClickhouseDatabase) ValidateDelete() error {
+	clickhousedatabaselog.Info("validate delete", "name", r.Name)
+
+	if r.Spec.TerminationProtection != nil && *r.Spec.TerminationProtection {
+		return errors.New("cannot delete ClickhouseDatabase, termination protection is on")
+	}
+	return nil
+}
+
+// validateServiceRef checks serviceRef points to the service from serviceName
+func (r *ClickhouseDatabase) validateServiceRef() error {
+	if r.Spec.ServiceRef != nil && r.Spec.ServiceRef.Name != r.Spec.ServiceName {
+		return fmt.Errorf("serviceRef %q doesn't match serviceName %q", r.Spec.ServiceRef.Name, r.Spec.ServiceName)
+	}
+	return nil
+}
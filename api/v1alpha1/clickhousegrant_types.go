@@ -0,0 +1,134 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClickhouseGrantSpec defines the desired state of ClickhouseGrant
+type ClickhouseGrantSpec struct {
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Format="^[a-zA-Z0-9_-]*$"
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Project to link the grant to
+	Project string `json:"project"`
+
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Service to link the grant to
+	ServiceName string `json:"serviceName"`
+
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// User or role the privileges and roles are granted to.
+	// The grantee must be managed by a single ClickhouseGrant, other privileges are revoked
+	Grantee ClickhouseGrantee `json:"grantee"`
+
+	// Privileges granted on databases and tables
+	PrivilegeGrants []ClickhousePrivilegeGrant `json:"privilegeGrants,omitempty"`
+
+	// Roles granted
+	RoleGrants []ClickhouseRoleGrant `json:"roleGrants,omitempty"`
+
+	// Authentication reference to Aiven token in a secret
+	AuthSecretRef *AuthSecretReference `json:"authSecretRef,omitempty"`
+}
+
+// ClickhouseGrantee references either ClickhouseUser or ClickhouseRole
+type ClickhouseGrantee struct {
+	// ClickhouseUser reference
+	UserRef *ResourceReference `json:"userRef,omitempty"`
+
+	// ClickhouseRole reference
+	RoleRef *ResourceReference `json:"roleRef,omitempty"`
+}
+
+// ClickhousePrivilegeGrant grants a privilege on a database or table
+type ClickhousePrivilegeGrant struct {
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Pattern="^[a-zA-Z ]+$"
+	// Privilege, for instance `SELECT`, `INSERT` or `ALTER UPDATE`
+	Privilege string `json:"privilege"`
+
+	// +kubebuilder:validation:MaxLength=255
+	// Database name, all databases if not set
+	Database string `json:"database,omitempty"`
+
+	// +kubebuilder:validation:MaxLength=255
+	// Table name, all tables if not set
+	Table string `json:"table,omitempty"`
+
+	// Allows the grantee to grant the privilege to others
+	WithGrantOption bool `json:"withGrantOption,omitempty"`
+}
+
+// ClickhouseRoleGrant grants a role
+type ClickhouseRoleGrant struct {
+	// ClickhouseRole reference
+	RoleRef ResourceReference `json:"roleRef"`
+
+	// Allows the grantee to grant the role to others
+	WithAdminOption bool `json:"withAdminOption,omitempty"`
+}
+
+// ClickhouseGrantStatus defines the observed state of ClickhouseGrant
+type ClickhouseGrantStatus struct {
+	// Conditions represent the latest available observations of an ClickhouseGrant state
+	Conditions []metav1.Condition `json:"conditions"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// ClickhouseGrant is the Schema for the clickhousegrants API
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
+// +kubebuilder:printcolumn:name="Service Name",type="string",JSONPath=".spec.serviceName"
+// +kubebuilder:printcolumn:name="User",type="string",JSONPath=".spec.grantee.userRef.name"
+// +kubebuilder:printcolumn:name="Role",type="string",JSONPath=".spec.grantee.roleRef.name"
+type ClickhouseGrant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClickhouseGrantSpec   `json:"spec,omitempty"`
+	Status ClickhouseGrantStatus `json:"status,omitempty"`
+}
+
+func (in *ClickhouseGrant) AuthSecretRef() *AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+// GetRefs returns the grantee and granted roles, they must be running before grants are applied
+func (in *ClickhouseGrant) GetRefs() []*ResourceReferenceObject {
+	refs := make([]*ResourceReferenceObject, 0)
+	if ref := in.Spec.Grantee.GetRef(in.Namespace); ref != nil {
+		refs = append(refs, ref)
+	}
+	for i := range in.Spec.RoleGrants {
+		refs = append(refs, in.Spec.RoleGrants[i].RoleRef.ClickhouseRole(in.Namespace))
+	}
+	return refs
+}
+
+// GetRef returns the grantee reference
+func (in *ClickhouseGrantee) GetRef(objNamespace string) *ResourceReferenceObject {
+	switch {
+	case in.UserRef != nil:
+		return in.UserRef.ClickhouseUser(objNamespace)
+	case in.RoleRef != nil:
+		return in.RoleRef.ClickhouseRole(objNamespace)
+	}
+	return nil
+}
+
+//+kubebuilder:object:root=true
+
+// ClickhouseGrantList contains a list of ClickhouseGrant
+type ClickhouseGrantList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClickhouseGrant `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClickhouseGrant{}, &ClickhouseGrantList{})
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// log is for logging in this package.
+var clickhousegrantlog = logf.Log.WithName("clickhousegrant-resource")
+
+func (r *ClickhouseGrant) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		WithDefaulter(newObjectDefaulter(mgr)).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-aiven-io-v1alpha1-clickhousegrant,mutating=true,failurePolicy=fail,sideEffects=None,groups=aiven.io,resources=clickhousegrants,verbs=create;update,versions=v1alpha1,name=mclickhousegrant.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &ClickhouseGrant{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *ClickhouseGrant) Default() {
+	clickhousegrantlog.Info("default", "name", r.Name)
+}
+
+//+kubebuilder:webhook:path=/validate-aiven-io-v1alpha1-clickhousegrant,mutating=false,failurePolicy=fail,sideEffects=None,groups=aiven.io,resources=clickhousegrants,verbs=create;update,versions=v1alpha1,name=vclickhousegrant.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &ClickhouseGrant{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *ClickhouseGrant) ValidateCreate() error {
+	clickhousegrantlog.Info("validate create", "name", r.Name)
+	return r.validateGrants()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *ClickhouseGrant) ValidateUpdate(old runtime.Object) error {
+	clickhousegrantlog.Info("validate update", "name", r.Name)
+	return r.validateGrants()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *ClickhouseGrant) ValidateDelete() error {
+	clickhousegrantlog.Info("validate delete", "name", r.Name)
+	return nil
+}
+
+// validateGrants checks the grantee is set and privileges and roles are not granted twice
+func (r *ClickhouseGrant) validateGrants() error {
+	if (r.Spec.Grantee.UserRef == nil) == (r.Spec.Grantee.RoleRef == nil) {
+		return fmt.Errorf("grantee must have exactly one of userRef or roleRef")
+	}
+
+	privileges := make(map[string]bool)
+	for _, p := range r.Spec.PrivilegeGrants {
+		if p.Table != "" && p.Database == "" {
+			return fmt.Errorf("privilege %q on table %q must have the database set", p.Privilege, p.Table)
+		}
+
+		key := strings.ToUpper(strings.Join(strings.Fields(p.Privilege), " ")) + "/" + p.Database + "/" + p.Table
+		if privileges[key] {
+			return fmt.Errorf("privilege %q on %q is granted more than once", p.Privilege, strings.Trim(p.Database+"."+p.Table, "."))
+		}
+		privileges[key] = true
+	}
+
+	roles := make(map[string]bool)
+	for _, g := range r.Spec.RoleGrants {
+		ref := g.RoleRef.ClickhouseRole(r.Namespace).NamespacedName.String()
+		if roles[ref] {
+			return fmt.Errorf("role %q is granted more than once", g.RoleRef.Name)
+		}
+		roles[ref] = true
+
+		if r.Spec.Grantee.RoleRef != nil && r.Spec.Grantee.GetRef(r.Namespace).NamespacedName.String() == ref {
+			return fmt.Errorf("role %q can't be granted to itself", g.RoleRef.Name)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// log is for logging in this package.
+var clickhouserolelog = logf.Log.WithName("clickhouserole-resource")
+
+func (r *ClickhouseRole) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		WithDefaulter(newObjectDefaulter(mgr)).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-aiven-io-v1alpha1-clickhouserole,mutating=true,failurePolicy=fail,sideEffects=None,groups=aiven.io,resources=clickhouseroles,verbs=create;update,versions=v1alpha1,name=mclickhouserole.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &ClickhouseRole{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *ClickhouseRole) Default() {
+	clickhouserolelog.Info("default", "name", r.Name)
+}
+
+//+kubebuilder:webhook:path=/validate-aiven-io-v1alpha1-clickhouserole,mutating=false,failurePolicy=fail,sideEffects=None,groups=aiven.io,resources=clickhouseroles,verbs=create;update,versions=v1alpha1,name=vclickhouserole.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &ClickhouseRole{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *ClickhouseRole) ValidateCreate() error {
+	clickhouserolelog.Info("validate create", "name", r.Name)
+	return nil
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *ClickhouseRole) ValidateUpdate(old runtime.Object) error {
+	clickhouserolelog.Info("validate update", "name", r.Name)
+	return nil
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *ClickhouseRole) ValidateDelete() error {
+	clickhouserolelog.Info("validate delete", "name", r.Name)
+	return nil
+}
@@ -22,6 +22,10 @@ type ClickhouseUserSpec struct {
 	// Information regarding secret creation
 	ConnInfoSecretTarget ConnInfoSecretTarget `json:"connInfoSecretTarget,omitempty"`
 
+	// Sets the user password from the secret instead of the generated one.
+	// The password is updated when the secret changes
+	PasswordSecretRef *PasswordSecretReference `json:"passwordSecretRef,omitempty"`
+
 	// Authentication reference to Aiven token in a secret
 	AuthSecretRef *AuthSecretReference `json:"authSecretRef,omitempty"`
 }
@@ -55,6 +59,10 @@ func (u ClickhouseUser) AuthSecretRef() *AuthSecretReference {
 	return u.Spec.AuthSecretRef
 }
 
+func (u ClickhouseUser) PasswordSecretRef() *PasswordSecretReference {
+	return u.Spec.PasswordSecretRef
+}
+
 //+kubebuilder:object:root=true
 
 // ClickhouseUserList contains a list of ClickhouseUser
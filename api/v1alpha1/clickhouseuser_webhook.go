@@ -15,6 +15,7 @@ var clickhouseuserlog = logf.Log.WithName("clickhouseuser-resource")
 func (r *ClickhouseUser) SetupWebhookWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(r).
+		WithDefaulter(newObjectDefaulter(mgr)).
 		Complete()
 }
 
@@ -25,6 +26,8 @@ var _ webhook.Defaulter = &ClickhouseUser{}
 // Default implements webhook.Defaulter so a webhook will be registered for the type
 func (r *ClickhouseUser) Default() {
 	clickhouseuserlog.Info("default", "name", r.Name)
+
+	r.Spec.ConnInfoSecretTarget.Name = secretTargetName(r, r.Spec.ConnInfoSecretTarget)
 }
 
 //+kubebuilder:webhook:path=/validate-aiven-io-v1alpha1-clickhouseuser,mutating=false,failurePolicy=fail,sideEffects=None,groups=aiven.io,resources=clickhouseusers,verbs=create;update,versions=v1alpha1,name=vclickhouseuser.kb.io,admissionReviewVersions=v1
@@ -34,14 +37,14 @@ var _ webhook.Validator = &ClickhouseUser{}
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type
 func (r *ClickhouseUser) ValidateCreate() error {
 	clickhouseuserlog.Info("validate create", "name", r.Name)
-	return nil
+	return validatePasswordSecretRef(r, r.Spec.PasswordSecretRef, r.Spec.ConnInfoSecretTarget)
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
 func (r *ClickhouseUser) ValidateUpdate(old runtime.Object) error {
 	clickhouseuserlog.Info("validate update", "name", r.Name)
 
-	return nil
+	return validatePasswordSecretRef(r, r.Spec.PasswordSecretRef, r.Spec.ConnInfoSecretTarget)
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
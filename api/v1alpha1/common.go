@@ -4,11 +4,13 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/docker/go-units"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -22,6 +24,22 @@ type AuthSecretReference struct {
 	Key string `json:"key"`
 }
 
+// PasswordSecretReference references a Secret containing a user password
+type PasswordSecretReference struct {
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// +kubebuilder:validation:MinLength=1
+	Key string `json:"key"`
+}
+
+// validatePasswordSecretRef checks the password secret is not overwritten with the connection secret
+func validatePasswordSecretRef(o client.Object, ref *PasswordSecretReference, target ConnInfoSecretTarget) error {
+	if ref != nil && ref.Name == secretTargetName(o, target) {
+		return fmt.Errorf("passwordSecretRef.name must differ from connInfoSecretTarget.name")
+	}
+	return nil
+}
+
 // ConnInfoSecretTarget contains information secret name
 type ConnInfoSecretTarget struct {
 	// Name of the secret resource to be created. By default, is equal to the resource name
@@ -41,6 +59,68 @@ type ServiceStatus struct {
 
 	// Service state
 	State string `json:"state"`
+
+	// Service is powered on
+	Powered bool `json:"powered"`
+
+	// Integrations created from serviceIntegrations
+	ServiceIntegrations []ServiceIntegrationItemStatus `json:"serviceIntegrations,omitempty"`
+
+	// Tags set by the operator: spec tags and mirrored Kubernetes metadata
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// Disk space increases made by autoscaleDisk, the latest ones
+	DiskAutoscaleSteps []DiskAutoscaleStep `json:"diskAutoscaleSteps,omitempty"`
+
+	// Addresses discovered by ipFilterFrom
+	IPFilterFrom *IPFilterFromStatus `json:"ipFilterFrom,omitempty"`
+
+	// Static IP ids associated from staticIPRefs
+	StaticIPs []string `json:"staticIPs,omitempty"`
+}
+
+// AutoscaleDisk increases the disk space in steps when its usage reaches the threshold,
+// up to the maximum disk space
+type AutoscaleDisk struct {
+	// +kubebuilder:validation:Minimum=50
+	// +kubebuilder:validation:Maximum=95
+	// +kubebuilder:default=85
+	// Disk usage percentage that triggers the increase
+	Threshold int `json:"threshold,omitempty"`
+
+	// +kubebuilder:validation:Pattern="^[1-9][0-9]*(GiB|G)$"
+	// Disk space added at once, rounded up to the plan's disk space step. Defaults to the plan's step
+	Step string `json:"step,omitempty"`
+
+	// +kubebuilder:validation:Pattern="^[1-9][0-9]*(GiB|G)$"
+	// Maximum disk space, defaults to the plan's maximum
+	MaxDiskSpace string `json:"maxDiskSpace,omitempty"`
+
+	// Minimum time between increases, defaults to 1h. Gives the service time to apply the change
+	Cooldown *metav1.Duration `json:"cooldown,omitempty"`
+}
+
+// Validate validates the fields the API can't
+func (in *AutoscaleDisk) Validate(diskSpace string) error {
+	if in.Cooldown != nil && in.Cooldown.Duration < 0 {
+		return fmt.Errorf("autoscaleDisk.cooldown must not be negative")
+	}
+
+	if in.MaxDiskSpace != "" && ConvertDiscSpace(in.MaxDiskSpace) < ConvertDiscSpace(diskSpace) {
+		return fmt.Errorf("autoscaleDisk.maxDiskSpace %s must not be less than disk_space %s", in.MaxDiskSpace, diskSpace)
+	}
+	return nil
+}
+
+// DiskAutoscaleStep is a disk space increase
+type DiskAutoscaleStep struct {
+	Time metav1.Time `json:"time"`
+
+	// Disk usage percentage that triggered the increase
+	UsagePercent int `json:"usagePercent"`
+
+	FromDiskSpace string `json:"fromDiskSpace"`
+	ToDiskSpace   string `json:"toDiskSpace"`
 }
 
 type ServiceCommonSpec struct {
@@ -78,22 +158,75 @@ type ServiceCommonSpec struct {
 	// Prevent service from being deleted. It is recommended to have this enabled for all services.
 	TerminationProtection *bool `json:"terminationProtection,omitempty"`
 
+	// Powers the service on or off, defaults to true. Powered off services keep their data in backups only,
+	// so services without backups are not powered off. Can't be used with terminationProtection
+	Powered *bool `json:"powered,omitempty"`
+
+	// Powers the service off and on at scheduled times, applied when powered is not false.
+	// Can't be used with terminationProtection
+	PowerSchedule *ServicePowerSchedule `json:"powerSchedule,omitempty"`
+
 	// Tags are key-value pairs that allow you to categorize services.
+	// Tags removed from the spec are removed from the service, tags added outside the operator are kept
 	Tags map[string]string `json:"tags,omitempty"`
 
-	// +kubebuilder:validation:MaxItems=1
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
-	// Service integrations to specify when creating a service. Not applied after initial service creation
+	// Service integrations of the service. Integrations are created, and removed when they are
+	// removed from the list, after the service creation too. Integrations created with
+	// the ServiceIntegration kind are reused and never removed
 	ServiceIntegrations []*ServiceIntegrationItem `json:"serviceIntegrations,omitempty"`
+
+	// Adds addresses discovered from Kubernetes to userConfig.ip_filter
+	IPFilterFrom *IPFilterFrom `json:"ipFilterFrom,omitempty"`
+
+	// StaticIPRefs references to StaticIP resources to associate with the service.
+	// userConfig.static_ips is enabled once all of them are associated,
+	// and is disabled before the static IPs in use are dissociated
+	StaticIPRefs []ResourceReference `json:"staticIPRefs,omitempty"`
 }
 
 // Validate runs complex validation on ServiceCommonSpec
 func (in *ServiceCommonSpec) Validate() error {
+	var errs []error
+
 	// todo: remove when resolved https://github.com/kubernetes-sigs/controller-tools/issues/461
 	if in.ProjectVPCID != "" && in.ProjectVPCRef != nil {
-		return fmt.Errorf("please set ProjectVPCID or ProjectVPCRef, not both")
+		errs = append(errs, fmt.Errorf("please set ProjectVPCID or ProjectVPCRef, not both"))
 	}
-	return nil
+
+	if (in.Powered != nil && !*in.Powered) || in.PowerSchedule != nil {
+		if in.TerminationProtection != nil && *in.TerminationProtection {
+			errs = append(errs, fmt.Errorf("powered and powerSchedule can't be used with terminationProtection"))
+		}
+	}
+
+	if in.PowerSchedule != nil {
+		if _, _, _, err := in.PowerSchedule.Parse(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	integrations := make(map[ServiceIntegrationItem]bool, len(in.ServiceIntegrations))
+	for _, i := range in.ServiceIntegrations {
+		if (i.SourceServiceName == "") == (i.DestinationServiceName == "") {
+			errs = append(errs, fmt.Errorf("service integration %q must have exactly one of sourceServiceName or destinationServiceName", i.IntegrationType))
+		}
+
+		if i.IntegrationType == "read_replica" && i.SourceServiceName == "" {
+			errs = append(errs, fmt.Errorf("service integration read_replica must have sourceServiceName"))
+		}
+
+		if integrations[*i] {
+			errs = append(errs, fmt.Errorf("service integration %q with %q is set more than once", i.IntegrationType, i.SourceServiceName+i.DestinationServiceName))
+		}
+		integrations[*i] = true
+	}
+
+	if in.IPFilterFrom != nil {
+		if err := in.IPFilterFrom.Validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return utilerrors.NewAggregate(errs)
 }
 
 // GetRefs is inherited by kafka, pg, os, etc
@@ -101,6 +234,10 @@ func (in *ServiceCommonSpec) GetRefs(namespace string) (refs []*ResourceReferenc
 	if in.ProjectVPCRef != nil {
 		refs = append(refs, in.ProjectVPCRef.ProjectVPC(namespace))
 	}
+
+	for i := range in.StaticIPRefs {
+		refs = append(refs, in.StaticIPRefs[i].StaticIP(namespace))
+	}
 	return refs
 }
 
@@ -144,6 +281,54 @@ func (in *ResourceReference) ProjectVPC(objNamespace string) *ResourceReferenceO
 	return in.ref("ProjectVPC", objNamespace)
 }
 
+// StaticIP returns reference StaticIP kind
+func (in *ResourceReference) StaticIP(objNamespace string) *ResourceReferenceObject {
+	return in.ref("StaticIP", objNamespace)
+}
+
+// BillingGroup returns reference BillingGroup kind
+func (in *ResourceReference) BillingGroup(objNamespace string) *ResourceReferenceObject {
+	return in.ref("BillingGroup", objNamespace)
+}
+
+// AccountTeam returns reference AccountTeam kind
+func (in *ResourceReference) AccountTeam(objNamespace string) *ResourceReferenceObject {
+	return in.ref("AccountTeam", objNamespace)
+}
+
+// ServiceIntegrationEndpoint returns reference ServiceIntegrationEndpoint kind
+func (in *ResourceReference) ServiceIntegrationEndpoint(objNamespace string) *ResourceReferenceObject {
+	return in.ref("ServiceIntegrationEndpoint", objNamespace)
+}
+
+func (in *ResourceReference) KafkaSchema(objNamespace string) *ResourceReferenceObject {
+	return in.ref("KafkaSchema", objNamespace)
+}
+
+func (in *ResourceReference) Flink(objNamespace string) *ResourceReferenceObject {
+	return in.ref("Flink", objNamespace)
+}
+
+func (in *ResourceReference) Kafka(objNamespace string) *ResourceReferenceObject {
+	return in.ref("Kafka", objNamespace)
+}
+
+func (in *ResourceReference) KafkaMirrorMaker(objNamespace string) *ResourceReferenceObject {
+	return in.ref("KafkaMirrorMaker", objNamespace)
+}
+
+func (in *ResourceReference) Clickhouse(objNamespace string) *ResourceReferenceObject {
+	return in.ref("Clickhouse", objNamespace)
+}
+
+func (in *ResourceReference) ClickhouseUser(objNamespace string) *ResourceReferenceObject {
+	return in.ref("ClickhouseUser", objNamespace)
+}
+
+func (in *ResourceReference) ClickhouseRole(objNamespace string) *ResourceReferenceObject {
+	return in.ref("ClickhouseRole", objNamespace)
+}
+
 // ResourceReferenceObject is a composite "key" to resource
 // GroupVersionKind is for resource "type": GroupVersionKind{Group: "aiven.io", Version: "v1alpha1", Kind: "Kafka"}
 // NamespacedName is for specific instance: NamespacedName{Name: "my-kafka", Namespace: "default"}
@@ -170,6 +355,36 @@ func FindProjectVPC(refs []client.Object) *ProjectVPC {
 	return nil
 }
 
+// FindBillingGroup returns BillingGroup from the given references
+func FindBillingGroup(refs []client.Object) *BillingGroup {
+	for _, o := range refs {
+		if g, ok := o.(*BillingGroup); ok {
+			return g
+		}
+	}
+	return nil
+}
+
+// FindAccountTeam returns AccountTeam from the given references
+func FindAccountTeam(refs []client.Object) *AccountTeam {
+	for _, o := range refs {
+		if t, ok := o.(*AccountTeam); ok {
+			return t
+		}
+	}
+	return nil
+}
+
+// FindKafka returns Kafka matching the reference from the given references
+func FindKafka(refs []client.Object, ref *ResourceReferenceObject) *Kafka {
+	for _, o := range refs {
+		if k, ok := o.(*Kafka); ok && k.Name == ref.NamespacedName.Name && k.Namespace == ref.NamespacedName.Namespace {
+			return k
+		}
+	}
+	return nil
+}
+
 // ErrorSubstrChecker returns error checker for containing given substrings
 func ErrorSubstrChecker(substrings ...string) func(error) bool {
 	return func(err error) bool {
@@ -187,11 +402,113 @@ func ErrorSubstrChecker(substrings ...string) func(error) bool {
 	}
 }
 
-// Service integrations to specify when creating a service. Not applied after initial service creation
+// ServicePowerSchedule powers the service off and on with cron expressions
+// (minute, hour, day of month, month, day of week), for instance
+// powerOff "0 20 * * 1-5" and powerOn "0 8 * * 1-5" keep the service off on nights and weekends
+type ServicePowerSchedule struct {
+	// +kubebuilder:validation:MinLength=9
+	// Cron expression when the service is powered off
+	PowerOff string `json:"powerOff"`
+
+	// +kubebuilder:validation:MinLength=9
+	// Cron expression when the service is powered on
+	PowerOn string `json:"powerOn"`
+
+	// Time zone of the schedule, for instance Europe/Helsinki. Defaults to UTC
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+// Parse returns the power off and power on schedules in the time zone
+func (in *ServicePowerSchedule) Parse() (off, on *CronSchedule, loc *time.Location, err error) {
+	loc, err = time.LoadLocation(in.TimeZone)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("invalid powerSchedule time zone: %w", err)
+	}
+
+	off, err = ParseCronSchedule(in.PowerOff)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("invalid powerSchedule powerOff: %w", err)
+	}
+
+	on, err = ParseCronSchedule(in.PowerOn)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("invalid powerSchedule powerOn: %w", err)
+	}
+	return off, on, loc, nil
+}
+
+// IPFilterFrom discovers addresses from Kubernetes, for instance the cluster egress IPs.
+// They are merged with userConfig.ip_filter and applied once they haven't changed for the debounce period.
+// If no addresses are discovered, the last applied ones are kept, so the allowlist is never emptied
+type IPFilterFrom struct {
+	// Adds external IPs of the nodes matching the selector, an empty selector matches all nodes
+	NodeSelector *metav1.LabelSelector `json:"nodeSelector,omitempty"`
+
+	// Adds load balancer ingress IPs of the Services
+	ServiceRefs []ResourceReference `json:"serviceRefs,omitempty"`
+
+	// Adds IPs and CIDRs from the ConfigMap keys in the same namespace, separated by commas or whitespace
+	ConfigMapKeyRefs []ConfigMapKeyReference `json:"configMapKeyRefs,omitempty"`
+
+	// Time the discovered addresses must not change before they are applied, defaults to 5m
+	Debounce *metav1.Duration `json:"debounce,omitempty"`
+}
+
+// Validate validates the fields the API can't
+func (in *IPFilterFrom) Validate() error {
+	if in.NodeSelector == nil && len(in.ServiceRefs) == 0 && len(in.ConfigMapKeyRefs) == 0 {
+		return fmt.Errorf("ipFilterFrom must have at least one of nodeSelector, serviceRefs or configMapKeyRefs")
+	}
+
+	if in.NodeSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(in.NodeSelector); err != nil {
+			return fmt.Errorf("invalid ipFilterFrom.nodeSelector: %w", err)
+		}
+	}
+
+	if in.Debounce != nil && in.Debounce.Duration < 0 {
+		return fmt.Errorf("ipFilterFrom.debounce must not be negative")
+	}
+	return nil
+}
+
+// ConfigMapKeyReference selects a key of a ConfigMap in the same namespace
+type ConfigMapKeyReference struct {
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:MinLength=1
+	Key string `json:"key"`
+}
+
+// IPFilterFromStatus shows the addresses discovered by ipFilterFrom
+type IPFilterFromStatus struct {
+	// CIDRs added to ip_filter
+	Applied []string `json:"applied,omitempty"`
+
+	// Changed CIDRs, applied once they haven't changed for the debounce period
+	Pending []string `json:"pending,omitempty"`
+
+	// Time the pending CIDRs were discovered
+	PendingSince *metav1.Time `json:"pendingSince,omitempty"`
+}
+
+// ServiceIntegrationItem integrates the service with another service of the project.
+// The service is the integration destination, or the source if destinationServiceName is set
 type ServiceIntegrationItem struct {
-	// +kubebuilder:validation:Enum=read_replica
+	// +kubebuilder:validation:Enum=read_replica;clickhouse_kafka;clickhouse_postgresql;dashboard;datasource;kafka_connect;kafka_logs;kafka_mirrormaker;logs;m3aggregator;m3coordinator;metrics;opensearch_cross_cluster_replication;opensearch_cross_cluster_search;prometheus;schema_registry_proxy
 	IntegrationType string `json:"integrationType"`
-	// +kubebuilder:validation:MinLength=1
 	// +kubebuilder:validation:MaxLength=64
-	SourceServiceName string `json:"sourceServiceName"`
+	// Service the integration gets data from
+	SourceServiceName string `json:"sourceServiceName,omitempty"`
+	// +kubebuilder:validation:MaxLength=64
+	// Service the integration sends data to
+	DestinationServiceName string `json:"destinationServiceName,omitempty"`
+}
+
+// ServiceIntegrationItemStatus is an integration created from serviceIntegrations
+type ServiceIntegrationItemStatus struct {
+	ServiceIntegrationItem `json:",inline"`
+	// Service integration ID
+	ID string `json:"id"`
 }
@@ -0,0 +1,27 @@
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServiceCommonSpecValidate(t *testing.T) {
+	spec := &ServiceCommonSpec{}
+	assert.NoError(t, spec.Validate())
+
+	// All errors are returned
+	spec.ProjectVPCID = "vpc-id"
+	spec.ProjectVPCRef = &ResourceReference{Name: "my-vpc"}
+	spec.ServiceIntegrations = []*ServiceIntegrationItem{{IntegrationType: "read_replica"}}
+	spec.IPFilterFrom = &IPFilterFrom{}
+	assert.EqualError(t, spec.Validate(), "["+
+		"please set ProjectVPCID or ProjectVPCRef, not both, "+
+		"service integration \"read_replica\" must have exactly one of sourceServiceName or destinationServiceName, "+
+		"service integration read_replica must have sourceServiceName, "+
+		"ipFilterFrom must have at least one of nodeSelector, serviceRefs or configMapKeyRefs]")
+
+	spec.ProjectVPCRef = nil
+	spec.ServiceIntegrations = nil
+	assert.EqualError(t, spec.Validate(), "ipFilterFrom must have at least one of nodeSelector, serviceRefs or configMapKeyRefs")
+}
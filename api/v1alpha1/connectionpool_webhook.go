@@ -17,6 +17,7 @@ var connectionpoollog = logf.Log.WithName("connectionpool-resource")
 func (r *ConnectionPool) SetupWebhookWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(r).
+		WithDefaulter(newObjectDefaulter(mgr)).
 		Complete()
 }
 
@@ -28,6 +29,8 @@ var _ webhook.Defaulter = &ConnectionPool{}
 func (r *ConnectionPool) Default() {
 	connectionpoollog.Info("default", "name", r.Name)
 
+	r.Spec.ConnInfoSecretTarget.Name = secretTargetName(r, r.Spec.ConnInfoSecretTarget)
+
 	if r.Spec.PoolSize == 0 {
 		r.Spec.PoolSize = 10
 	}
@@ -56,7 +59,7 @@ func (r *ConnectionPool) ValidateUpdate(old runtime.Object) error {
 		return errors.New("cannot update a ConnectionPool, serviceName field is immutable and cannot be updated")
 	}
 
-	if r.Spec.ConnInfoSecretTarget.Name != old.(*ConnectionPool).Spec.ConnInfoSecretTarget.Name {
+	if secretTargetName(r, r.Spec.ConnInfoSecretTarget) != secretTargetName(old.(*ConnectionPool), old.(*ConnectionPool).Spec.ConnInfoSecretTarget) {
 		return errors.New("cannot update a ConnectionPool, connInfoSecretTarget.name field is immutable and cannot be updated")
 	}
 
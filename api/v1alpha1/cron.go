@@ -0,0 +1,143 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CronSchedule is a parsed cron expression: minute, hour, day of month, month and day of week.
+// Supports lists, ranges and steps, like "0 20 * * 1-5" or "*/15 8-18 * * *"
+// +kubebuilder:object:generate=false
+type CronSchedule struct {
+	minute, hour, dom, month, dow uint64
+
+	// restrictedDays both day of month and day of week are set, the day matches either of them
+	restrictedDays bool
+}
+
+// ParseCronSchedule parses a cron expression with five fields
+func ParseCronSchedule(s string) (*CronSchedule, error) {
+	fields := strings.Fields(s)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron expression %q must have five fields: minute, hour, day of month, month and day of week", s)
+	}
+
+	bounds := [5][2]int{{0, 59}, {0, 23}, {1, 31}, {1, 12}, {0, 7}}
+	values := make([]uint64, 5)
+	for i, f := range fields {
+		v, err := parseCronField(f, bounds[i][0], bounds[i][1])
+		if err != nil {
+			return nil, fmt.Errorf("cron expression %q: %w", s, err)
+		}
+		values[i] = v
+	}
+
+	// Sunday is either 0 or 7
+	if values[4]&(1<<7) != 0 {
+		values[4] |= 1
+	}
+
+	return &CronSchedule{
+		minute:         values[0],
+		hour:           values[1],
+		dom:            values[2],
+		month:          values[3],
+		dow:            values[4],
+		restrictedDays: !strings.HasPrefix(fields[2], "*") && !strings.HasPrefix(fields[4], "*"),
+	}, nil
+}
+
+func parseCronField(f string, min, max int) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(f, ",") {
+		values, stepValue, hasStep := strings.Cut(part, "/")
+		step := 1
+		if hasStep {
+			s, err := strconv.Atoi(stepValue)
+			if err != nil || s < 1 {
+				return 0, fmt.Errorf("invalid step %q", part)
+			}
+			step = s
+		}
+
+		from, to := min, max
+		if values != "*" {
+			first, last, isRange := strings.Cut(values, "-")
+			v, err := strconv.Atoi(first)
+			if err != nil {
+				return 0, fmt.Errorf("invalid value %q", part)
+			}
+			from, to = v, v
+			if isRange {
+				to, err = strconv.Atoi(last)
+				if err != nil {
+					return 0, fmt.Errorf("invalid range %q", part)
+				}
+			} else if hasStep {
+				to = max
+			}
+		}
+
+		if from < min || to > max || from > to {
+			return 0, fmt.Errorf("value %q is out of range %d-%d", part, min, max)
+		}
+
+		for v := from; v <= to; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+func (c *CronSchedule) matchesDay(t time.Time) bool {
+	if c.month&(1<<uint(t.Month())) == 0 {
+		return false
+	}
+
+	dom := c.dom&(1<<uint(t.Day())) != 0
+	dow := c.dow&(1<<uint(t.Weekday())) != 0
+	if c.restrictedDays {
+		return dom || dow
+	}
+	return dom && dow
+}
+
+// Prev returns the latest time not after t the schedule fires at, zero time if there is none within a year
+func (c *CronSchedule) Prev(t time.Time) time.Time {
+	t = t.Truncate(time.Minute)
+	for limit := t.AddDate(-1, 0, 0); t.After(limit); {
+		switch {
+		case !c.matchesDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location()).Add(-time.Minute)
+		case c.hour&(1<<uint(t.Hour())) == 0:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, t.Location()).Add(-time.Minute)
+		case c.minute&(1<<uint(t.Minute())) == 0:
+			t = t.Add(-time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// Next returns the earliest time after t the schedule fires at, zero time if there is none within a year
+func (c *CronSchedule) Next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	for limit := t.AddDate(1, 0, 0); t.Before(limit); {
+		switch {
+		case !c.matchesDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+		case c.hour&(1<<uint(t.Hour())) == 0:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+		case c.minute&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
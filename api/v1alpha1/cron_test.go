@@ -0,0 +1,68 @@
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCronSchedule(t *testing.T) {
+	// Friday
+	now := time.Date(2023, 5, 12, 10, 30, 0, 0, time.UTC)
+
+	cases := []struct {
+		expr string
+		prev time.Time
+		next time.Time
+	}{
+		{
+			expr: "0 20 * * 1-5",
+			prev: time.Date(2023, 5, 11, 20, 0, 0, 0, time.UTC),
+			next: time.Date(2023, 5, 12, 20, 0, 0, 0, time.UTC),
+		},
+		{
+			expr: "0 8 * * 1-5",
+			prev: time.Date(2023, 5, 12, 8, 0, 0, 0, time.UTC),
+			next: time.Date(2023, 5, 15, 8, 0, 0, 0, time.UTC),
+		},
+		{
+			expr: "*/15 * * * *",
+			prev: time.Date(2023, 5, 12, 10, 30, 0, 0, time.UTC),
+			next: time.Date(2023, 5, 12, 10, 45, 0, 0, time.UTC),
+		},
+		{
+			expr: "0 0 1 * 7",
+			prev: time.Date(2023, 5, 7, 0, 0, 0, 0, time.UTC),
+			next: time.Date(2023, 5, 14, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			expr: "30 6,18 29 2 *",
+			prev: time.Date(2020, 2, 29, 18, 30, 0, 0, time.UTC),
+			next: time.Date(2024, 2, 29, 6, 30, 0, 0, time.UTC),
+		},
+	}
+
+	for _, c := range cases {
+		s, err := ParseCronSchedule(c.expr)
+		require.NoError(t, err, c.expr)
+		if c.prev.Before(now.AddDate(-1, 0, 0)) {
+			assert.True(t, s.Prev(now).IsZero(), c.expr)
+		} else {
+			assert.Equal(t, c.prev, s.Prev(now), c.expr)
+		}
+		if c.next.After(now.AddDate(1, 0, 0)) {
+			assert.True(t, s.Next(now).IsZero(), c.expr)
+		} else {
+			assert.Equal(t, c.next, s.Next(now), c.expr)
+		}
+	}
+}
+
+func TestParseCronScheduleErrors(t *testing.T) {
+	for _, expr := range []string{"0 20 * *", "60 * * * *", "* 5-1 * * *", "*/0 * * * *", "a * * * *"} {
+		_, err := ParseCronSchedule(expr)
+		assert.Error(t, err, expr)
+	}
+}
@@ -58,6 +58,10 @@ func (db Database) AuthSecretRef() *AuthSecretReference {
 	return db.Spec.AuthSecretRef
 }
 
+func (db *Database) getTerminationProtection() **bool {
+	return &db.Spec.TerminationProtection
+}
+
 // +kubebuilder:object:root=true
 
 // DatabaseList contains a list of Database
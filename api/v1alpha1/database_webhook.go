@@ -17,6 +17,7 @@ var databaselog = logf.Log.WithName("database-resource")
 func (r *Database) SetupWebhookWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(r).
+		WithDefaulter(newObjectDefaulter(mgr)).
 		Complete()
 }
 
@@ -0,0 +1,217 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// Namespace annotations to set defaults for the resources in the namespace
+const (
+	// DefaultCloudNameAnnotation sets services cloudName
+	DefaultCloudNameAnnotation = "defaults.aiven.io/cloud-name"
+	// DefaultMaintenanceWindowDowAnnotation sets services maintenanceWindowDow
+	DefaultMaintenanceWindowDowAnnotation = "defaults.aiven.io/maintenance-window-dow"
+	// DefaultMaintenanceWindowTimeAnnotation sets services maintenanceWindowTime
+	DefaultMaintenanceWindowTimeAnnotation = "defaults.aiven.io/maintenance-window-time"
+	// DefaultTerminationProtectionAnnotation sets terminationProtection for all kinds that support it.
+	// Value must be parsable with strconv.ParseBool
+	DefaultTerminationProtectionAnnotation = "defaults.aiven.io/termination-protection"
+	// DefaultTagAnnotationPrefix adds a service tag, e.g. "tags.aiven.io/team: data" adds "team: data"
+	DefaultTagAnnotationPrefix = "tags.aiven.io/"
+)
+
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get
+
+// serviceObject is implemented by all service kinds, like Kafka or PostgreSQL
+type serviceObject interface {
+	client.Object
+
+	AuthSecretRef() *AuthSecretReference
+	getServiceCommonSpec() *ServiceCommonSpec
+	getServiceType() string
+	getDiskSpace() string
+	getAutoscaleDisk() *AutoscaleDisk
+}
+
+// terminationProtectedObject is implemented by kinds which support termination protection
+type terminationProtectedObject interface {
+	client.Object
+
+	getTerminationProtection() **bool
+}
+
+var _ admission.CustomDefaulter = &objectDefaulter{}
+
+// objectDefaulter applies the kind's static defaults (Default method)
+// and the ones which depend on other objects:
+// the namespace annotations and the Project the service belongs to.
+// Explicitly set fields are never changed, tags are inherited on creation only.
+// The namespace annotations take precedence over the Project.
+type objectDefaulter struct {
+	// reader doesn't use cache, so the defaulter doesn't need to watch namespaces
+	reader client.Reader
+}
+
+func newObjectDefaulter(mgr ctrl.Manager) *objectDefaulter {
+	return &objectDefaulter{reader: mgr.GetAPIReader()}
+}
+
+// Default implements admission.CustomDefaulter
+func (d *objectDefaulter) Default(ctx context.Context, obj runtime.Object) error {
+	if o, ok := obj.(webhook.Defaulter); ok {
+		o.Default()
+	}
+
+	o, ok := obj.(client.Object)
+	if !ok {
+		return nil
+	}
+
+	annotations, err := d.getNamespaceAnnotations(ctx, o.GetNamespace())
+	if err != nil {
+		return err
+	}
+
+	if p, ok := obj.(terminationProtectedObject); ok {
+		if err = defaultTerminationProtection(p.getTerminationProtection(), annotations); err != nil {
+			return err
+		}
+	}
+
+	s, ok := obj.(serviceObject)
+	if !ok {
+		return nil
+	}
+
+	spec := s.getServiceCommonSpec()
+	if err = defaultTerminationProtection(&spec.TerminationProtection, annotations); err != nil {
+		return err
+	}
+
+	project, err := d.getProject(ctx, o.GetNamespace(), spec.Project)
+	if err != nil {
+		return err
+	}
+
+	spec.CloudName = defaultString(spec.CloudName, annotations[DefaultCloudNameAnnotation], project.Spec.Cloud)
+	spec.MaintenanceWindowDow = defaultString(spec.MaintenanceWindowDow, annotations[DefaultMaintenanceWindowDowAnnotation])
+	spec.MaintenanceWindowTime = defaultString(spec.MaintenanceWindowTime, annotations[DefaultMaintenanceWindowTimeAnnotation])
+
+	// Tags are inherited on creation only, so a tag removed from the spec is not added back on update
+	if !isCreateRequest(ctx) {
+		return nil
+	}
+
+	// Merges tags: spec overrides namespace annotations, which override the project's tags
+	tags := make(map[string]string)
+	for k, v := range project.Spec.Tags {
+		tags[k] = v
+	}
+
+	for k, v := range annotations {
+		if strings.HasPrefix(k, DefaultTagAnnotationPrefix) {
+			tags[strings.TrimPrefix(k, DefaultTagAnnotationPrefix)] = v
+		}
+	}
+
+	for k, v := range spec.Tags {
+		tags[k] = v
+	}
+
+	if len(tags) > 0 {
+		spec.Tags = tags
+	}
+	return nil
+}
+
+// isCreateRequest returns true for CREATE admission requests,
+// or if there is no request in the context, when the defaulter is called directly
+func isCreateRequest(ctx context.Context) bool {
+	req, err := admission.RequestFromContext(ctx)
+	return err != nil || req.Operation == admissionv1.Create
+}
+
+func (d *objectDefaulter) getNamespaceAnnotations(ctx context.Context, namespace string) (map[string]string, error) {
+	if namespace == "" {
+		return nil, nil
+	}
+
+	ns := new(corev1.Namespace)
+	err := d.reader.Get(ctx, types.NamespacedName{Name: namespace}, ns)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot get namespace %q: %w", namespace, err)
+	}
+	return ns.GetAnnotations(), nil
+}
+
+// getProject returns Project by its name.
+// If the project is not managed by the operator, returns an empty one
+func (d *objectDefaulter) getProject(ctx context.Context, namespace, name string) (*Project, error) {
+	project := new(Project)
+	if name == "" {
+		return project, nil
+	}
+
+	err := d.reader.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, project)
+	if apierrors.IsNotFound(err) {
+		return new(Project), nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot get project %q: %w", name, err)
+	}
+	return project, nil
+}
+
+// defaultTerminationProtection sets the namespace policy, if the field is not set explicitly
+func defaultTerminationProtection(p **bool, annotations map[string]string) error {
+	v, ok := annotations[DefaultTerminationProtectionAnnotation]
+	if !ok {
+		return nil
+	}
+
+	if *p != nil {
+		return nil
+	}
+
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return fmt.Errorf("invalid namespace annotation %q value: %w", DefaultTerminationProtectionAnnotation, err)
+	}
+
+	*p = &b
+	return nil
+}
+
+// defaultString returns the first non-empty string
+func defaultString(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// secretTargetName returns the secret name the way the operator creates it
+func secretTargetName(o client.Object, target ConnInfoSecretTarget) string {
+	return defaultString(target.Name, o.GetName())
+}
@@ -0,0 +1,86 @@
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func newTestDefaulter(t *testing.T) *objectDefaulter {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, AddToScheme(scheme))
+	reader := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "data-team", Annotations: map[string]string{
+			DefaultCloudNameAnnotation:             "google-europe-west1",
+			DefaultTerminationProtectionAnnotation: "true",
+			DefaultTagAnnotationPrefix + "team":    "data",
+			DefaultTagAnnotationPrefix + "env":     "prod",
+		}}},
+		&Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-project", Namespace: "data-team"},
+			Spec:       ProjectSpec{Cloud: "aws-eu-west-1", Tags: map[string]string{"env": "dev", "cost-center": "4200"}},
+		},
+	).Build()
+	return &objectDefaulter{reader: reader}
+}
+
+func newTestAdmissionContext(op admissionv1.Operation) context.Context {
+	return admission.NewContextWithRequest(context.Background(), admission.Request{
+		AdmissionRequest: admissionv1.AdmissionRequest{Operation: op},
+	})
+}
+
+func TestObjectDefaulterService(t *testing.T) {
+	d := newTestDefaulter(t)
+	pg := &PostgreSQL{ObjectMeta: metav1.ObjectMeta{Name: "my-pg", Namespace: "data-team"}}
+	pg.Spec.Project = "my-project"
+	pg.Spec.MaintenanceWindowDow = "monday"
+	pg.Spec.Tags = map[string]string{"team": "payments"}
+
+	// The namespace overrides the project, the spec overrides both
+	require.NoError(t, d.Default(newTestAdmissionContext(admissionv1.Create), pg))
+	assert.Equal(t, "google-europe-west1", pg.Spec.CloudName)
+	assert.Equal(t, "monday", pg.Spec.MaintenanceWindowDow)
+	assert.Empty(t, pg.Spec.MaintenanceWindowTime)
+	require.NotNil(t, pg.Spec.TerminationProtection)
+	assert.True(t, *pg.Spec.TerminationProtection)
+	assert.Equal(t, map[string]string{"team": "payments", "env": "prod", "cost-center": "4200"}, pg.Spec.Tags)
+
+	// Removed tags are not added back on update
+	delete(pg.Spec.Tags, "cost-center")
+	require.NoError(t, d.Default(newTestAdmissionContext(admissionv1.Update), pg))
+	assert.Equal(t, map[string]string{"team": "payments", "env": "prod"}, pg.Spec.Tags)
+
+	// Missing fields are still defaulted on update
+	pg.Spec.CloudName = ""
+	require.NoError(t, d.Default(newTestAdmissionContext(admissionv1.Update), pg))
+	assert.Equal(t, "google-europe-west1", pg.Spec.CloudName)
+	assert.Equal(t, map[string]string{"team": "payments", "env": "prod"}, pg.Spec.Tags)
+}
+
+func TestObjectDefaulterTerminationProtection(t *testing.T) {
+	d := newTestDefaulter(t)
+
+	// Explicit value is kept
+	disabled := false
+	db := &Database{ObjectMeta: metav1.ObjectMeta{Name: "my-db", Namespace: "data-team"}}
+	db.Spec.TerminationProtection = &disabled
+	require.NoError(t, d.Default(newTestAdmissionContext(admissionv1.Create), db))
+	assert.False(t, *db.Spec.TerminationProtection)
+
+	// No namespace annotations
+	topic := &KafkaTopic{ObjectMeta: metav1.ObjectMeta{Name: "my-topic", Namespace: "default"}}
+	require.NoError(t, d.Default(newTestAdmissionContext(admissionv1.Create), topic))
+	assert.Nil(t, topic.Spec.TerminationProtection)
+	assert.Equal(t, "my-topic", topic.Spec.TopicName)
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	flinkuserconfig "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/service/flink"
+)
+
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// FlinkSpec defines the desired state of Flink
+type FlinkSpec struct {
+	ServiceCommonSpec `json:",inline"`
+
+	// Authentication reference to Aiven token in a secret
+	AuthSecretRef *AuthSecretReference `json:"authSecretRef,omitempty"`
+
+	// Information regarding secret creation
+	ConnInfoSecretTarget ConnInfoSecretTarget `json:"connInfoSecretTarget,omitempty"`
+
+	// Flink specific user configuration options
+	UserConfig *flinkuserconfig.FlinkUserConfig `json:"userConfig,omitempty"`
+}
+
+// Flink is the Schema for the flinks API
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
+// +kubebuilder:printcolumn:name="Region",type="string",JSONPath=".spec.cloudName"
+// +kubebuilder:printcolumn:name="Plan",type="string",JSONPath=".spec.plan"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
+type Flink struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FlinkSpec     `json:"spec,omitempty"`
+	Status ServiceStatus `json:"status,omitempty"`
+}
+
+func (in *Flink) AuthSecretRef() *AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *Flink) GetRefs() []*ResourceReferenceObject {
+	return in.Spec.GetRefs(in.GetNamespace())
+}
+
+func (in *Flink) getServiceCommonSpec() *ServiceCommonSpec {
+	return &in.Spec.ServiceCommonSpec
+}
+
+func (in *Flink) getServiceType() string {
+	return "flink"
+}
+
+// getDiskSpace Flink doesn't have additional disk space
+func (in *Flink) getDiskSpace() string {
+	return ""
+}
+
+func (in *Flink) getAutoscaleDisk() *AutoscaleDisk {
+	return nil
+}
+
+//+kubebuilder:object:root=true
+
+// FlinkList contains a list of Flink
+type FlinkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Flink `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Flink{}, &FlinkList{})
+}
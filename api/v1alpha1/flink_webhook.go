@@ -0,0 +1,78 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	"errors"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// log is for logging in this package.
+var flinklog = logf.Log.WithName("flink-resource")
+
+func (in *Flink) SetupWebhookWithManager(mgr ctrl.Manager, catalog ServiceCatalog) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(in).
+		WithDefaulter(newObjectDefaulter(mgr)).
+		WithValidator(newServiceValidator(catalog)).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-aiven-io-v1alpha1-flink,mutating=true,failurePolicy=fail,sideEffects=None,groups=aiven.io,resources=flinks,verbs=create;update,versions=v1alpha1,name=mflink.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &Flink{}
+
+func (in *Flink) Default() {
+	flinklog.Info("default", "name", in.Name)
+
+	in.Spec.ConnInfoSecretTarget.Name = secretTargetName(in, in.Spec.ConnInfoSecretTarget)
+}
+
+//+kubebuilder:webhook:verbs=create;update;delete,path=/validate-aiven-io-v1alpha1-flink,mutating=false,failurePolicy=fail,groups=aiven.io,resources=flinks,versions=v1alpha1,name=vflink.kb.io,sideEffects=none,admissionReviewVersions=v1
+
+var _ webhook.Validator = &Flink{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (in *Flink) ValidateCreate() error {
+	flinklog.Info("validate create", "name", in.Name)
+
+	if len(in.Spec.StaticIPRefs) > 0 {
+		return errors.New("Flink service doesn't support static IPs")
+	}
+
+	return in.Spec.Validate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (in *Flink) ValidateUpdate(old runtime.Object) error {
+	flinklog.Info("validate update", "name", in.Name)
+
+	if in.Spec.Project != old.(*Flink).Spec.Project {
+		return errors.New("cannot update a Flink service, project field is immutable and cannot be updated")
+	}
+
+	if secretTargetName(in, in.Spec.ConnInfoSecretTarget) != secretTargetName(old.(*Flink), old.(*Flink).Spec.ConnInfoSecretTarget) {
+		return errors.New("cannot update a Flink service, connInfoSecretTarget.name field is immutable and cannot be updated")
+	}
+
+	if len(in.Spec.StaticIPRefs) > 0 {
+		return errors.New("Flink service doesn't support static IPs")
+	}
+
+	return in.Spec.Validate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (in *Flink) ValidateDelete() error {
+	flinklog.Info("validate delete", "name", in.Name)
+
+	if in.Spec.TerminationProtection != nil && *in.Spec.TerminationProtection {
+		return errors.New("cannot delete Flink service, termination protection is on")
+	}
+
+	return nil
+}
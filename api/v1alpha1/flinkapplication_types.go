@@ -0,0 +1,185 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FlinkApplicationSpec defines the desired state of FlinkApplication
+type FlinkApplicationSpec struct {
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Format="^[a-zA-Z0-9_-]*$"
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Project to link the application to
+	Project string `json:"project"`
+
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Flink service to link the application to
+	ServiceName string `json:"serviceName"`
+
+	// Flink resource of the service, the application is created once it is running
+	ServiceRef *ResourceReference `json:"serviceRef,omitempty"`
+
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=128
+	// Application name. If not set, metadata.name is used
+	ApplicationName string `json:"applicationName,omitempty"`
+
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=32
+	// Application versions. A version is created on Aiven side for each item,
+	// changing an item creates a new version. Aiven side versions are kept until the application is deleted
+	Versions []FlinkApplicationVersion `json:"versions"`
+
+	// Deploys a version. When not set, the running deployment is stopped with a savepoint
+	Deployment *FlinkApplicationDeployment `json:"deployment,omitempty"`
+
+	// Authentication reference to Aiven token in a secret
+	AuthSecretRef *AuthSecretReference `json:"authSecretRef,omitempty"`
+}
+
+// FlinkApplicationVersion SQL statement with its sources and sinks
+type FlinkApplicationVersion struct {
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// Version name, referenced by the deployment
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:MinLength=1
+	// Job SQL statement
+	Statement string `json:"statement"`
+
+	// Source tables
+	Sources []FlinkApplicationVersionTable `json:"sources,omitempty"`
+
+	// Sink tables
+	Sinks []FlinkApplicationVersionTable `json:"sinks,omitempty"`
+}
+
+// FlinkApplicationVersionTable a table backed by a service integration
+type FlinkApplicationVersionTable struct {
+	// +kubebuilder:validation:MaxLength=36
+	// Flink service integration ID
+	IntegrationID string `json:"integrationId"`
+
+	// +kubebuilder:validation:MinLength=1
+	// CREATE TABLE statement
+	CreateTable string `json:"createTable"`
+}
+
+// FlinkApplicationDeployment runs a version
+type FlinkApplicationDeployment struct {
+	// +kubebuilder:validation:MinLength=1
+	// Version name to deploy. Changing it stops the running deployment with a savepoint
+	// and deploys the version starting from it
+	Version string `json:"version"`
+
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=128
+	// +kubebuilder:default=1
+	// Number of parallel instances of the job
+	Parallelism int `json:"parallelism,omitempty"`
+
+	// +kubebuilder:default=true
+	// Restarts the job if it fails
+	RestartEnabled *bool `json:"restartEnabled,omitempty"`
+
+	// +kubebuilder:validation:MaxLength=2048
+	// Savepoint to start the job from, overrides the savepoint of the previous deployment
+	StartingSavepoint string `json:"startingSavepoint,omitempty"`
+}
+
+// FlinkApplicationVersionStatus Aiven side version of a spec version
+type FlinkApplicationVersionStatus struct {
+	// Version name
+	Name string `json:"name"`
+
+	// Version ID
+	ID string `json:"id"`
+
+	// Version number
+	Version int `json:"version"`
+
+	// SHA-256 of the version spec, a new version is created when it changes
+	Hash string `json:"hash"`
+}
+
+// FlinkApplicationStatus defines the observed state of FlinkApplication
+type FlinkApplicationStatus struct {
+	// Conditions represent the latest available observations of an FlinkApplication state
+	Conditions []metav1.Condition `json:"conditions"`
+
+	// Application ID
+	ID string `json:"id,omitempty"`
+
+	// Created versions
+	Versions []FlinkApplicationVersionStatus `json:"versions,omitempty"`
+
+	// Current deployment ID
+	DeploymentID string `json:"deploymentId,omitempty"`
+
+	// Version name of the current deployment
+	DeploymentVersion string `json:"deploymentVersion,omitempty"`
+
+	// Generation of the spec the current deployment was made for, a failed deployment is made again for a newer one
+	DeploymentGeneration int64 `json:"deploymentGeneration,omitempty"`
+
+	// Last savepoint of the stopped deployment, the next deployment starts from it
+	Savepoint string `json:"savepoint,omitempty"`
+
+	// Job state of the current deployment, like RUNNING, CANCELED or FAILED
+	JobState string `json:"jobState,omitempty"`
+
+	// Error message of the failed deployment
+	JobError string `json:"jobError,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// FlinkApplication is the Schema for the flinkapplications API
+// +kubebuilder:printcolumn:name="Service Name",type="string",JSONPath=".spec.serviceName"
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
+// +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".status.deploymentVersion"
+// +kubebuilder:printcolumn:name="Job State",type="string",JSONPath=".status.jobState"
+type FlinkApplication struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FlinkApplicationSpec   `json:"spec,omitempty"`
+	Status FlinkApplicationStatus `json:"status,omitempty"`
+}
+
+func (in *FlinkApplication) AuthSecretRef() *AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *FlinkApplication) GetRefs() []*ResourceReferenceObject {
+	if in.Spec.ServiceRef == nil {
+		return nil
+	}
+	return []*ResourceReferenceObject{in.Spec.ServiceRef.Flink(in.Namespace)}
+}
+
+// GetApplicationName returns the application name, metadata.name is used if not set
+func (in *FlinkApplication) GetApplicationName() string {
+	if in.Spec.ApplicationName != "" {
+		return in.Spec.ApplicationName
+	}
+	return in.Name
+}
+
+// +kubebuilder:object:root=true
+
+// FlinkApplicationList contains a list of FlinkApplication
+type FlinkApplicationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FlinkApplication `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&FlinkApplication{}, &FlinkApplicationList{})
+}
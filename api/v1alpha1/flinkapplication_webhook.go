@@ -0,0 +1,69 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// log is for logging in this package.
+var flinkapplicationlog = logf.Log.WithName("flinkapplication-resource")
+
+func (r *FlinkApplication) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		WithDefaulter(newObjectDefaulter(mgr)).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-aiven-io-v1alpha1-flinkapplication,mutating=true,failurePolicy=fail,sideEffects=None,groups=aiven.io,resources=flinkapplications,verbs=create;update,versions=v1alpha1,name=mflinkapplication.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &FlinkApplication{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *FlinkApplication) Default() {
+	flinkapplicationlog.Info("default", "name", r.Name)
+}
+
+//+kubebuilder:webhook:path=/validate-aiven-io-v1alpha1-flinkapplication,mutating=false,failurePolicy=fail,sideEffects=None,groups=aiven.io,resources=flinkapplications,verbs=create;update,versions=v1alpha1,name=vflinkapplication.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &FlinkApplication{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *FlinkApplication) ValidateCreate() error {
+	flinkapplicationlog.Info("validate create", "name", r.Name)
+	return r.validateVersions()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *FlinkApplication) ValidateUpdate(old runtime.Object) error {
+	flinkapplicationlog.Info("validate update", "name", r.Name)
+	return r.validateVersions()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *FlinkApplication) ValidateDelete() error {
+	flinkapplicationlog.Info("validate delete", "name", r.Name)
+	return nil
+}
+
+// validateVersions version names must be unique, the deployment must refer to one of them
+func (r *FlinkApplication) validateVersions() error {
+	names := make(map[string]bool, len(r.Spec.Versions))
+	for _, v := range r.Spec.Versions {
+		if names[v.Name] {
+			return fmt.Errorf("duplicate version name %q", v.Name)
+		}
+		names[v.Name] = true
+	}
+
+	if d := r.Spec.Deployment; d != nil && !names[d.Version] {
+		return fmt.Errorf("deployment version %q is not in versions", d.Version)
+	}
+	return nil
+}
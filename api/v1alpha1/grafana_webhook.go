@@ -14,9 +14,11 @@ import (
 // log is for logging in this package.
 var grafanalog = logf.Log.WithName("grafana-resource")
 
-func (in *Grafana) SetupWebhookWithManager(mgr ctrl.Manager) error {
+func (in *Grafana) SetupWebhookWithManager(mgr ctrl.Manager, catalog ServiceCatalog) error {
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(in).
+		WithDefaulter(newObjectDefaulter(mgr)).
+		WithValidator(newServiceValidator(catalog)).
 		Complete()
 }
 
@@ -26,6 +28,8 @@ var _ webhook.Defaulter = &Grafana{}
 
 func (in *Grafana) Default() {
 	grafanalog.Info("default", "name", in.Name)
+
+	in.Spec.ConnInfoSecretTarget.Name = secretTargetName(in, in.Spec.ConnInfoSecretTarget)
 }
 
 //+kubebuilder:webhook:verbs=create;update;delete,path=/validate-aiven-io-v1alpha1-grafana,mutating=false,failurePolicy=fail,groups=aiven.io,resources=grafanas,versions=v1alpha1,name=vgrafana.kb.io,sideEffects=none,admissionReviewVersions=v1
@@ -47,7 +51,7 @@ func (in *Grafana) ValidateUpdate(old runtime.Object) error {
 		return errors.New("cannot update a Grafana service, project field is immutable and cannot be updated")
 	}
 
-	if in.Spec.ConnInfoSecretTarget.Name != old.(*Grafana).Spec.ConnInfoSecretTarget.Name {
+	if secretTargetName(in, in.Spec.ConnInfoSecretTarget) != secretTargetName(old.(*Grafana), old.(*Grafana).Spec.ConnInfoSecretTarget) {
 		return errors.New("cannot update a Grafana service, connInfoSecretTarget.name field is immutable and cannot be updated")
 	}
 
@@ -16,6 +16,9 @@ type KafkaSpec struct {
 	// The disk space of the service, possible values depend on the service type, the cloud provider and the project. Reducing will result in the service re-balancing.
 	DiskSpace string `json:"disk_space,omitempty"`
 
+	// Increases the disk space when the service is running out of it, never decreases it
+	AutoscaleDisk *AutoscaleDisk `json:"autoscaleDisk,omitempty"`
+
 	// Authentication reference to Aiven token in a secret
 	AuthSecretRef *AuthSecretReference `json:"authSecretRef,omitempty"`
 
@@ -53,6 +56,22 @@ func (in *Kafka) GetRefs() []*ResourceReferenceObject {
 	return in.Spec.GetRefs(in.GetNamespace())
 }
 
+func (in *Kafka) getServiceCommonSpec() *ServiceCommonSpec {
+	return &in.Spec.ServiceCommonSpec
+}
+
+func (in *Kafka) getServiceType() string {
+	return "kafka"
+}
+
+func (in *Kafka) getDiskSpace() string {
+	return in.Spec.DiskSpace
+}
+
+func (in *Kafka) getAutoscaleDisk() *AutoscaleDisk {
+	return in.Spec.AutoscaleDisk
+}
+
 // +kubebuilder:object:root=true
 
 // KafkaList contains a list of Kafka
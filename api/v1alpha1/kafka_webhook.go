@@ -14,9 +14,11 @@ import (
 // log is for logging in this package.
 var kafkalog = logf.Log.WithName("kafka-resource")
 
-func (r *Kafka) SetupWebhookWithManager(mgr ctrl.Manager) error {
+func (r *Kafka) SetupWebhookWithManager(mgr ctrl.Manager, catalog ServiceCatalog) error {
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(r).
+		WithDefaulter(newObjectDefaulter(mgr)).
+		WithValidator(newServiceValidator(catalog)).
 		Complete()
 }
 
@@ -27,6 +29,8 @@ var _ webhook.Defaulter = &Kafka{}
 // Default implements webhook.Defaulter so a webhook will be registered for the type
 func (r *Kafka) Default() {
 	kafkalog.Info("default", "name", r.Name)
+
+	r.Spec.ConnInfoSecretTarget.Name = secretTargetName(r, r.Spec.ConnInfoSecretTarget)
 }
 
 //+kubebuilder:webhook:verbs=create;update;delete,path=/validate-aiven-io-v1alpha1-kafka,mutating=false,failurePolicy=fail,groups=aiven.io,resources=kafkas,versions=v1alpha1,name=vkafka.kb.io,sideEffects=none,admissionReviewVersions=v1
@@ -48,7 +52,7 @@ func (r *Kafka) ValidateUpdate(old runtime.Object) error {
 		return errors.New("cannot update a Kafka service, project field is immutable and cannot be updated")
 	}
 
-	if r.Spec.ConnInfoSecretTarget.Name != old.(*Kafka).Spec.ConnInfoSecretTarget.Name {
+	if secretTargetName(r, r.Spec.ConnInfoSecretTarget) != secretTargetName(old.(*Kafka), old.(*Kafka).Spec.ConnInfoSecretTarget) {
 		return errors.New("cannot update a Kafka service, connInfoSecretTarget.name field is immutable and cannot be updated")
 	}
 
@@ -38,6 +38,10 @@ type KafkaACLStatus struct {
 
 	// Kafka ACL ID
 	ID string `json:"id"`
+
+	// Kafka ACL ID replaced by ID.
+	// Removed when the new ACL is confirmed to avoid access loss during the update
+	PreviousID string `json:"previousId,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -15,6 +15,7 @@ var kafkaacllog = logf.Log.WithName("kafkaacl-resource")
 func (r *KafkaACL) SetupWebhookWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(r).
+		WithDefaulter(newObjectDefaulter(mgr)).
 		Complete()
 }
 
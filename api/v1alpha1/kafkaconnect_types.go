@@ -19,6 +19,22 @@ type KafkaConnectSpec struct {
 	UserConfig *kafkaconnectuserconfig.KafkaConnectUserConfig `json:"userConfig,omitempty"`
 }
 
+// KafkaConnectStatus defines the observed state of KafkaConnect
+type KafkaConnectStatus struct {
+	ServiceStatus `json:",inline"`
+
+	// Connector plugins available in the service
+	Plugins []KafkaConnectPlugin `json:"plugins,omitempty"`
+}
+
+// KafkaConnectPlugin describes an available connector plugin
+type KafkaConnectPlugin struct {
+	Class   string `json:"class"`
+	Title   string `json:"title,omitempty"`
+	Type    string `json:"type,omitempty"`
+	Version string `json:"version,omitempty"`
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 
@@ -28,8 +44,8 @@ type KafkaConnect struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
-	Spec   KafkaConnectSpec `json:"spec,omitempty"`
-	Status ServiceStatus    `json:"status,omitempty"`
+	Spec   KafkaConnectSpec   `json:"spec,omitempty"`
+	Status KafkaConnectStatus `json:"status,omitempty"`
 }
 
 func (in *KafkaConnect) AuthSecretRef() *AuthSecretReference {
@@ -40,6 +56,22 @@ func (in *KafkaConnect) GetRefs() []*ResourceReferenceObject {
 	return in.Spec.GetRefs(in.GetNamespace())
 }
 
+func (in *KafkaConnect) getServiceCommonSpec() *ServiceCommonSpec {
+	return &in.Spec.ServiceCommonSpec
+}
+
+func (in *KafkaConnect) getServiceType() string {
+	return "kafka_connect"
+}
+
+func (in *KafkaConnect) getDiskSpace() string {
+	return ""
+}
+
+func (in *KafkaConnect) getAutoscaleDisk() *AutoscaleDisk {
+	return nil
+}
+
 // +kubebuilder:object:root=true
 
 // KafkaConnectList contains a list of KafkaConnect
@@ -14,9 +14,11 @@ import (
 // log is for logging in this package.
 var kafkaconnectlog = logf.Log.WithName("kafkaconnect-resource")
 
-func (r *KafkaConnect) SetupWebhookWithManager(mgr ctrl.Manager) error {
+func (r *KafkaConnect) SetupWebhookWithManager(mgr ctrl.Manager, catalog ServiceCatalog) error {
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(r).
+		WithDefaulter(newObjectDefaulter(mgr)).
+		WithValidator(newServiceValidator(catalog)).
 		Complete()
 }
 
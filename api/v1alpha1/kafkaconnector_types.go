@@ -28,6 +28,72 @@ type KafkaConnectorSpec struct {
 	// To build config values from secret the template function `{{ fromSecret "name" "key" }}`
 	// is provided when interpreting the keys
 	UserConfig map[string]string `json:"userConfig"`
+
+	// Sets connector config values from secrets, ConfigMaps, ServiceUser and KafkaTopic resources in the same namespace.
+	// Changes of the referenced resources are applied to the connector
+	ConfigFrom []KafkaConnectorConfigFrom `json:"configFrom,omitempty"`
+
+	// +kubebuilder:validation:Enum=running;paused
+	// +kubebuilder:default=running
+	// Desired connector state: running or paused
+	State string `json:"state,omitempty"`
+
+	// Restarts failed tasks automatically with exponential backoff
+	AutoRestart *KafkaConnectorAutoRestart `json:"autoRestart,omitempty"`
+}
+
+// KafkaConnectorConfigFrom sets a connector config value from another resource.
+// Exactly one source must be set
+type KafkaConnectorConfigFrom struct {
+	// +kubebuilder:validation:MinLength=1
+	// Connector config key
+	Key string `json:"key"`
+
+	// Selects a key of a secret
+	SecretKeyRef *KafkaConnectorKeySelector `json:"secretKeyRef,omitempty"`
+
+	// Selects a key of a ConfigMap
+	ConfigMapKeyRef *KafkaConnectorKeySelector `json:"configMapKeyRef,omitempty"`
+
+	// Selects a key of a ServiceUser connection secret, for instance `USERNAME` or `PASSWORD`
+	ServiceUserRef *KafkaConnectorKeySelector `json:"serviceUserRef,omitempty"`
+
+	// Uses the topic name of a KafkaTopic
+	KafkaTopicRef *KafkaConnectorObjectReference `json:"kafkaTopicRef,omitempty"`
+}
+
+// KafkaConnectorKeySelector selects a key of a resource in the same namespace
+type KafkaConnectorKeySelector struct {
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:MinLength=1
+	Key string `json:"key"`
+}
+
+// KafkaConnectorObjectReference references a resource in the same namespace
+type KafkaConnectorObjectReference struct {
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+}
+
+// KafkaConnectorRestartAnnotation restarts the connector and its tasks once set, then the annotation is removed
+const KafkaConnectorRestartAnnotation = "controllers.aiven.io/restart"
+
+// KafkaConnectorAutoRestart defines auto restart policy for failed tasks
+type KafkaConnectorAutoRestart struct {
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=5
+	// Maximum restart attempts in a row. The counter is reset when the tasks are running again
+	MaxAttempts int `json:"maxAttempts,omitempty"`
+
+	// +kubebuilder:default="30s"
+	// Delay after the first restart, doubled with every attempt
+	InitialDelay metav1.Duration `json:"initialDelay,omitempty"`
+
+	// +kubebuilder:default="30m"
+	// Maximum delay between restarts
+	MaxDelay metav1.Duration `json:"maxDelay,omitempty"`
 }
 
 // KafkaConnectorStatus defines the observed state of KafkaConnector
@@ -43,6 +109,15 @@ type KafkaConnectorStatus struct {
 
 	// TasksStatus contains metadata about the running tasks
 	TasksStatus KafkaConnectorTasksStatus `json:"tasksStatus"`
+
+	// Auto restart attempts in a row
+	RestartAttempts int `json:"restartAttempts,omitempty"`
+
+	// Last time the connector or its tasks were restarted
+	LastRestartTime *metav1.Time `json:"lastRestartTime,omitempty"`
+
+	// Hash of the applied connector config, the config is applied again once it changes
+	ConfigHash string `json:"configHash,omitempty"`
 }
 
 // KafkaConnectorPluginStatus describes the observed state of a Kafka Connector Plugin
@@ -88,6 +163,19 @@ func (kfk KafkaConnector) AuthSecretRef() *AuthSecretReference {
 	return kfk.Spec.AuthSecretRef
 }
 
+func (kfk *KafkaConnector) GetRefs() []*ResourceReferenceObject {
+	refs := make([]*ResourceReferenceObject, 0)
+	for _, c := range kfk.Spec.ConfigFrom {
+		switch {
+		case c.ServiceUserRef != nil:
+			refs = append(refs, (&ResourceReference{Name: c.ServiceUserRef.Name}).ref("ServiceUser", kfk.Namespace))
+		case c.KafkaTopicRef != nil:
+			refs = append(refs, (&ResourceReference{Name: c.KafkaTopicRef.Name}).ref("KafkaTopic", kfk.Namespace))
+		}
+	}
+	return refs
+}
+
 //+kubebuilder:object:root=true
 
 // KafkaConnectorList contains a list of KafkaConnector
@@ -3,6 +3,8 @@
 package v1alpha1
 
 import (
+	"fmt"
+
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -15,6 +17,7 @@ var kafkaconnectorlog = logf.Log.WithName("kafkaconnector-resource")
 func (r *KafkaConnector) SetupWebhookWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(r).
+		WithDefaulter(newObjectDefaulter(mgr)).
 		Complete()
 }
 
@@ -35,14 +38,14 @@ var _ webhook.Validator = &KafkaConnector{}
 func (r *KafkaConnector) ValidateCreate() error {
 	kafkaconnectorlog.Info("validate create", "name", r.Name)
 
-	return nil
+	return r.validateConfigFrom()
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
 func (r *KafkaConnector) ValidateUpdate(old runtime.Object) error {
 	kafkaconnectorlog.Info("validate update", "name", r.Name)
 
-	return nil
+	return r.validateConfigFrom()
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
@@ -51,3 +54,30 @@ func (r *KafkaConnector) ValidateDelete() error {
 
 	return nil
 }
+
+// validateConfigFrom checks that every configFrom entry has a single source and doesn't override userConfig
+func (r *KafkaConnector) validateConfigFrom() error {
+	keys := make(map[string]bool)
+	for _, c := range r.Spec.ConfigFrom {
+		sources := 0
+		for _, set := range []bool{c.SecretKeyRef != nil, c.ConfigMapKeyRef != nil, c.ServiceUserRef != nil, c.KafkaTopicRef != nil} {
+			if set {
+				sources++
+			}
+		}
+
+		if sources != 1 {
+			return fmt.Errorf("configFrom %q must have exactly one of secretKeyRef, configMapKeyRef, serviceUserRef or kafkaTopicRef", c.Key)
+		}
+
+		if _, ok := r.Spec.UserConfig[c.Key]; ok {
+			return fmt.Errorf("configFrom %q is already set in userConfig", c.Key)
+		}
+
+		if keys[c.Key] {
+			return fmt.Errorf("configFrom %q is set more than once", c.Key)
+		}
+		keys[c.Key] = true
+	}
+	return nil
+}
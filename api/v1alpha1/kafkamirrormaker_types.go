@@ -0,0 +1,73 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kafkamirrormakeruserconfig "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/service/kafka_mirrormaker"
+)
+
+// KafkaMirrorMakerSpec defines the desired state of KafkaMirrorMaker
+type KafkaMirrorMakerSpec struct {
+	ServiceCommonSpec `json:",inline"`
+
+	// Authentication reference to Aiven token in a secret
+	AuthSecretRef *AuthSecretReference `json:"authSecretRef,omitempty"`
+
+	// KafkaMirrorMaker specific user configuration options
+	UserConfig *kafkamirrormakeruserconfig.KafkaMirrormakerUserConfig `json:"userConfig,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// KafkaMirrorMaker is the Schema for the kafkamirrormakers API
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
+// +kubebuilder:printcolumn:name="Region",type="string",JSONPath=".spec.cloudName"
+// +kubebuilder:printcolumn:name="Plan",type="string",JSONPath=".spec.plan"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
+type KafkaMirrorMaker struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KafkaMirrorMakerSpec `json:"spec,omitempty"`
+	Status ServiceStatus        `json:"status,omitempty"`
+}
+
+func (in *KafkaMirrorMaker) AuthSecretRef() *AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *KafkaMirrorMaker) GetRefs() []*ResourceReferenceObject {
+	return in.Spec.GetRefs(in.GetNamespace())
+}
+
+func (in *KafkaMirrorMaker) getServiceCommonSpec() *ServiceCommonSpec {
+	return &in.Spec.ServiceCommonSpec
+}
+
+func (in *KafkaMirrorMaker) getServiceType() string {
+	return "kafka_mirrormaker"
+}
+
+func (in *KafkaMirrorMaker) getDiskSpace() string {
+	return ""
+}
+
+func (in *KafkaMirrorMaker) getAutoscaleDisk() *AutoscaleDisk {
+	return nil
+}
+
+// +kubebuilder:object:root=true
+
+// KafkaMirrorMakerList contains a list of KafkaMirrorMaker
+type KafkaMirrorMakerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KafkaMirrorMaker `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&KafkaMirrorMaker{}, &KafkaMirrorMakerList{})
+}
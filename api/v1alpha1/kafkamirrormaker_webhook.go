@@ -0,0 +1,65 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	"errors"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// log is for logging in this package.
+var kafkamirrormakerlog = logf.Log.WithName("kafkamirrormaker-resource")
+
+func (r *KafkaMirrorMaker) SetupWebhookWithManager(mgr ctrl.Manager, catalog ServiceCatalog) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		WithDefaulter(newObjectDefaulter(mgr)).
+		WithValidator(newServiceValidator(catalog)).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-aiven-io-v1alpha1-kafkamirrormaker,mutating=true,failurePolicy=fail,groups=aiven.io,resources=kafkamirrormakers,verbs=create;update,versions=v1alpha1,name=mkafkamirrormaker.kb.io,sideEffects=none,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &KafkaMirrorMaker{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *KafkaMirrorMaker) Default() {
+	kafkamirrormakerlog.Info("default", "name", r.Name)
+}
+
+//+kubebuilder:webhook:verbs=create;update;delete,path=/validate-aiven-io-v1alpha1-kafkamirrormaker,mutating=false,failurePolicy=fail,groups=aiven.io,resources=kafkamirrormakers,versions=v1alpha1,name=vkafkamirrormaker.kb.io,sideEffects=none,admissionReviewVersions=v1
+
+var _ webhook.Validator = &KafkaMirrorMaker{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *KafkaMirrorMaker) ValidateCreate() error {
+	kafkamirrormakerlog.Info("validate create", "name", r.Name)
+
+	return r.Spec.Validate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *KafkaMirrorMaker) ValidateUpdate(old runtime.Object) error {
+	kafkamirrormakerlog.Info("validate update", "name", r.Name)
+
+	if r.Spec.Project != old.(*KafkaMirrorMaker).Spec.Project {
+		return errors.New("cannot update a KafkaMirrorMaker service, project field is immutable and cannot be updated")
+	}
+
+	return r.Spec.Validate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *KafkaMirrorMaker) ValidateDelete() error {
+	kafkamirrormakerlog.Info("validate delete", "name", r.Name)
+
+	if r.Spec.TerminationProtection != nil && *r.Spec.TerminationProtection {
+		return errors.New("cannot delete KafkaMirrorMaker service, termination protection is on")
+	}
+
+	return nil
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// KafkaReplicationFlowSpec defines the desired state of KafkaReplicationFlow
+type KafkaReplicationFlowSpec struct {
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Format="^[a-zA-Z0-9_-]*$"
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Project to link the replication flow to
+	Project string `json:"project"`
+
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Kafka MirrorMaker 2 service to link the replication flow to
+	ServiceName string `json:"serviceName"`
+
+	// KafkaMirrorMaker resource of the service, the replication flow is created once it is running
+	ServiceRef *ResourceReference `json:"serviceRef,omitempty"`
+
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Kafka resource to replicate from
+	SourceKafkaRef ResourceReference `json:"sourceKafkaRef"`
+
+	// +kubebuilder:validation:MaxLength=128
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9_.-]+$`
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Alias of the source cluster. If not set, the source Kafka name is used
+	SourceClusterAlias string `json:"sourceClusterAlias,omitempty"`
+
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Kafka resource to replicate to
+	TargetKafkaRef ResourceReference `json:"targetKafkaRef"`
+
+	// +kubebuilder:validation:MaxLength=128
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9_.-]+$`
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Alias of the target cluster. If not set, the target Kafka name is used
+	TargetClusterAlias string `json:"targetClusterAlias,omitempty"`
+
+	// +kubebuilder:default=true
+	// Enables the replication flow
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// +kubebuilder:validation:MaxItems=256
+	// Topic names and regular expressions to replicate
+	Topics []string `json:"topics,omitempty"`
+
+	// +kubebuilder:validation:MaxItems=256
+	// Topic names and regular expressions not to replicate
+	TopicsBlacklist []string `json:"topicsBlacklist,omitempty"`
+
+	// +kubebuilder:validation:Enum=org.apache.kafka.connect.mirror.DefaultReplicationPolicy;org.apache.kafka.connect.mirror.IdentityReplicationPolicy
+	// +kubebuilder:default=org.apache.kafka.connect.mirror.DefaultReplicationPolicy
+	// Replication policy class. The default policy prefixes remote topics with the source cluster alias
+	ReplicationPolicyClass string `json:"replicationPolicyClass,omitempty"`
+
+	// Syncs consumer group offsets to the target cluster
+	SyncGroupOffsetsEnabled bool `json:"syncGroupOffsetsEnabled,omitempty"`
+
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=1
+	// Frequency of consumer group offset sync
+	SyncGroupOffsetsIntervalSeconds int `json:"syncGroupOffsetsIntervalSeconds,omitempty"`
+
+	// Emits heartbeats to the target cluster
+	EmitHeartbeatsEnabled bool `json:"emitHeartbeatsEnabled,omitempty"`
+
+	// +kubebuilder:validation:Enum=source;target
+	// +kubebuilder:default=source
+	// Cluster where the offset-syncs topic is located
+	OffsetSyncsTopicLocation string `json:"offsetSyncsTopicLocation,omitempty"`
+
+	// Authentication reference to Aiven token in a secret
+	AuthSecretRef *AuthSecretReference `json:"authSecretRef,omitempty"`
+}
+
+// KafkaReplicationFlowStatus defines the observed state of KafkaReplicationFlow
+type KafkaReplicationFlowStatus struct {
+	// Conditions represent the latest available observations of an KafkaReplicationFlow state
+	Conditions []metav1.Condition `json:"conditions"`
+
+	// Source cluster alias integration ID
+	SourceIntegrationID string `json:"sourceIntegrationId,omitempty"`
+
+	// Target cluster alias integration ID
+	TargetIntegrationID string `json:"targetIntegrationId,omitempty"`
+
+	// Integrations created by replication flows, removed with the last flow using them
+	ManagedIntegrationIDs []string `json:"managedIntegrationIds,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// KafkaReplicationFlow is the Schema for the kafkareplicationflows API
+// +kubebuilder:printcolumn:name="Service Name",type="string",JSONPath=".spec.serviceName"
+// +kubebuilder:printcolumn:name="Source",type="string",JSONPath=".spec.sourceClusterAlias"
+// +kubebuilder:printcolumn:name="Target",type="string",JSONPath=".spec.targetClusterAlias"
+type KafkaReplicationFlow struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KafkaReplicationFlowSpec   `json:"spec,omitempty"`
+	Status KafkaReplicationFlowStatus `json:"status,omitempty"`
+}
+
+func (in *KafkaReplicationFlow) AuthSecretRef() *AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+func (in *KafkaReplicationFlow) GetRefs() []*ResourceReferenceObject {
+	refs := []*ResourceReferenceObject{
+		in.Spec.SourceKafkaRef.Kafka(in.Namespace),
+		in.Spec.TargetKafkaRef.Kafka(in.Namespace),
+	}
+
+	if in.Spec.ServiceRef != nil {
+		refs = append(refs, in.Spec.ServiceRef.KafkaMirrorMaker(in.Namespace))
+	}
+	return refs
+}
+
+// GetSourceClusterAlias returns the source cluster alias, the source Kafka name is used if not set
+func (in *KafkaReplicationFlow) GetSourceClusterAlias() string {
+	if in.Spec.SourceClusterAlias != "" {
+		return in.Spec.SourceClusterAlias
+	}
+	return in.Spec.SourceKafkaRef.Name
+}
+
+// GetTargetClusterAlias returns the target cluster alias, the target Kafka name is used if not set
+func (in *KafkaReplicationFlow) GetTargetClusterAlias() string {
+	if in.Spec.TargetClusterAlias != "" {
+		return in.Spec.TargetClusterAlias
+	}
+	return in.Spec.TargetKafkaRef.Name
+}
+
+// +kubebuilder:object:root=true
+
+// KafkaReplicationFlowList contains a list of KafkaReplicationFlow
+type KafkaReplicationFlowList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KafkaReplicationFlow `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&KafkaReplicationFlow{}, &KafkaReplicationFlowList{})
+}
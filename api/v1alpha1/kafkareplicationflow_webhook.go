@@ -0,0 +1,71 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// log is for logging in this package.
+var kafkareplicationflowlog = logf.Log.WithName("kafkareplicationflow-resource")
+
+func (r *KafkaReplicationFlow) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		WithDefaulter(newObjectDefaulter(mgr)).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-aiven-io-v1alpha1-kafkareplicationflow,mutating=true,failurePolicy=fail,sideEffects=None,groups=aiven.io,resources=kafkareplicationflows,verbs=create;update,versions=v1alpha1,name=mkafkareplicationflow.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &KafkaReplicationFlow{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *KafkaReplicationFlow) Default() {
+	kafkareplicationflowlog.Info("default", "name", r.Name)
+
+	// Aliases are immutable, sets them explicitly
+	r.Spec.SourceClusterAlias = r.GetSourceClusterAlias()
+	r.Spec.TargetClusterAlias = r.GetTargetClusterAlias()
+}
+
+//+kubebuilder:webhook:path=/validate-aiven-io-v1alpha1-kafkareplicationflow,mutating=false,failurePolicy=fail,sideEffects=None,groups=aiven.io,resources=kafkareplicationflows,verbs=create;update,versions=v1alpha1,name=vkafkareplicationflow.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &KafkaReplicationFlow{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *KafkaReplicationFlow) ValidateCreate() error {
+	kafkareplicationflowlog.Info("validate create", "name", r.Name)
+	return r.validateClusters()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *KafkaReplicationFlow) ValidateUpdate(old runtime.Object) error {
+	kafkareplicationflowlog.Info("validate update", "name", r.Name)
+	return r.validateClusters()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *KafkaReplicationFlow) ValidateDelete() error {
+	kafkareplicationflowlog.Info("validate delete", "name", r.Name)
+	return nil
+}
+
+// validateClusters source and target must be different clusters
+func (r *KafkaReplicationFlow) validateClusters() error {
+	if r.GetSourceClusterAlias() == r.GetTargetClusterAlias() {
+		return fmt.Errorf("source and target cluster aliases must be different, got %q", r.GetSourceClusterAlias())
+	}
+
+	source := r.Spec.SourceKafkaRef.Kafka(r.Namespace)
+	target := r.Spec.TargetKafkaRef.Kafka(r.Namespace)
+	if source.NamespacedName == target.NamespacedName {
+		return fmt.Errorf("source and target Kafka must be different, got %q", source.NamespacedName)
+	}
+	return nil
+}
@@ -21,24 +21,73 @@ type KafkaSchemaSpec struct {
 	// Kafka Schema Subject name
 	SubjectName string `json:"subjectName"`
 
-	// Kafka Schema configuration should be a valid Avro Schema JSON format
+	// Kafka Schema configuration should be a valid schema of the schemaType format
 	Schema string `json:"schema"`
 
+	// +kubebuilder:validation:Enum=AVRO;JSON;PROTOBUF
+	// Schema type, AVRO if not set
+	SchemaType string `json:"schemaType,omitempty"`
+
+	// Schema references to other subjects, e.g. imported Protobuf files
+	References []KafkaSchemaReference `json:"references,omitempty"`
+
 	// +kubebuilder:validation:Enum=BACKWARD;BACKWARD_TRANSITIVE;FORWARD;FORWARD_TRANSITIVE;FULL;FULL_TRANSITIVE;NONE
 	// Kafka Schemas compatibility level
 	CompatibilityLevel string `json:"compatibilityLevel,omitempty"`
 
+	// +kubebuilder:validation:Enum=soft;hard
+	// Deletes versions older than the one that matches the schema: "soft" or "hard" (permanent) deletion.
+	// Versions are kept if not set
+	DeleteSupersededVersions string `json:"deleteSupersededVersions,omitempty"`
+
 	// Authentication reference to Aiven token in a secret
 	AuthSecretRef *AuthSecretReference `json:"authSecretRef,omitempty"`
 }
 
+// KafkaSchemaReference is a reference to a subject version.
+// Set either subject (and version) or schemaRef
+type KafkaSchemaReference struct {
+	// +kubebuilder:validation:MinLength=1
+	// Reference name, e.g. the Protobuf import path or the Avro full type name
+	Name string `json:"name"`
+
+	// Referenced subject name
+	Subject string `json:"subject,omitempty"`
+
+	// +kubebuilder:validation:Minimum=1
+	// Referenced subject version, the latest if not set
+	Version int `json:"version,omitempty"`
+
+	// Reference to KafkaSchema resource, its subject and version are used.
+	// The schema is not registered until the referenced one is ready
+	SchemaRef *ResourceReference `json:"schemaRef,omitempty"`
+}
+
 // KafkaSchemaStatus defines the observed state of KafkaSchema
 type KafkaSchemaStatus struct {
 	// Conditions represent the latest available observations of an KafkaSchema state
 	Conditions []metav1.Condition `json:"conditions"`
 
-	// Kafka Schema configuration version
+	// Kafka Schema configuration version that matches the schema
 	Version int `json:"version"`
+
+	// Schema ID of the version that matches the schema
+	ID int `json:"id,omitempty"`
+
+	// Subject versions
+	Versions []KafkaSchemaVersion `json:"versions,omitempty"`
+}
+
+// KafkaSchemaVersion is a registered subject version
+type KafkaSchemaVersion struct {
+	// Subject version
+	Version int `json:"version"`
+
+	// Schema ID
+	ID int `json:"id"`
+
+	// Referenced by another subject, so it can't be deleted as a superseded version
+	Referenced bool `json:"referenced,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -62,6 +111,16 @@ func (kfks KafkaSchema) AuthSecretRef() *AuthSecretReference {
 	return kfks.Spec.AuthSecretRef
 }
 
+func (kfks *KafkaSchema) GetRefs() []*ResourceReferenceObject {
+	refs := make([]*ResourceReferenceObject, 0)
+	for _, r := range kfks.Spec.References {
+		if r.SchemaRef != nil {
+			refs = append(refs, r.SchemaRef.KafkaSchema(kfks.Namespace))
+		}
+	}
+	return refs
+}
+
 // +kubebuilder:object:root=true
 
 // KafkaSchemaList contains a list of KafkaSchema
@@ -4,6 +4,7 @@ package v1alpha1
 
 import (
 	"errors"
+	"fmt"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -17,6 +18,7 @@ var kafkaschemalog = logf.Log.WithName("kafkaschema-resource")
 func (r *KafkaSchema) SetupWebhookWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(r).
+		WithDefaulter(newObjectDefaulter(mgr)).
 		Complete()
 }
 
@@ -37,7 +39,7 @@ var _ webhook.Validator = &KafkaSchema{}
 func (r *KafkaSchema) ValidateCreate() error {
 	kafkaschemalog.Info("validate create", "name", r.Name)
 
-	return nil
+	return r.validateReferences()
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
@@ -56,7 +58,7 @@ func (r *KafkaSchema) ValidateUpdate(old runtime.Object) error {
 		return errors.New("cannot update a KafkaSchema, subjectName field is immutable and cannot be updated")
 	}
 
-	return nil
+	return r.validateReferences()
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
@@ -65,3 +67,16 @@ func (r *KafkaSchema) ValidateDelete() error {
 
 	return nil
 }
+
+func (r *KafkaSchema) validateReferences() error {
+	for _, ref := range r.Spec.References {
+		if (ref.Subject == "") == (ref.SchemaRef == nil) {
+			return fmt.Errorf("reference %q: exactly one of subject or schemaRef must be set", ref.Name)
+		}
+
+		if ref.SchemaRef != nil && ref.Version != 0 {
+			return fmt.Errorf("reference %q: version can't be set with schemaRef, the referenced schema version is used", ref.Name)
+		}
+	}
+	return nil
+}
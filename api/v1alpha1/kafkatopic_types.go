@@ -172,6 +172,10 @@ func (t *KafkaTopic) AuthSecretRef() *AuthSecretReference {
 	return t.Spec.AuthSecretRef
 }
 
+func (t *KafkaTopic) getTerminationProtection() **bool {
+	return &t.Spec.TerminationProtection
+}
+
 // +kubebuilder:object:root=true
 
 // KafkaTopicList contains a list of KafkaTopic
@@ -17,6 +17,7 @@ var kafkatopiclog = logf.Log.WithName("kafkatopic-resource")
 func (r *KafkaTopic) SetupWebhookWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(r).
+		WithDefaulter(newObjectDefaulter(mgr)).
 		Complete()
 }
 
@@ -27,6 +28,10 @@ var _ webhook.Defaulter = &KafkaTopic{}
 // Default implements webhook.Defaulter so a webhook will be registered for the type
 func (r *KafkaTopic) Default() {
 	kafkatopiclog.Info("default", "name", r.Name)
+
+	if r.Spec.TopicName == "" {
+		r.Spec.TopicName = r.Name
+	}
 }
 
 //+kubebuilder:webhook:verbs=create;update;delete,path=/validate-aiven-io-v1alpha1-kafkatopic,mutating=false,failurePolicy=fail,groups=aiven.io,resources=kafkatopics,versions=v1alpha1,name=vkafkatopic.kb.io,sideEffects=none,admissionReviewVersions=v1
@@ -16,6 +16,9 @@ type MySQLSpec struct {
 	// The disk space of the service, possible values depend on the service type, the cloud provider and the project. Reducing will result in the service re-balancing.
 	DiskSpace string `json:"disk_space,omitempty"`
 
+	// Increases the disk space when the service is running out of it, never decreases it
+	AutoscaleDisk *AutoscaleDisk `json:"autoscaleDisk,omitempty"`
+
 	// Authentication reference to Aiven token in a secret
 	AuthSecretRef *AuthSecretReference `json:"authSecretRef,omitempty"`
 
@@ -49,6 +52,22 @@ func (in *MySQL) GetRefs() []*ResourceReferenceObject {
 	return in.Spec.GetRefs(in.GetNamespace())
 }
 
+func (in *MySQL) getServiceCommonSpec() *ServiceCommonSpec {
+	return &in.Spec.ServiceCommonSpec
+}
+
+func (in *MySQL) getServiceType() string {
+	return "mysql"
+}
+
+func (in *MySQL) getDiskSpace() string {
+	return in.Spec.DiskSpace
+}
+
+func (in *MySQL) getAutoscaleDisk() *AutoscaleDisk {
+	return in.Spec.AutoscaleDisk
+}
+
 //+kubebuilder:object:root=true
 
 // MySQLList contains a list of MySQL
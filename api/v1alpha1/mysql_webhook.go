@@ -14,9 +14,11 @@ import (
 // log is for logging in this package.
 var mysqllog = logf.Log.WithName("mysql-resource")
 
-func (in *MySQL) SetupWebhookWithManager(mgr ctrl.Manager) error {
+func (in *MySQL) SetupWebhookWithManager(mgr ctrl.Manager, catalog ServiceCatalog) error {
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(in).
+		WithDefaulter(newObjectDefaulter(mgr)).
+		WithValidator(newServiceValidator(catalog)).
 		Complete()
 }
 
@@ -27,6 +29,8 @@ var _ webhook.Defaulter = &MySQL{}
 // Default implements webhook.Defaulter so a webhook will be registered for the type
 func (in *MySQL) Default() {
 	mysqllog.Info("default", "name", in.Name)
+
+	in.Spec.ConnInfoSecretTarget.Name = secretTargetName(in, in.Spec.ConnInfoSecretTarget)
 }
 
 //+kubebuilder:webhook:verbs=create;update;delete,path=/validate-aiven-io-v1alpha1-mysql,mutating=false,failurePolicy=fail,groups=aiven.io,resources=mysqls,versions=v1alpha1,name=vmysql.kb.io,sideEffects=none,admissionReviewVersions=v1
@@ -48,7 +52,7 @@ func (in *MySQL) ValidateUpdate(old runtime.Object) error {
 		return errors.New("cannot update a MySQL service, project field is immutable and cannot be updated")
 	}
 
-	if in.Spec.ConnInfoSecretTarget.Name != old.(*MySQL).Spec.ConnInfoSecretTarget.Name {
+	if secretTargetName(in, in.Spec.ConnInfoSecretTarget) != secretTargetName(old.(*MySQL), old.(*MySQL).Spec.ConnInfoSecretTarget) {
 		return errors.New("cannot update a MySQL service, connInfoSecretTarget.name field is immutable and cannot be updated")
 	}
 
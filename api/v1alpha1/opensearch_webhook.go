@@ -14,9 +14,11 @@ import (
 // log is for logging in this package.
 var opensearchlog = logf.Log.WithName("opensearch-resource")
 
-func (r *OpenSearch) SetupWebhookWithManager(mgr ctrl.Manager) error {
+func (r *OpenSearch) SetupWebhookWithManager(mgr ctrl.Manager, catalog ServiceCatalog) error {
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(r).
+		WithDefaulter(newObjectDefaulter(mgr)).
+		WithValidator(newServiceValidator(catalog)).
 		Complete()
 }
 
@@ -27,6 +29,8 @@ var _ webhook.Defaulter = &OpenSearch{}
 // Default implements webhook.Defaulter so a webhook will be registered for the type
 func (r *OpenSearch) Default() {
 	opensearchlog.Info("default", "name", r.Name)
+
+	r.Spec.ConnInfoSecretTarget.Name = secretTargetName(r, r.Spec.ConnInfoSecretTarget)
 }
 
 //+kubebuilder:webhook:verbs=create;update;delete,path=/validate-aiven-io-v1alpha1-opensearch,mutating=false,failurePolicy=fail,groups=aiven.io,resources=opensearches,versions=v1alpha1,name=vopensearch.kb.io,sideEffects=none,admissionReviewVersions=v1
@@ -48,7 +52,7 @@ func (r *OpenSearch) ValidateUpdate(old runtime.Object) error {
 		return errors.New("cannot update a OpenSearch service, project field is immutable and cannot be updated")
 	}
 
-	if r.Spec.ConnInfoSecretTarget.Name != old.(*OpenSearch).Spec.ConnInfoSecretTarget.Name {
+	if secretTargetName(r, r.Spec.ConnInfoSecretTarget) != secretTargetName(old.(*OpenSearch), old.(*OpenSearch).Spec.ConnInfoSecretTarget) {
 		return errors.New("cannot update a OpenSearch service, connInfoSecretTarget.name field is immutable and cannot be updated")
 	}
 
@@ -16,6 +16,9 @@ type PostgreSQLSpec struct {
 	// The disk space of the service, possible values depend on the service type, the cloud provider and the project. Reducing will result in the service re-balancing.
 	DiskSpace string `json:"disk_space,omitempty"`
 
+	// Increases the disk space when the service is running out of it, never decreases it
+	AutoscaleDisk *AutoscaleDisk `json:"autoscaleDisk,omitempty"`
+
 	// Authentication reference to Aiven token in a secret
 	AuthSecretRef *AuthSecretReference `json:"authSecretRef,omitempty"`
 
@@ -51,6 +54,22 @@ func (in *PostgreSQL) GetRefs() []*ResourceReferenceObject {
 	return in.Spec.GetRefs(in.GetNamespace())
 }
 
+func (in *PostgreSQL) getServiceCommonSpec() *ServiceCommonSpec {
+	return &in.Spec.ServiceCommonSpec
+}
+
+func (in *PostgreSQL) getServiceType() string {
+	return "pg"
+}
+
+func (in *PostgreSQL) getDiskSpace() string {
+	return in.Spec.DiskSpace
+}
+
+func (in *PostgreSQL) getAutoscaleDisk() *AutoscaleDisk {
+	return in.Spec.AutoscaleDisk
+}
+
 // +kubebuilder:object:root=true
 
 // PostgreSQLList contains a list of PostgreSQL instances
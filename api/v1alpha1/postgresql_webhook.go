@@ -14,9 +14,11 @@ import (
 // log is for logging in this package.
 var pglog = logf.Log.WithName("postgresql-resource")
 
-func (r *PostgreSQL) SetupWebhookWithManager(mgr ctrl.Manager) error {
+func (r *PostgreSQL) SetupWebhookWithManager(mgr ctrl.Manager, catalog ServiceCatalog) error {
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(r).
+		WithDefaulter(newObjectDefaulter(mgr)).
+		WithValidator(newServiceValidator(catalog)).
 		Complete()
 }
 
@@ -27,6 +29,8 @@ var _ webhook.Defaulter = &PostgreSQL{}
 // Default implements webhook.Defaulter so a webhook will be registered for the type
 func (r *PostgreSQL) Default() {
 	pglog.Info("default", "name", r.Name)
+
+	r.Spec.ConnInfoSecretTarget.Name = secretTargetName(r, r.Spec.ConnInfoSecretTarget)
 }
 
 //+kubebuilder:webhook:verbs=create;update;delete,path=/validate-aiven-io-v1alpha1-postgresql,mutating=false,failurePolicy=fail,groups=aiven.io,resources=postgresqls,versions=v1alpha1,name=vpg.kb.io,sideEffects=none,admissionReviewVersions=v1
@@ -48,7 +52,7 @@ func (r *PostgreSQL) ValidateUpdate(old runtime.Object) error {
 		return errors.New("cannot update a PostgreSQL service, project field is immutable and cannot be updated")
 	}
 
-	if r.Spec.ConnInfoSecretTarget.Name != old.(*PostgreSQL).Spec.ConnInfoSecretTarget.Name {
+	if secretTargetName(r, r.Spec.ConnInfoSecretTarget) != secretTargetName(old.(*PostgreSQL), old.(*PostgreSQL).Spec.ConnInfoSecretTarget) {
 		return errors.New("cannot update a PostgreSQL service, connInfoSecretTarget.name field is immutable and cannot be updated")
 	}
 
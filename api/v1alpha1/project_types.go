@@ -34,9 +34,14 @@ type ProjectSpec struct {
 
 	// +kubebuilder:validation:MaxLength=36
 	// +kubebuilder:validation:MinLength=36
-	// BillingGroup ID
+	// BillingGroup ID, the project is moved to the billing group when changed.
+	// Use billingGroupRef to refer to a BillingGroup resource instead
 	BillingGroupID string `json:"billingGroupId,omitempty"`
 
+	// BillingGroup resource the project is assigned to, replaces the billing fields.
+	// The project is moved to the billing group when changed, or when the billing group is recreated
+	BillingGroupRef *ResourceReference `json:"billingGroupRef,omitempty"`
+
 	// +kubebuilder:validation:MinLength=2
 	// +kubebuilder:validation:MaxLength=2
 	// Billing country code of the project
@@ -84,6 +89,9 @@ type ProjectStatus struct {
 
 	// Payment method name
 	PaymentMethod string `json:"paymentMethod,omitempty"`
+
+	// Billing group ID the project is assigned to
+	BillingGroupID string `json:"billingGroupId,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -102,6 +110,14 @@ func (proj Project) AuthSecretRef() *AuthSecretReference {
 	return proj.Spec.AuthSecretRef
 }
 
+// GetRefs returns the BillingGroup, it must exist before the project is assigned to it
+func (proj *Project) GetRefs() []*ResourceReferenceObject {
+	if proj.Spec.BillingGroupRef == nil {
+		return nil
+	}
+	return []*ResourceReferenceObject{proj.Spec.BillingGroupRef.BillingGroup(proj.Namespace)}
+}
+
 // +kubebuilder:object:root=true
 
 // ProjectList contains a list of Project
@@ -17,6 +17,7 @@ var projectlog = logf.Log.WithName("project-resource")
 func (r *Project) SetupWebhookWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(r).
+		WithDefaulter(newObjectDefaulter(mgr)).
 		Complete()
 }
 
@@ -29,6 +30,8 @@ var _ webhook.Defaulter = &Project{}
 // Default implements webhook.Defaulter so a webhook will be registered for the type
 func (r *Project) Default() {
 	projectlog.Info("default", "name", r.Name)
+
+	r.Spec.ConnInfoSecretTarget.Name = secretTargetName(r, r.Spec.ConnInfoSecretTarget)
 }
 
 //+kubebuilder:webhook:verbs=create;update;delete,path=/validate-aiven-io-v1alpha1-project,mutating=false,failurePolicy=fail,groups=aiven.io,resources=projects,versions=v1alpha1,name=vproject.kb.io,sideEffects=none,admissionReviewVersions=v1
@@ -39,7 +42,7 @@ var _ webhook.Validator = &Project{}
 func (r *Project) ValidateCreate() error {
 	projectlog.Info("validate create", "name", r.Name)
 
-	return nil
+	return r.validateBillingGroup()
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
@@ -50,14 +53,27 @@ func (r *Project) ValidateUpdate(old runtime.Object) error {
 		return errors.New("'copyFromProject' can only be set during creation of a project")
 	}
 
-	if r.Spec.ConnInfoSecretTarget.Name != old.(*Project).Spec.ConnInfoSecretTarget.Name {
+	if secretTargetName(r, r.Spec.ConnInfoSecretTarget) != secretTargetName(old.(*Project), old.(*Project).Spec.ConnInfoSecretTarget) {
 		return errors.New("cannot update a Project, connInfoSecretTarget.name field is immutable and cannot be updated")
 	}
 
-	if r.Spec.BillingGroupID != old.(*Project).Spec.BillingGroupID {
-		return errors.New("'billingGroupId' can only be set during creation of a project")
+	return r.validateBillingGroup()
+}
+
+// validateBillingGroup billing fields belong to the billing group when it is referenced
+func (r *Project) validateBillingGroup() error {
+	if r.Spec.BillingGroupRef == nil {
+		return nil
 	}
 
+	s := r.Spec
+	switch {
+	case s.BillingGroupID != "":
+		return errors.New("'billingGroupId' and 'billingGroupRef' can't be set together")
+	case s.CardID != "", s.BillingAddress != "", len(s.BillingEmails) > 0, s.BillingCurrency != "",
+		s.BillingExtraText != "", s.CountryCode != "":
+		return errors.New("billing fields can't be set with 'billingGroupRef', set them in the BillingGroup")
+	}
 	return nil
 }
 
@@ -15,15 +15,27 @@ type ProjectVPCSpec struct {
 	Project string `json:"project"`
 
 	// +kubebuilder:validation:MaxLength=256
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
-	// Cloud the VPC is in
+	// Cloud the VPC is in.
+	// Can't be changed in place, see replacePolicy
 	CloudName string `json:"cloudName"`
 
 	// +kubebuilder:validation:MaxLength=36
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
-	// Network address range used by the VPC like 192.168.0.0/24
+	// Network address range used by the VPC like 192.168.0.0/24.
+	// Can't be changed in place, see replacePolicy
 	NetworkCidr string `json:"networkCidr"`
 
+	// +kubebuilder:validation:MaxLength=36
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// ID of an existing VPC to adopt, must match cloudName and networkCidr
+	// and must not be managed by another ProjectVPC. When not set, a new VPC is created
+	ID string `json:"id,omitempty"`
+
+	// +kubebuilder:validation:Enum=Reject;Recreate
+	// What to do when cloudName or networkCidr change.
+	// Reject (default) keeps the VPC and sets the SpecApplied condition to False,
+	// Recreate deletes the VPC and creates a new one, it must have no services or peering connections
+	ReplacePolicy string `json:"replacePolicy,omitempty"`
+
 	// Authentication reference to Aiven token in a secret
 	AuthSecretRef *AuthSecretReference `json:"authSecretRef,omitempty"`
 }
@@ -14,9 +14,11 @@ import (
 // log is for logging in this package.
 var redislog = logf.Log.WithName("redis-resource")
 
-func (r *Redis) SetupWebhookWithManager(mgr ctrl.Manager) error {
+func (r *Redis) SetupWebhookWithManager(mgr ctrl.Manager, catalog ServiceCatalog) error {
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(r).
+		WithDefaulter(newObjectDefaulter(mgr)).
+		WithValidator(newServiceValidator(catalog)).
 		Complete()
 }
 
@@ -27,6 +29,8 @@ var _ webhook.Defaulter = &Redis{}
 // Default implements webhook.Defaulter so a webhook will be registered for the type
 func (r *Redis) Default() {
 	redislog.Info("default", "name", r.Name)
+
+	r.Spec.ConnInfoSecretTarget.Name = secretTargetName(r, r.Spec.ConnInfoSecretTarget)
 }
 
 //+kubebuilder:webhook:verbs=create;update;delete,path=/validate-aiven-io-v1alpha1-redis,mutating=false,failurePolicy=fail,groups=aiven.io,resources=redis,versions=v1alpha1,name=vredis.kb.io,sideEffects=none,admissionReviewVersions=v1
@@ -48,7 +52,7 @@ func (r *Redis) ValidateUpdate(old runtime.Object) error {
 		return errors.New("cannot update a Redis service, project field is immutable and cannot be updated")
 	}
 
-	if r.Spec.ConnInfoSecretTarget.Name != old.(*Redis).Spec.ConnInfoSecretTarget.Name {
+	if secretTargetName(r, r.Spec.ConnInfoSecretTarget) != secretTargetName(old.(*Redis), old.(*Redis).Spec.ConnInfoSecretTarget) {
 		return errors.New("cannot update a Redis service, connInfoSecretTarget.name field is immutable and cannot be updated")
 	}
 
@@ -37,6 +37,11 @@ type ServiceIntegrationSpec struct {
 	// Source endpoint for the integration (if any)
 	SourceEndpointID string `json:"sourceEndpointID,omitempty"`
 
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Source ServiceIntegrationEndpoint reference to use its ID as SourceEndpointID.
+	// The integration waits for the endpoint to be ready
+	SourceEndpointRef *ResourceReference `json:"sourceEndpointRef,omitempty"`
+
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
 	// +kubebuilder:validation:MaxLength=64
 	// Source service for the integration (if any)
@@ -52,6 +57,11 @@ type ServiceIntegrationSpec struct {
 	// Destination endpoint for the integration (if any)
 	DestinationEndpointID string `json:"destinationEndpointId,omitempty"`
 
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Destination ServiceIntegrationEndpoint reference to use its ID as DestinationEndpointID.
+	// The integration waits for the endpoint to be ready
+	DestinationEndpointRef *ResourceReference `json:"destinationEndpointRef,omitempty"`
+
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
 	// +kubebuilder:validation:MaxLength=64
 	// Destination service for the integration (if any)
@@ -124,6 +134,18 @@ func (in *ServiceIntegration) AuthSecretRef() *AuthSecretReference {
 	return in.Spec.AuthSecretRef
 }
 
+// GetRefs returns the referenced endpoints, they must be ready before the integration is created
+func (in *ServiceIntegration) GetRefs() []*ResourceReferenceObject {
+	refs := make([]*ResourceReferenceObject, 0)
+	if in.Spec.SourceEndpointRef != nil {
+		refs = append(refs, in.Spec.SourceEndpointRef.ServiceIntegrationEndpoint(in.Namespace))
+	}
+	if in.Spec.DestinationEndpointRef != nil {
+		refs = append(refs, in.Spec.DestinationEndpointRef.ServiceIntegrationEndpoint(in.Namespace))
+	}
+	return refs
+}
+
 func (in *ServiceIntegration) GetUserConfig() (any, error) {
 	configs := map[string]any{
 		"clickhouse_kafka":                in.Spec.ClickhouseKafkaUserConfig,
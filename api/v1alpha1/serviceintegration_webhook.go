@@ -3,6 +3,8 @@
 package v1alpha1
 
 import (
+	"fmt"
+
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -15,6 +17,7 @@ var serviceintegrationlog = logf.Log.WithName("serviceintegration-resource")
 func (in *ServiceIntegration) SetupWebhookWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(in).
+		WithDefaulter(newObjectDefaulter(mgr)).
 		Complete()
 }
 
@@ -35,18 +38,14 @@ var _ webhook.Validator = &ServiceIntegration{}
 func (in *ServiceIntegration) ValidateCreate() error {
 	serviceintegrationlog.Info("validate create", "name", in.Name)
 
-	// We need the validation here only
-	_, err := in.GetUserConfig()
-	return err
+	return in.validate()
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
 func (in *ServiceIntegration) ValidateUpdate(old runtime.Object) error {
 	serviceintegrationlog.Info("validate update", "name", in.Name)
 
-	// We need the validation here only
-	_, err := in.GetUserConfig()
-	return err
+	return in.validate()
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
@@ -55,3 +54,18 @@ func (in *ServiceIntegration) ValidateDelete() error {
 
 	return nil
 }
+
+func (in *ServiceIntegration) validate() error {
+	// todo: remove when resolved https://github.com/kubernetes-sigs/controller-tools/issues/461
+	if in.Spec.SourceEndpointID != "" && in.Spec.SourceEndpointRef != nil {
+		return fmt.Errorf("please set sourceEndpointID or sourceEndpointRef, not both")
+	}
+
+	if in.Spec.DestinationEndpointID != "" && in.Spec.DestinationEndpointRef != nil {
+		return fmt.Errorf("please set destinationEndpointId or destinationEndpointRef, not both")
+	}
+
+	// We need the validation here only
+	_, err := in.GetUserConfig()
+	return err
+}
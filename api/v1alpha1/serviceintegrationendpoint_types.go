@@ -0,0 +1,168 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	datadogendpoint "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/integrationendpoint/datadog"
+	externalelasticsearchlogsendpoint "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/integrationendpoint/external_elasticsearch_logs"
+	externalgooglecloudloggingendpoint "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/integrationendpoint/external_google_cloud_logging"
+	externalkafkaendpoint "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/integrationendpoint/external_kafka"
+	externalopensearchlogsendpoint "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/integrationendpoint/external_opensearch_logs"
+	externalschemaregistryendpoint "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/integrationendpoint/external_schema_registry"
+	prometheusendpoint "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/integrationendpoint/prometheus"
+	rsyslogendpoint "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/integrationendpoint/rsyslog"
+)
+
+// ServiceIntegrationEndpointSpec defines the desired state of ServiceIntegrationEndpoint
+type ServiceIntegrationEndpointSpec struct {
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Format="^[a-zA-Z0-9_-]*$"
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Project the integration endpoint belongs to
+	Project string `json:"project"`
+
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=36
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Name of the integration endpoint
+	EndpointName string `json:"endpointName"`
+
+	// +kubebuilder:validation:Enum=datadog;external_elasticsearch_logs;external_google_cloud_logging;external_kafka;external_opensearch_logs;external_schema_registry;prometheus;rsyslog
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Type of the integration endpoint
+	EndpointType string `json:"endpointType"`
+
+	// Datadog configuration values
+	DatadogUserConfig *datadogendpoint.DatadogUserConfig `json:"datadog,omitempty"`
+
+	// External Elasticsearch logs configuration values
+	ExternalElasticsearchLogsUserConfig *externalelasticsearchlogsendpoint.ExternalElasticsearchLogsUserConfig `json:"externalElasticsearchLogs,omitempty"`
+
+	// Google Cloud Logging configuration values
+	ExternalGoogleCloudLoggingUserConfig *externalgooglecloudloggingendpoint.ExternalGoogleCloudLoggingUserConfig `json:"externalGoogleCloudLogging,omitempty"`
+
+	// External Kafka configuration values
+	ExternalKafkaUserConfig *externalkafkaendpoint.ExternalKafkaUserConfig `json:"externalKafka,omitempty"`
+
+	// External OpenSearch logs configuration values
+	ExternalOpensearchLogsUserConfig *externalopensearchlogsendpoint.ExternalOpensearchLogsUserConfig `json:"externalOpensearchLogs,omitempty"`
+
+	// External Schema Registry configuration values
+	ExternalSchemaRegistryUserConfig *externalschemaregistryendpoint.ExternalSchemaRegistryUserConfig `json:"externalSchemaRegistry,omitempty"`
+
+	// Prometheus configuration values
+	PrometheusUserConfig *prometheusendpoint.PrometheusUserConfig `json:"prometheus,omitempty"`
+
+	// Rsyslog configuration values
+	RsyslogUserConfig *rsyslogendpoint.RsyslogUserConfig `json:"rsyslog,omitempty"`
+
+	// Sets user config values from secrets in the same namespace, like API keys, passwords and certificates.
+	// Changes of the secrets are applied to the endpoint
+	ConfigFrom []ServiceIntegrationEndpointConfigFrom `json:"configFrom,omitempty"`
+
+	// Authentication reference to Aiven token in a secret
+	AuthSecretRef *AuthSecretReference `json:"authSecretRef,omitempty"`
+}
+
+// ServiceIntegrationEndpointConfigFrom sets a user config value from a secret
+type ServiceIntegrationEndpointConfigFrom struct {
+	// +kubebuilder:validation:MinLength=1
+	// User config key, like datadog_api_key or basic_auth_password
+	Key string `json:"key"`
+
+	// Selects a key of a secret
+	SecretKeyRef ServiceIntegrationEndpointKeySelector `json:"secretKeyRef"`
+}
+
+// ServiceIntegrationEndpointKeySelector selects a key of a secret in the same namespace
+type ServiceIntegrationEndpointKeySelector struct {
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:MinLength=1
+	Key string `json:"key"`
+}
+
+// ServiceIntegrationEndpointStatus defines the observed state of ServiceIntegrationEndpoint
+type ServiceIntegrationEndpointStatus struct {
+	// Conditions represent the latest available observations of an ServiceIntegrationEndpoint state
+	Conditions []metav1.Condition `json:"conditions"`
+
+	// Service integration endpoint ID
+	ID string `json:"id,omitempty"`
+
+	// Hash of the values set from configFrom, the secrets changes are applied when it differs
+	ConfigHash string `json:"configHash,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// ServiceIntegrationEndpoint is the Schema for the serviceintegrationendpoints API.
+// ServiceIntegration uses it with sourceEndpointRef and destinationEndpointRef
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
+// +kubebuilder:printcolumn:name="Endpoint Name",type="string",JSONPath=".spec.endpointName"
+// +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".spec.endpointType"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.id"
+type ServiceIntegrationEndpoint struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceIntegrationEndpointSpec   `json:"spec,omitempty"`
+	Status ServiceIntegrationEndpointStatus `json:"status,omitempty"`
+}
+
+func (in *ServiceIntegrationEndpoint) AuthSecretRef() *AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+// serviceIntegrationEndpointSecretFields required fields which are expected to be set from secrets
+var serviceIntegrationEndpointSecretFields = map[string]string{
+	"datadog":                       "datadog_api_key",
+	"external_elasticsearch_logs":   "url",
+	"external_google_cloud_logging": "service_account_credentials",
+	"external_opensearch_logs":      "url",
+	"external_schema_registry":      "url",
+}
+
+func (in *ServiceIntegrationEndpoint) GetUserConfig() (any, error) {
+	configs := map[string]any{
+		"datadog":                       in.Spec.DatadogUserConfig,
+		"external_elasticsearch_logs":   in.Spec.ExternalElasticsearchLogsUserConfig,
+		"external_google_cloud_logging": in.Spec.ExternalGoogleCloudLoggingUserConfig,
+		"external_kafka":                in.Spec.ExternalKafkaUserConfig,
+		"external_opensearch_logs":      in.Spec.ExternalOpensearchLogsUserConfig,
+		"external_schema_registry":      in.Spec.ExternalSchemaRegistryUserConfig,
+		"prometheus":                    in.Spec.PrometheusUserConfig,
+		"rsyslog":                       in.Spec.RsyslogUserConfig,
+	}
+
+	thisType := in.Spec.EndpointType
+
+	// Checks if it is the only configuration set
+	for k, v := range configs {
+		if k != thisType && !reflect.ValueOf(v).IsNil() {
+			return nil, fmt.Errorf("got additional configuration for endpoint type %q", k)
+		}
+	}
+
+	return configs[thisType], nil
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceIntegrationEndpointList contains a list of ServiceIntegrationEndpoint
+type ServiceIntegrationEndpointList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceIntegrationEndpoint `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ServiceIntegrationEndpoint{}, &ServiceIntegrationEndpointList{})
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// log is for logging in this package.
+var serviceintegrationendpointlog = logf.Log.WithName("serviceintegrationendpoint-resource")
+
+func (in *ServiceIntegrationEndpoint) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(in).
+		WithDefaulter(newObjectDefaulter(mgr)).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-aiven-io-v1alpha1-serviceintegrationendpoint,mutating=true,failurePolicy=fail,sideEffects=None,groups=aiven.io,resources=serviceintegrationendpoints,verbs=create;update,versions=v1alpha1,name=mserviceintegrationendpoint.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &ServiceIntegrationEndpoint{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (in *ServiceIntegrationEndpoint) Default() {
+	serviceintegrationendpointlog.Info("default", "name", in.Name)
+}
+
+//+kubebuilder:webhook:path=/validate-aiven-io-v1alpha1-serviceintegrationendpoint,mutating=false,failurePolicy=fail,sideEffects=None,groups=aiven.io,resources=serviceintegrationendpoints,verbs=create;update,versions=v1alpha1,name=vserviceintegrationendpoint.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &ServiceIntegrationEndpoint{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (in *ServiceIntegrationEndpoint) ValidateCreate() error {
+	serviceintegrationendpointlog.Info("validate create", "name", in.Name)
+	return in.validateUserConfig()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (in *ServiceIntegrationEndpoint) ValidateUpdate(old runtime.Object) error {
+	serviceintegrationendpointlog.Info("validate update", "name", in.Name)
+	return in.validateUserConfig()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (in *ServiceIntegrationEndpoint) ValidateDelete() error {
+	serviceintegrationendpointlog.Info("validate delete", "name", in.Name)
+	return nil
+}
+
+// validateUserConfig checks configFrom doesn't override the user config,
+// and the secret fields are set either way
+func (in *ServiceIntegrationEndpoint) validateUserConfig() error {
+	userConfig, err := in.GetUserConfig()
+	if err != nil {
+		return err
+	}
+
+	fields := make(map[string]any)
+	b, err := json.Marshal(userConfig)
+	if err != nil {
+		return err
+	}
+
+	// Typed nil is marshalled to "null"
+	err = json.Unmarshal(b, &fields)
+	if err != nil {
+		return err
+	}
+
+	// "null" resets the map
+	if fields == nil {
+		fields = make(map[string]any)
+	}
+
+	for _, c := range in.Spec.ConfigFrom {
+		if _, ok := fields[c.Key]; ok {
+			return fmt.Errorf("configFrom key %q is set more than once", c.Key)
+		}
+		fields[c.Key] = true
+	}
+
+	if f, ok := serviceIntegrationEndpointSecretFields[in.Spec.EndpointType]; ok {
+		if _, ok := fields[f]; !ok {
+			return fmt.Errorf("endpoint type %q requires %q, set it in the user config or with configFrom", in.Spec.EndpointType, f)
+		}
+	}
+	return nil
+}
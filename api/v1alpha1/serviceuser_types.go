@@ -21,13 +21,35 @@ type ServiceUserSpec struct {
 	// Authentication details
 	Authentication string `json:"authentication,omitempty"`
 
+	// Access control rules, Redis services only. Updated in place, removing it resets the rules
+	AccessControl *ServiceUserAccessControl `json:"accessControl,omitempty"`
+
 	// Information regarding secret creation
 	ConnInfoSecretTarget ConnInfoSecretTarget `json:"connInfoSecretTarget,omitempty"`
 
+	// Sets the user password from the secret instead of the generated one.
+	// The password is updated when the secret changes
+	PasswordSecretRef *PasswordSecretReference `json:"passwordSecretRef,omitempty"`
+
 	// Authentication reference to Aiven token in a secret
 	AuthSecretRef *AuthSecretReference `json:"authSecretRef,omitempty"`
 }
 
+// ServiceUserAccessControl defines Redis ACL rules of the user
+type ServiceUserAccessControl struct {
+	// Redis ACL categories, for instance `+@all` or `-@dangerous`
+	RedisACLCategories []string `json:"redisAclCategories,omitempty"`
+
+	// Redis ACL commands, for instance `+get` or `-flushall`
+	RedisACLCommands []string `json:"redisAclCommands,omitempty"`
+
+	// Redis ACL key patterns
+	RedisACLKeys []string `json:"redisAclKeys,omitempty"`
+
+	// Redis ACL channel patterns
+	RedisACLChannels []string `json:"redisAclChannels,omitempty"`
+}
+
 // ServiceUserStatus defines the observed state of ServiceUser
 type ServiceUserStatus struct {
 	// Conditions represent the latest available observations of an ServiceUser state
@@ -35,6 +57,12 @@ type ServiceUserStatus struct {
 
 	// Type of the user account
 	Type string `json:"type,omitempty"`
+
+	// Effective authentication method
+	Authentication string `json:"authentication,omitempty"`
+
+	// Effective access control rules
+	AccessControl *ServiceUserAccessControl `json:"accessControl,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -56,6 +84,10 @@ func (svcusr ServiceUser) AuthSecretRef() *AuthSecretReference {
 	return svcusr.Spec.AuthSecretRef
 }
 
+func (svcusr ServiceUser) PasswordSecretRef() *PasswordSecretReference {
+	return svcusr.Spec.PasswordSecretRef
+}
+
 // +kubebuilder:object:root=true
 
 // ServiceUserList contains a list of ServiceUser
@@ -17,6 +17,7 @@ var serviceuserlog = logf.Log.WithName("serviceuser-resource")
 func (r *ServiceUser) SetupWebhookWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(r).
+		WithDefaulter(newObjectDefaulter(mgr)).
 		Complete()
 }
 
@@ -28,6 +29,8 @@ var _ webhook.Defaulter = &ServiceUser{}
 func (r *ServiceUser) Default() {
 	serviceuserlog.Info("default", "name", r.Name)
 
+	r.Spec.ConnInfoSecretTarget.Name = secretTargetName(r, r.Spec.ConnInfoSecretTarget)
+
 }
 
 //+kubebuilder:webhook:verbs=create;update,path=/validate-aiven-io-v1alpha1-serviceuser,mutating=false,failurePolicy=fail,groups=aiven.io,resources=serviceusers,versions=v1alpha1,name=vserviceuser.kb.io,sideEffects=none,admissionReviewVersions=v1
@@ -38,7 +41,7 @@ var _ webhook.Validator = &ServiceUser{}
 func (r *ServiceUser) ValidateCreate() error {
 	serviceuserlog.Info("validate create", "name", r.Name)
 
-	return nil
+	return validatePasswordSecretRef(r, r.Spec.PasswordSecretRef, r.Spec.ConnInfoSecretTarget)
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
@@ -53,11 +56,11 @@ func (r *ServiceUser) ValidateUpdate(old runtime.Object) error {
 		return errors.New("cannot update a Service User, serviceName field is immutable and cannot be updated")
 	}
 
-	if r.Spec.ConnInfoSecretTarget.Name != old.(*ServiceUser).Spec.ConnInfoSecretTarget.Name {
+	if secretTargetName(r, r.Spec.ConnInfoSecretTarget) != secretTargetName(old.(*ServiceUser), old.(*ServiceUser).Spec.ConnInfoSecretTarget) {
 		return errors.New("cannot update a ServiceUser, connInfoSecretTarget.name field is immutable and cannot be updated")
 	}
 
-	return nil
+	return validatePasswordSecretRef(r, r.Spec.PasswordSecretRef, r.Spec.ConnInfoSecretTarget)
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
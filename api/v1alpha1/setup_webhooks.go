@@ -6,11 +6,12 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
-func SetupWebhooks(mgr ctrl.Manager) error {
+// SetupWebhooks registers webhooks. Catalog is optional, validates service plans if set
+func SetupWebhooks(mgr ctrl.Manager, catalog ServiceCatalog) error {
 	if err := (&Project{}).SetupWebhookWithManager(mgr); err != nil {
 		return fmt.Errorf("webhook Project: %w", err)
 	}
-	if err := (&PostgreSQL{}).SetupWebhookWithManager(mgr); err != nil {
+	if err := (&PostgreSQL{}).SetupWebhookWithManager(mgr, catalog); err != nil {
 		return fmt.Errorf("webhook PostgreSQL: %w", err)
 	}
 	if err := (&Database{}).SetupWebhookWithManager(mgr); err != nil {
@@ -22,10 +23,10 @@ func SetupWebhooks(mgr ctrl.Manager) error {
 	if err := (&ServiceUser{}).SetupWebhookWithManager(mgr); err != nil {
 		return fmt.Errorf("webhook ServiceUser: %w", err)
 	}
-	if err := (&Kafka{}).SetupWebhookWithManager(mgr); err != nil {
+	if err := (&Kafka{}).SetupWebhookWithManager(mgr, catalog); err != nil {
 		return fmt.Errorf("webhook Kafka: %w", err)
 	}
-	if err := (&KafkaConnect{}).SetupWebhookWithManager(mgr); err != nil {
+	if err := (&KafkaConnect{}).SetupWebhookWithManager(mgr, catalog); err != nil {
 		return fmt.Errorf("webhook KafkaConnect: %w", err)
 	}
 	if err := (&KafkaTopic{}).SetupWebhookWithManager(mgr); err != nil {
@@ -43,27 +44,69 @@ func SetupWebhooks(mgr ctrl.Manager) error {
 	if err := (&KafkaConnector{}).SetupWebhookWithManager(mgr); err != nil {
 		return fmt.Errorf("webhook KafkaConnector: %w", err)
 	}
-	if err := (&Redis{}).SetupWebhookWithManager(mgr); err != nil {
+	if err := (&Redis{}).SetupWebhookWithManager(mgr, catalog); err != nil {
 		return fmt.Errorf("webhook Redis: %w", err)
 	}
-	if err := (&OpenSearch{}).SetupWebhookWithManager(mgr); err != nil {
+	if err := (&OpenSearch{}).SetupWebhookWithManager(mgr, catalog); err != nil {
 		return fmt.Errorf("webhook OpenSearch: %w", err)
 	}
-	if err := (&Clickhouse{}).SetupWebhookWithManager(mgr); err != nil {
+	if err := (&Clickhouse{}).SetupWebhookWithManager(mgr, catalog); err != nil {
 		return fmt.Errorf("webhook Clickhouse: %w", err)
 	}
 	if err := (&ClickhouseUser{}).SetupWebhookWithManager(mgr); err != nil {
 		return fmt.Errorf("webhook ClickhouseUser: %w", err)
 	}
-	if err := (&MySQL{}).SetupWebhookWithManager(mgr); err != nil {
+	if err := (&MySQL{}).SetupWebhookWithManager(mgr, catalog); err != nil {
 		return fmt.Errorf("webhook MySQL: %w", err)
 	}
-	if err := (&Cassandra{}).SetupWebhookWithManager(mgr); err != nil {
+	if err := (&Cassandra{}).SetupWebhookWithManager(mgr, catalog); err != nil {
 		return fmt.Errorf("webhook Cassandra: %w", err)
 	}
-	if err := (&Grafana{}).SetupWebhookWithManager(mgr); err != nil {
+	if err := (&Grafana{}).SetupWebhookWithManager(mgr, catalog); err != nil {
 		return fmt.Errorf("webhook Grafana: %w", err)
 	}
+	if err := (&ClickhouseRole{}).SetupWebhookWithManager(mgr); err != nil {
+		return fmt.Errorf("webhook ClickhouseRole: %w", err)
+	}
+	if err := (&ClickhouseGrant{}).SetupWebhookWithManager(mgr); err != nil {
+		return fmt.Errorf("webhook ClickhouseGrant: %w", err)
+	}
+	if err := (&ClickhouseDatabase{}).SetupWebhookWithManager(mgr); err != nil {
+		return fmt.Errorf("webhook ClickhouseDatabase: %w", err)
+	}
+	if err := (&StaticIP{}).SetupWebhookWithManager(mgr); err != nil {
+		return fmt.Errorf("webhook StaticIP: %w", err)
+	}
+	if err := (&ServiceIntegrationEndpoint{}).SetupWebhookWithManager(mgr); err != nil {
+		return fmt.Errorf("webhook ServiceIntegrationEndpoint: %w", err)
+	}
+	if err := (&VPCPeeringConnection{}).SetupWebhookWithManager(mgr); err != nil {
+		return fmt.Errorf("webhook VPCPeeringConnection: %w", err)
+	}
+	if err := (&AccountTeam{}).SetupWebhookWithManager(mgr); err != nil {
+		return fmt.Errorf("webhook AccountTeam: %w", err)
+	}
+	if err := (&AccountTeamMember{}).SetupWebhookWithManager(mgr); err != nil {
+		return fmt.Errorf("webhook AccountTeamMember: %w", err)
+	}
+	if err := (&AccountTeamProject{}).SetupWebhookWithManager(mgr); err != nil {
+		return fmt.Errorf("webhook AccountTeamProject: %w", err)
+	}
+	if err := (&BillingGroup{}).SetupWebhookWithManager(mgr); err != nil {
+		return fmt.Errorf("webhook BillingGroup: %w", err)
+	}
+	if err := (&Flink{}).SetupWebhookWithManager(mgr, catalog); err != nil {
+		return fmt.Errorf("webhook Flink: %w", err)
+	}
+	if err := (&FlinkApplication{}).SetupWebhookWithManager(mgr); err != nil {
+		return fmt.Errorf("webhook FlinkApplication: %w", err)
+	}
+	if err := (&KafkaMirrorMaker{}).SetupWebhookWithManager(mgr, catalog); err != nil {
+		return fmt.Errorf("webhook KafkaMirrorMaker: %w", err)
+	}
+	if err := (&KafkaReplicationFlow{}).SetupWebhookWithManager(mgr); err != nil {
+		return fmt.Errorf("webhook KafkaReplicationFlow: %w", err)
+	}
 
 	//+kubebuilder:scaffold:builder
 	return nil
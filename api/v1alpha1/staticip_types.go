@@ -0,0 +1,77 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// StaticIPSpec defines the desired state of StaticIP
+type StaticIPSpec struct {
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Format="^[a-zA-Z0-9_-]*$"
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// The project the static IP belongs to
+	Project string `json:"project"`
+
+	// +kubebuilder:validation:MaxLength=256
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Cloud the static IP is in, must match the cloud of the services using it
+	CloudName string `json:"cloudName"`
+
+	// Authentication reference to Aiven token in a secret
+	AuthSecretRef *AuthSecretReference `json:"authSecretRef,omitempty"`
+}
+
+// StaticIPStatus defines the observed state of StaticIP
+type StaticIPStatus struct {
+	// Conditions represent the latest available observations of an StaticIP state
+	Conditions []metav1.Condition `json:"conditions"`
+
+	// Static IP address id
+	ID string `json:"id,omitempty"`
+
+	// The IP address
+	IPAddress string `json:"ipAddress,omitempty"`
+
+	// State of the static IP: creating, created, available, assigned, deleting or deleted
+	State string `json:"state,omitempty"`
+
+	// The service the static IP is associated with
+	ServiceName string `json:"serviceName,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// StaticIP is the Schema for the staticips API.
+// Services use it with staticIPRefs
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
+// +kubebuilder:printcolumn:name="Cloud",type="string",JSONPath=".spec.cloudName"
+// +kubebuilder:printcolumn:name="IP Address",type="string",JSONPath=".status.ipAddress"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
+// +kubebuilder:printcolumn:name="Service",type="string",JSONPath=".status.serviceName"
+type StaticIP struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   StaticIPSpec   `json:"spec,omitempty"`
+	Status StaticIPStatus `json:"status,omitempty"`
+}
+
+func (in *StaticIP) AuthSecretRef() *AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+// +kubebuilder:object:root=true
+
+// StaticIPList contains a list of StaticIP
+type StaticIPList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []StaticIP `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&StaticIP{}, &StaticIPList{})
+}
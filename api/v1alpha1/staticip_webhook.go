@@ -0,0 +1,57 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// log is for logging in this package.
+var staticiplog = logf.Log.WithName("staticip-resource")
+
+func (r *StaticIP) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		WithDefaulter(newObjectDefaulter(mgr)).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-aiven-io-v1alpha1-staticip,mutating=true,failurePolicy=fail,sideEffects=None,groups=aiven.io,resources=staticips,verbs=create;update,versions=v1alpha1,name=mstaticip.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &StaticIP{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *StaticIP) Default() {
+	staticiplog.Info("default", "name", r.Name)
+}
+
+//+kubebuilder:webhook:path=/validate-aiven-io-v1alpha1-staticip,mutating=false,failurePolicy=fail,sideEffects=None,groups=aiven.io,resources=staticips,verbs=create;update;delete,versions=v1alpha1,name=vstaticip.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &StaticIP{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *StaticIP) ValidateCreate() error {
+	staticiplog.Info("validate create", "name", r.Name)
+	return nil
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *StaticIP) ValidateUpdate(old runtime.Object) error {
+	staticiplog.Info("validate update", "name", r.Name)
+	return nil
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *StaticIP) ValidateDelete() error {
+	staticiplog.Info("validate delete", "name", r.Name)
+
+	if r.Status.ServiceName != "" {
+		return fmt.Errorf("cannot delete StaticIP, it is associated with service %q, remove it from the service staticIPRefs first", r.Status.ServiceName)
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+// Code generated by user config generator. DO NOT EDIT.
+// +kubebuilder:object:generate=true
+
+package externalelasticsearchlogsuserconfig
+
+type ExternalElasticsearchLogsUserConfig struct {
+	// +kubebuilder:validation:MaxLength=16384
+	// PEM encoded CA certificate
+	Ca *string `groups:"create,update" json:"ca,omitempty"`
+
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10000
+	// Maximum number of days of logs to keep
+	IndexDaysMax *int `groups:"create,update" json:"index_days_max,omitempty"`
+
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=1000
+	// +kubebuilder:validation:Pattern=`^[a-z0-9][a-z0-9-_.]+$`
+	// Elasticsearch index prefix
+	IndexPrefix string `groups:"create,update" json:"index_prefix"`
+
+	// +kubebuilder:validation:Minimum=10
+	// +kubebuilder:validation:Maximum=120
+	// Elasticsearch request timeout limit
+	Timeout *float64 `groups:"create,update" json:"timeout,omitempty"`
+
+	// +kubebuilder:validation:MinLength=12
+	// +kubebuilder:validation:MaxLength=2048
+	// Elasticsearch connection URL
+	Url *string `groups:"create,update" json:"url,omitempty"`
+}
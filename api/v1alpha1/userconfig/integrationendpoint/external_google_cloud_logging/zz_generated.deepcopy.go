@@ -0,0 +1,30 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package externalgooglecloudlogginguserconfig
+
+import ()
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalGoogleCloudLoggingUserConfig) DeepCopyInto(out *ExternalGoogleCloudLoggingUserConfig) {
+	*out = *in
+	if in.ServiceAccountCredentials != nil {
+		in, out := &in.ServiceAccountCredentials, &out.ServiceAccountCredentials
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalGoogleCloudLoggingUserConfig.
+func (in *ExternalGoogleCloudLoggingUserConfig) DeepCopy() *ExternalGoogleCloudLoggingUserConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalGoogleCloudLoggingUserConfig)
+	in.DeepCopyInto(out)
+	return out
+}
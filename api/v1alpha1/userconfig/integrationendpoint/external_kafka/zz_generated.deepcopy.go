@@ -0,0 +1,60 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package externalkafkauserconfig
+
+import ()
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalKafkaUserConfig) DeepCopyInto(out *ExternalKafkaUserConfig) {
+	*out = *in
+	if in.SaslMechanism != nil {
+		in, out := &in.SaslMechanism, &out.SaslMechanism
+		*out = new(string)
+		**out = **in
+	}
+	if in.SaslPlainPassword != nil {
+		in, out := &in.SaslPlainPassword, &out.SaslPlainPassword
+		*out = new(string)
+		**out = **in
+	}
+	if in.SaslPlainUsername != nil {
+		in, out := &in.SaslPlainUsername, &out.SaslPlainUsername
+		*out = new(string)
+		**out = **in
+	}
+	if in.SslCaCert != nil {
+		in, out := &in.SslCaCert, &out.SslCaCert
+		*out = new(string)
+		**out = **in
+	}
+	if in.SslClientCert != nil {
+		in, out := &in.SslClientCert, &out.SslClientCert
+		*out = new(string)
+		**out = **in
+	}
+	if in.SslClientKey != nil {
+		in, out := &in.SslClientKey, &out.SslClientKey
+		*out = new(string)
+		**out = **in
+	}
+	if in.SslEndpointIdentificationAlgorithm != nil {
+		in, out := &in.SslEndpointIdentificationAlgorithm, &out.SslEndpointIdentificationAlgorithm
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalKafkaUserConfig.
+func (in *ExternalKafkaUserConfig) DeepCopy() *ExternalKafkaUserConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalKafkaUserConfig)
+	in.DeepCopyInto(out)
+	return out
+}
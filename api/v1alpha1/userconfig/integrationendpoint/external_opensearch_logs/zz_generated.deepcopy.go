@@ -0,0 +1,45 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package externalopensearchlogsuserconfig
+
+import ()
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalOpensearchLogsUserConfig) DeepCopyInto(out *ExternalOpensearchLogsUserConfig) {
+	*out = *in
+	if in.Ca != nil {
+		in, out := &in.Ca, &out.Ca
+		*out = new(string)
+		**out = **in
+	}
+	if in.IndexDaysMax != nil {
+		in, out := &in.IndexDaysMax, &out.IndexDaysMax
+		*out = new(int)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(float64)
+		**out = **in
+	}
+	if in.Url != nil {
+		in, out := &in.Url, &out.Url
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalOpensearchLogsUserConfig.
+func (in *ExternalOpensearchLogsUserConfig) DeepCopy() *ExternalOpensearchLogsUserConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalOpensearchLogsUserConfig)
+	in.DeepCopyInto(out)
+	return out
+}
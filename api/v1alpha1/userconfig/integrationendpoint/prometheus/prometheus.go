@@ -0,0 +1,17 @@
+// Code generated by user config generator. DO NOT EDIT.
+// +kubebuilder:object:generate=true
+
+package prometheususerconfig
+
+type PrometheusUserConfig struct {
+	// +kubebuilder:validation:MinLength=8
+	// +kubebuilder:validation:MaxLength=64
+	// Prometheus basic authentication password
+	BasicAuthPassword *string `groups:"create,update" json:"basic_auth_password,omitempty"`
+
+	// +kubebuilder:validation:MinLength=5
+	// +kubebuilder:validation:MaxLength=32
+	// +kubebuilder:validation:Pattern=`^[a-z0-9\-@_]{5,32}$`
+	// Prometheus basic authentication username
+	BasicAuthUsername *string `groups:"create,update" json:"basic_auth_username,omitempty"`
+}
@@ -0,0 +1,35 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package prometheususerconfig
+
+import ()
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusUserConfig) DeepCopyInto(out *PrometheusUserConfig) {
+	*out = *in
+	if in.BasicAuthPassword != nil {
+		in, out := &in.BasicAuthPassword, &out.BasicAuthPassword
+		*out = new(string)
+		**out = **in
+	}
+	if in.BasicAuthUsername != nil {
+		in, out := &in.BasicAuthUsername, &out.BasicAuthUsername
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusUserConfig.
+func (in *PrometheusUserConfig) DeepCopy() *PrometheusUserConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusUserConfig)
+	in.DeepCopyInto(out)
+	return out
+}
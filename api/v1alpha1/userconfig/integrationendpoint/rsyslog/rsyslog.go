@@ -0,0 +1,44 @@
+// Code generated by user config generator. DO NOT EDIT.
+// +kubebuilder:object:generate=true
+
+package rsysloguserconfig
+
+type RsyslogUserConfig struct {
+	// +kubebuilder:validation:MaxLength=16384
+	// PEM encoded CA certificate
+	Ca *string `groups:"create,update" json:"ca,omitempty"`
+
+	// +kubebuilder:validation:MaxLength=16384
+	// PEM encoded client certificate
+	Cert *string `groups:"create,update" json:"cert,omitempty"`
+
+	// +kubebuilder:validation:Enum="rfc5424";"rfc3164";"custom"
+	// message format
+	Format string `groups:"create,update" json:"format"`
+
+	// +kubebuilder:validation:MaxLength=16384
+	// PEM encoded client key
+	Key *string `groups:"create,update" json:"key,omitempty"`
+
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=512
+	// custom syslog message format
+	Logline *string `groups:"create,update" json:"logline,omitempty"`
+
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// rsyslog server port
+	Port int `groups:"create,update" json:"port"`
+
+	// +kubebuilder:validation:MaxLength=1024
+	// Structured data block for log message
+	Sd *string `groups:"create,update" json:"sd,omitempty"`
+
+	// +kubebuilder:validation:MinLength=4
+	// +kubebuilder:validation:MaxLength=255
+	// rsyslog server IP address or hostname
+	Server string `groups:"create,update" json:"server"`
+
+	// Require TLS
+	Tls bool `groups:"create,update" json:"tls"`
+}
@@ -0,0 +1,41 @@
+// Code generated by user config generator. DO NOT EDIT.
+// +kubebuilder:object:generate=true
+
+package flinkuserconfig
+
+// CIDR address block, either as a string, or in a dict with an optional description field
+type IpFilter struct {
+	// +kubebuilder:validation:MaxLength=1024
+	// Description for IP filter list entry
+	Description *string `groups:"create,update" json:"description,omitempty"`
+
+	// +kubebuilder:validation:MaxLength=43
+	// CIDR address block
+	Network string `groups:"create,update" json:"network"`
+}
+
+// Allow access to selected service components through Privatelink
+type PrivatelinkAccess struct {
+	// Enable flink
+	Flink *bool `groups:"create,update" json:"flink,omitempty"`
+
+	// Enable prometheus
+	Prometheus *bool `groups:"create,update" json:"prometheus,omitempty"`
+}
+type FlinkUserConfig struct {
+	// +kubebuilder:validation:Enum="1.16"
+	// Flink major version
+	FlinkVersion *string `groups:"create,update" json:"flink_version,omitempty"`
+
+	// +kubebuilder:validation:MaxItems=1024
+	// Allow incoming connections from CIDR address block, e.g. '10.20.0.0/16'
+	IpFilter []*IpFilter `groups:"create,update" json:"ip_filter,omitempty"`
+
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=1024
+	// Task slots per node. For a 3 node plan, total number of task slots is 3x this value
+	NumberOfTaskSlots *int `groups:"create,update" json:"number_of_task_slots,omitempty"`
+
+	// Allow access to selected service components through Privatelink
+	PrivatelinkAccess *PrivatelinkAccess `groups:"create,update" json:"privatelink_access,omitempty"`
+}
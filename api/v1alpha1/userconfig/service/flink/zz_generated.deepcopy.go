@@ -0,0 +1,96 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package flinkuserconfig
+
+import ()
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlinkUserConfig) DeepCopyInto(out *FlinkUserConfig) {
+	*out = *in
+	if in.FlinkVersion != nil {
+		in, out := &in.FlinkVersion, &out.FlinkVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.IpFilter != nil {
+		in, out := &in.IpFilter, &out.IpFilter
+		*out = make([]*IpFilter, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(IpFilter)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.NumberOfTaskSlots != nil {
+		in, out := &in.NumberOfTaskSlots, &out.NumberOfTaskSlots
+		*out = new(int)
+		**out = **in
+	}
+	if in.PrivatelinkAccess != nil {
+		in, out := &in.PrivatelinkAccess, &out.PrivatelinkAccess
+		*out = new(PrivatelinkAccess)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkUserConfig.
+func (in *FlinkUserConfig) DeepCopy() *FlinkUserConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(FlinkUserConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IpFilter) DeepCopyInto(out *IpFilter) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IpFilter.
+func (in *IpFilter) DeepCopy() *IpFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(IpFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivatelinkAccess) DeepCopyInto(out *PrivatelinkAccess) {
+	*out = *in
+	if in.Flink != nil {
+		in, out := &in.Flink, &out.Flink
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Prometheus != nil {
+		in, out := &in.Prometheus, &out.Prometheus
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivatelinkAccess.
+func (in *PrivatelinkAccess) DeepCopy() *PrivatelinkAccess {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivatelinkAccess)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,69 @@
+// Code generated by user config generator. DO NOT EDIT.
+// +kubebuilder:object:generate=true
+
+package kafkamirrormakeruserconfig
+
+// CIDR address block, either as a string, or in a dict with an optional description field
+type IpFilter struct {
+	// +kubebuilder:validation:MaxLength=1024
+	// Description for IP filter list entry
+	Description *string `groups:"create,update" json:"description,omitempty"`
+
+	// +kubebuilder:validation:MaxLength=43
+	// CIDR address block
+	Network string `groups:"create,update" json:"network"`
+}
+
+// Kafka MirrorMaker configuration values
+type KafkaMirrormaker struct {
+	// Whether to emit consumer group offset checkpoints to target cluster periodically (default: true)
+	EmitCheckpointsEnabled *bool `groups:"create,update" json:"emit_checkpoints_enabled,omitempty"`
+
+	// +kubebuilder:validation:Minimum=1
+	// Frequency at which consumer group offset checkpoints are emitted (default: 60, every minute)
+	EmitCheckpointsIntervalSeconds *int `groups:"create,update" json:"emit_checkpoints_interval_seconds,omitempty"`
+
+	// Whether to periodically check for new consumer groups. Defaults to 'true'.
+	RefreshGroupsEnabled *bool `groups:"create,update" json:"refresh_groups_enabled,omitempty"`
+
+	// +kubebuilder:validation:Minimum=1
+	// Frequency of consumer group refresh in seconds. Defaults to 600 seconds (10 minutes).
+	RefreshGroupsIntervalSeconds *int `groups:"create,update" json:"refresh_groups_interval_seconds,omitempty"`
+
+	// Whether to periodically check for new topics and partitions. Defaults to 'true'.
+	RefreshTopicsEnabled *bool `groups:"create,update" json:"refresh_topics_enabled,omitempty"`
+
+	// +kubebuilder:validation:Minimum=1
+	// Frequency of topic and partitions refresh in seconds. Defaults to 600 seconds (10 minutes).
+	RefreshTopicsIntervalSeconds *int `groups:"create,update" json:"refresh_topics_interval_seconds,omitempty"`
+
+	// Whether to periodically write the translated offsets of replicated consumer groups (in the source cluster) to __consumer_offsets topic in target cluster, as long as no active consumers in that group are connected to the target cluster
+	SyncGroupOffsetsEnabled *bool `groups:"create,update" json:"sync_group_offsets_enabled,omitempty"`
+
+	// +kubebuilder:validation:Minimum=1
+	// Frequency at which consumer group offsets are synced (default: 60, every minute)
+	SyncGroupOffsetsIntervalSeconds *int `groups:"create,update" json:"sync_group_offsets_interval_seconds,omitempty"`
+
+	// Whether to periodically configure remote topics to match their corresponding upstream topics.
+	SyncTopicConfigsEnabled *bool `groups:"create,update" json:"sync_topic_configs_enabled,omitempty"`
+
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=4
+	// 'tasks.max' is set to this multiplied by the number of CPUs in the service.
+	TasksMaxPerCpu *int `groups:"create,update" json:"tasks_max_per_cpu,omitempty"`
+}
+type KafkaMirrormakerUserConfig struct {
+	// +kubebuilder:validation:MaxItems=1
+	// Additional Cloud Regions for Backup Replication
+	AdditionalBackupRegions []string `groups:"create,update" json:"additional_backup_regions,omitempty"`
+
+	// +kubebuilder:validation:MaxItems=1024
+	// Allow incoming connections from CIDR address block, e.g. '10.20.0.0/16'
+	IpFilter []*IpFilter `groups:"create,update" json:"ip_filter,omitempty"`
+
+	// Kafka MirrorMaker configuration values
+	KafkaMirrormaker *KafkaMirrormaker `groups:"create,update" json:"kafka_mirrormaker,omitempty"`
+
+	// Use static public IP addresses
+	StaticIps *bool `groups:"create,update" json:"static_ips,omitempty"`
+}
@@ -0,0 +1,136 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+// Copyright (c) 2022 Aiven, Helsinki, Finland. https://aiven.io/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package kafkamirrormakeruserconfig
+
+import ()
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IpFilter) DeepCopyInto(out *IpFilter) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IpFilter.
+func (in *IpFilter) DeepCopy() *IpFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(IpFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaMirrormaker) DeepCopyInto(out *KafkaMirrormaker) {
+	*out = *in
+	if in.EmitCheckpointsEnabled != nil {
+		in, out := &in.EmitCheckpointsEnabled, &out.EmitCheckpointsEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EmitCheckpointsIntervalSeconds != nil {
+		in, out := &in.EmitCheckpointsIntervalSeconds, &out.EmitCheckpointsIntervalSeconds
+		*out = new(int)
+		**out = **in
+	}
+	if in.RefreshGroupsEnabled != nil {
+		in, out := &in.RefreshGroupsEnabled, &out.RefreshGroupsEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RefreshGroupsIntervalSeconds != nil {
+		in, out := &in.RefreshGroupsIntervalSeconds, &out.RefreshGroupsIntervalSeconds
+		*out = new(int)
+		**out = **in
+	}
+	if in.RefreshTopicsEnabled != nil {
+		in, out := &in.RefreshTopicsEnabled, &out.RefreshTopicsEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RefreshTopicsIntervalSeconds != nil {
+		in, out := &in.RefreshTopicsIntervalSeconds, &out.RefreshTopicsIntervalSeconds
+		*out = new(int)
+		**out = **in
+	}
+	if in.SyncGroupOffsetsEnabled != nil {
+		in, out := &in.SyncGroupOffsetsEnabled, &out.SyncGroupOffsetsEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SyncGroupOffsetsIntervalSeconds != nil {
+		in, out := &in.SyncGroupOffsetsIntervalSeconds, &out.SyncGroupOffsetsIntervalSeconds
+		*out = new(int)
+		**out = **in
+	}
+	if in.SyncTopicConfigsEnabled != nil {
+		in, out := &in.SyncTopicConfigsEnabled, &out.SyncTopicConfigsEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TasksMaxPerCpu != nil {
+		in, out := &in.TasksMaxPerCpu, &out.TasksMaxPerCpu
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaMirrormaker.
+func (in *KafkaMirrormaker) DeepCopy() *KafkaMirrormaker {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaMirrormaker)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaMirrormakerUserConfig) DeepCopyInto(out *KafkaMirrormakerUserConfig) {
+	*out = *in
+	if in.AdditionalBackupRegions != nil {
+		in, out := &in.AdditionalBackupRegions, &out.AdditionalBackupRegions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IpFilter != nil {
+		in, out := &in.IpFilter, &out.IpFilter
+		*out = make([]*IpFilter, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(IpFilter)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.KafkaMirrormaker != nil {
+		in, out := &in.KafkaMirrormaker, &out.KafkaMirrormaker
+		*out = new(KafkaMirrormaker)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StaticIps != nil {
+		in, out := &in.StaticIps, &out.StaticIps
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaMirrormakerUserConfig.
+func (in *KafkaMirrormakerUserConfig) DeepCopy() *KafkaMirrormakerUserConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaMirrormakerUserConfig)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VPCPeeringConnectionSpec defines the desired state of VPCPeeringConnection
+type VPCPeeringConnectionSpec struct {
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Format="^[a-zA-Z0-9_-]*$"
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// The project the VPC belongs to
+	Project string `json:"project"`
+
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// ProjectVPC resource to peer
+	ProjectVPCRef ResourceReference `json:"projectVPCRef"`
+
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=1024
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// AWS account ID, GCP project ID, or Azure subscription ID of the peered VPC
+	PeerCloudAccount string `json:"peerCloudAccount"`
+
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=1024
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// AWS VPC ID, GCP VPC network name, or Azure virtual network name of the peered VPC
+	PeerVPC string `json:"peerVPC"`
+
+	// +kubebuilder:validation:MaxLength=1024
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// AWS region of the peered VPC, if it is not in the same region as the project VPC
+	PeerRegion string `json:"peerRegion,omitempty"`
+
+	// +kubebuilder:validation:MaxLength=1024
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Azure resource group name of the peered VPC
+	PeerResourceGroup string `json:"peerResourceGroup,omitempty"`
+
+	// +kubebuilder:validation:MaxLength=1024
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Azure app registration ID in UUID4 form that is allowed to create a peering to the peer VPC
+	PeerAzureAppID string `json:"peerAzureAppId,omitempty"`
+
+	// +kubebuilder:validation:MaxLength=1024
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// Azure tenant ID in UUID4 form
+	PeerAzureTenantID string `json:"peerAzureTenantId,omitempty"`
+
+	// +kubebuilder:validation:MaxItems=128
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	// List of private IPv4 ranges to route through the peering connection
+	UserPeerNetworkCIDRs []string `json:"userPeerNetworkCidrs,omitempty"`
+
+	// Authentication reference to Aiven token in a secret
+	AuthSecretRef *AuthSecretReference `json:"authSecretRef,omitempty"`
+}
+
+// VPCPeeringConnectionStatus defines the observed state of VPCPeeringConnection
+type VPCPeeringConnectionStatus struct {
+	// Conditions represent the latest available observations of an VPCPeeringConnection state
+	Conditions []metav1.Condition `json:"conditions"`
+
+	// Project VPC id the connection belongs to
+	ProjectVPCID string `json:"projectVpcId,omitempty"`
+
+	// State of the peering connection: APPROVED, PENDING_PEER, ACTIVE, DELETED,
+	// DELETED_BY_PEER, REJECTED_BY_PEER or INVALID_SPECIFICATION
+	State string `json:"state,omitempty"`
+
+	// State details, like the message of an invalid specification
+	StateInfo map[string]string `json:"stateInfo,omitempty"`
+
+	// AWS VPC peering connection ID to accept in the peer account, when the state is PENDING_PEER
+	AWSVPCPeeringConnectionID string `json:"awsVpcPeeringConnectionId,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// VPCPeeringConnection is the Schema for the vpcpeeringconnections API
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.project"
+// +kubebuilder:printcolumn:name="Peer Cloud Account",type="string",JSONPath=".spec.peerCloudAccount"
+// +kubebuilder:printcolumn:name="Peer VPC",type="string",JSONPath=".spec.peerVPC"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state"
+type VPCPeeringConnection struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VPCPeeringConnectionSpec   `json:"spec,omitempty"`
+	Status VPCPeeringConnectionStatus `json:"status,omitempty"`
+}
+
+func (in *VPCPeeringConnection) AuthSecretRef() *AuthSecretReference {
+	return in.Spec.AuthSecretRef
+}
+
+// GetRefs returns the ProjectVPC, it must be active before the connection is created
+func (in *VPCPeeringConnection) GetRefs() []*ResourceReferenceObject {
+	return []*ResourceReferenceObject{in.Spec.ProjectVPCRef.ProjectVPC(in.Namespace)}
+}
+
+// +kubebuilder:object:root=true
+
+// VPCPeeringConnectionList contains a list of VPCPeeringConnection
+type VPCPeeringConnectionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VPCPeeringConnection `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&VPCPeeringConnection{}, &VPCPeeringConnectionList{})
+}
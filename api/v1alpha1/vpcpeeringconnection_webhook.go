@@ -0,0 +1,69 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package v1alpha1
+
+import (
+	"errors"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// log is for logging in this package.
+var vpcpeeringconnectionlog = logf.Log.WithName("vpcpeeringconnection-resource")
+
+func (r *VPCPeeringConnection) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		WithDefaulter(newObjectDefaulter(mgr)).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-aiven-io-v1alpha1-vpcpeeringconnection,mutating=true,failurePolicy=fail,sideEffects=None,groups=aiven.io,resources=vpcpeeringconnections,verbs=create;update,versions=v1alpha1,name=mvpcpeeringconnection.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &VPCPeeringConnection{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *VPCPeeringConnection) Default() {
+	vpcpeeringconnectionlog.Info("default", "name", r.Name)
+}
+
+//+kubebuilder:webhook:path=/validate-aiven-io-v1alpha1-vpcpeeringconnection,mutating=false,failurePolicy=fail,sideEffects=None,groups=aiven.io,resources=vpcpeeringconnections,verbs=create;update,versions=v1alpha1,name=vvpcpeeringconnection.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &VPCPeeringConnection{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *VPCPeeringConnection) ValidateCreate() error {
+	vpcpeeringconnectionlog.Info("validate create", "name", r.Name)
+	return r.validateAzure()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *VPCPeeringConnection) ValidateUpdate(old runtime.Object) error {
+	vpcpeeringconnectionlog.Info("validate update", "name", r.Name)
+	return r.validateAzure()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *VPCPeeringConnection) ValidateDelete() error {
+	vpcpeeringconnectionlog.Info("validate delete", "name", r.Name)
+	return nil
+}
+
+// validateAzure checks Azure fields are set together
+func (r *VPCPeeringConnection) validateAzure() error {
+	s := r.Spec
+	set := 0
+	for _, v := range []string{s.PeerResourceGroup, s.PeerAzureAppID, s.PeerAzureTenantID} {
+		if v != "" {
+			set++
+		}
+	}
+
+	if set != 0 && set != 3 {
+		return errors.New("peerResourceGroup, peerAzureAppId and peerAzureTenantId must be set together for Azure")
+	}
+	return nil
+}
@@ -14,14 +14,24 @@ import (
 	external_aws_cloudwatch_metrics "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/integration/external_aws_cloudwatch_metrics"
 	integrationkafka_connect "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/integration/kafka_connect"
 	kafka_logs "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/integration/kafka_logs"
-	kafka_mirrormaker "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/integration/kafka_mirrormaker"
+	integrationkafka_mirrormaker "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/integration/kafka_mirrormaker"
 	logs "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/integration/logs"
 	metrics "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/integration/metrics"
+	integrationendpointdatadog "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/integrationendpoint/datadog"
+	external_elasticsearch_logs "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/integrationendpoint/external_elasticsearch_logs"
+	external_google_cloud_logging "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/integrationendpoint/external_google_cloud_logging"
+	external_kafka "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/integrationendpoint/external_kafka"
+	external_opensearch_logs "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/integrationendpoint/external_opensearch_logs"
+	external_schema_registry "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/integrationendpoint/external_schema_registry"
+	prometheus "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/integrationendpoint/prometheus"
+	rsyslog "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/integrationendpoint/rsyslog"
 	cassandra "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/service/cassandra"
 	clickhouse "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/service/clickhouse"
+	flink "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/service/flink"
 	grafana "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/service/grafana"
 	kafka "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/service/kafka"
 	kafka_connect "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/service/kafka_connect"
+	kafka_mirrormaker "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/service/kafka_mirrormaker"
 	mysql "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/service/mysql"
 	opensearch "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/service/opensearch"
 	pg "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/service/pg"
@@ -31,22 +41,7 @@ import (
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AuthSecretReference) DeepCopyInto(out *AuthSecretReference) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthSecretReference.
-func (in *AuthSecretReference) DeepCopy() *AuthSecretReference {
-	if in == nil {
-		return nil
-	}
-	out := new(AuthSecretReference)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Cassandra) DeepCopyInto(out *Cassandra) {
+func (in *AccountTeam) DeepCopyInto(out *AccountTeam) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -54,18 +49,18 @@ func (in *Cassandra) DeepCopyInto(out *Cassandra) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Cassandra.
-func (in *Cassandra) DeepCopy() *Cassandra {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountTeam.
+func (in *AccountTeam) DeepCopy() *AccountTeam {
 	if in == nil {
 		return nil
 	}
-	out := new(Cassandra)
+	out := new(AccountTeam)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Cassandra) DeepCopyObject() runtime.Object {
+func (in *AccountTeam) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -73,31 +68,31 @@ func (in *Cassandra) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CassandraList) DeepCopyInto(out *CassandraList) {
+func (in *AccountTeamList) DeepCopyInto(out *AccountTeamList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Cassandra, len(*in))
+		*out = make([]AccountTeam, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CassandraList.
-func (in *CassandraList) DeepCopy() *CassandraList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountTeamList.
+func (in *AccountTeamList) DeepCopy() *AccountTeamList {
 	if in == nil {
 		return nil
 	}
-	out := new(CassandraList)
+	out := new(AccountTeamList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *CassandraList) DeepCopyObject() runtime.Object {
+func (in *AccountTeamList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -105,34 +100,7 @@ func (in *CassandraList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CassandraSpec) DeepCopyInto(out *CassandraSpec) {
-	*out = *in
-	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
-	if in.AuthSecretRef != nil {
-		in, out := &in.AuthSecretRef, &out.AuthSecretRef
-		*out = new(AuthSecretReference)
-		**out = **in
-	}
-	in.ConnInfoSecretTarget.DeepCopyInto(&out.ConnInfoSecretTarget)
-	if in.UserConfig != nil {
-		in, out := &in.UserConfig, &out.UserConfig
-		*out = new(cassandra.CassandraUserConfig)
-		(*in).DeepCopyInto(*out)
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CassandraSpec.
-func (in *CassandraSpec) DeepCopy() *CassandraSpec {
-	if in == nil {
-		return nil
-	}
-	out := new(CassandraSpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Clickhouse) DeepCopyInto(out *Clickhouse) {
+func (in *AccountTeamMember) DeepCopyInto(out *AccountTeamMember) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -140,18 +108,18 @@ func (in *Clickhouse) DeepCopyInto(out *Clickhouse) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Clickhouse.
-func (in *Clickhouse) DeepCopy() *Clickhouse {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountTeamMember.
+func (in *AccountTeamMember) DeepCopy() *AccountTeamMember {
 	if in == nil {
 		return nil
 	}
-	out := new(Clickhouse)
+	out := new(AccountTeamMember)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Clickhouse) DeepCopyObject() runtime.Object {
+func (in *AccountTeamMember) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -159,31 +127,31 @@ func (in *Clickhouse) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClickhouseList) DeepCopyInto(out *ClickhouseList) {
+func (in *AccountTeamMemberList) DeepCopyInto(out *AccountTeamMemberList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Clickhouse, len(*in))
+		*out = make([]AccountTeamMember, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseList.
-func (in *ClickhouseList) DeepCopy() *ClickhouseList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountTeamMemberList.
+func (in *AccountTeamMemberList) DeepCopy() *AccountTeamMemberList {
 	if in == nil {
 		return nil
 	}
-	out := new(ClickhouseList)
+	out := new(AccountTeamMemberList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClickhouseList) DeepCopyObject() runtime.Object {
+func (in *AccountTeamMemberList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -191,34 +159,50 @@ func (in *ClickhouseList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClickhouseSpec) DeepCopyInto(out *ClickhouseSpec) {
+func (in *AccountTeamMemberSpec) DeepCopyInto(out *AccountTeamMemberSpec) {
 	*out = *in
-	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
+	out.TeamRef = in.TeamRef
 	if in.AuthSecretRef != nil {
 		in, out := &in.AuthSecretRef, &out.AuthSecretRef
 		*out = new(AuthSecretReference)
 		**out = **in
 	}
-	in.ConnInfoSecretTarget.DeepCopyInto(&out.ConnInfoSecretTarget)
-	if in.UserConfig != nil {
-		in, out := &in.UserConfig, &out.UserConfig
-		*out = new(clickhouse.ClickhouseUserConfig)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountTeamMemberSpec.
+func (in *AccountTeamMemberSpec) DeepCopy() *AccountTeamMemberSpec {
+	if in == nil {
+		return nil
 	}
+	out := new(AccountTeamMemberSpec)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseSpec.
-func (in *ClickhouseSpec) DeepCopy() *ClickhouseSpec {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountTeamMemberStatus) DeepCopyInto(out *AccountTeamMemberStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountTeamMemberStatus.
+func (in *AccountTeamMemberStatus) DeepCopy() *AccountTeamMemberStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ClickhouseSpec)
+	out := new(AccountTeamMemberStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClickhouseUser) DeepCopyInto(out *ClickhouseUser) {
+func (in *AccountTeamProject) DeepCopyInto(out *AccountTeamProject) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -226,18 +210,18 @@ func (in *ClickhouseUser) DeepCopyInto(out *ClickhouseUser) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseUser.
-func (in *ClickhouseUser) DeepCopy() *ClickhouseUser {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountTeamProject.
+func (in *AccountTeamProject) DeepCopy() *AccountTeamProject {
 	if in == nil {
 		return nil
 	}
-	out := new(ClickhouseUser)
+	out := new(AccountTeamProject)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClickhouseUser) DeepCopyObject() runtime.Object {
+func (in *AccountTeamProject) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -245,31 +229,31 @@ func (in *ClickhouseUser) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClickhouseUserList) DeepCopyInto(out *ClickhouseUserList) {
+func (in *AccountTeamProjectList) DeepCopyInto(out *AccountTeamProjectList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ClickhouseUser, len(*in))
+		*out = make([]AccountTeamProject, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseUserList.
-func (in *ClickhouseUserList) DeepCopy() *ClickhouseUserList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountTeamProjectList.
+func (in *AccountTeamProjectList) DeepCopy() *AccountTeamProjectList {
 	if in == nil {
 		return nil
 	}
-	out := new(ClickhouseUserList)
+	out := new(AccountTeamProjectList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClickhouseUserList) DeepCopyObject() runtime.Object {
+func (in *AccountTeamProjectList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -277,9 +261,9 @@ func (in *ClickhouseUserList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClickhouseUserSpec) DeepCopyInto(out *ClickhouseUserSpec) {
+func (in *AccountTeamProjectSpec) DeepCopyInto(out *AccountTeamProjectSpec) {
 	*out = *in
-	in.ConnInfoSecretTarget.DeepCopyInto(&out.ConnInfoSecretTarget)
+	out.TeamRef = in.TeamRef
 	if in.AuthSecretRef != nil {
 		in, out := &in.AuthSecretRef, &out.AuthSecretRef
 		*out = new(AuthSecretReference)
@@ -287,18 +271,18 @@ func (in *ClickhouseUserSpec) DeepCopyInto(out *ClickhouseUserSpec) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseUserSpec.
-func (in *ClickhouseUserSpec) DeepCopy() *ClickhouseUserSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountTeamProjectSpec.
+func (in *AccountTeamProjectSpec) DeepCopy() *AccountTeamProjectSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ClickhouseUserSpec)
+	out := new(AccountTeamProjectSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClickhouseUserStatus) DeepCopyInto(out *ClickhouseUserStatus) {
+func (in *AccountTeamProjectStatus) DeepCopyInto(out *AccountTeamProjectStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -309,149 +293,95 @@ func (in *ClickhouseUserStatus) DeepCopyInto(out *ClickhouseUserStatus) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseUserStatus.
-func (in *ClickhouseUserStatus) DeepCopy() *ClickhouseUserStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountTeamProjectStatus.
+func (in *AccountTeamProjectStatus) DeepCopy() *AccountTeamProjectStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ClickhouseUserStatus)
+	out := new(AccountTeamProjectStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConnInfoSecretTarget) DeepCopyInto(out *ConnInfoSecretTarget) {
+func (in *AccountTeamSpec) DeepCopyInto(out *AccountTeamSpec) {
 	*out = *in
-	if in.Annotations != nil {
-		in, out := &in.Annotations, &out.Annotations
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.Labels != nil {
-		in, out := &in.Labels, &out.Labels
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnInfoSecretTarget.
-func (in *ConnInfoSecretTarget) DeepCopy() *ConnInfoSecretTarget {
-	if in == nil {
-		return nil
+	if in.AuthSecretRef != nil {
+		in, out := &in.AuthSecretRef, &out.AuthSecretRef
+		*out = new(AuthSecretReference)
+		**out = **in
 	}
-	out := new(ConnInfoSecretTarget)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConnectionPool) DeepCopyInto(out *ConnectionPool) {
-	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionPool.
-func (in *ConnectionPool) DeepCopy() *ConnectionPool {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountTeamSpec.
+func (in *AccountTeamSpec) DeepCopy() *AccountTeamSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ConnectionPool)
+	out := new(AccountTeamSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ConnectionPool) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConnectionPoolList) DeepCopyInto(out *ConnectionPoolList) {
+func (in *AccountTeamStatus) DeepCopyInto(out *AccountTeamStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]ConnectionPool, len(*in))
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionPoolList.
-func (in *ConnectionPoolList) DeepCopy() *ConnectionPoolList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountTeamStatus.
+func (in *AccountTeamStatus) DeepCopy() *AccountTeamStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ConnectionPoolList)
+	out := new(AccountTeamStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ConnectionPoolList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConnectionPoolSpec) DeepCopyInto(out *ConnectionPoolSpec) {
+func (in *AuthSecretReference) DeepCopyInto(out *AuthSecretReference) {
 	*out = *in
-	in.ConnInfoSecretTarget.DeepCopyInto(&out.ConnInfoSecretTarget)
-	if in.AuthSecretRef != nil {
-		in, out := &in.AuthSecretRef, &out.AuthSecretRef
-		*out = new(AuthSecretReference)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionPoolSpec.
-func (in *ConnectionPoolSpec) DeepCopy() *ConnectionPoolSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthSecretReference.
+func (in *AuthSecretReference) DeepCopy() *AuthSecretReference {
 	if in == nil {
 		return nil
 	}
-	out := new(ConnectionPoolSpec)
+	out := new(AuthSecretReference)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConnectionPoolStatus) DeepCopyInto(out *ConnectionPoolStatus) {
+func (in *AutoscaleDisk) DeepCopyInto(out *AutoscaleDisk) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Cooldown != nil {
+		in, out := &in.Cooldown, &out.Cooldown
+		*out = new(v1.Duration)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionPoolStatus.
-func (in *ConnectionPoolStatus) DeepCopy() *ConnectionPoolStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscaleDisk.
+func (in *AutoscaleDisk) DeepCopy() *AutoscaleDisk {
 	if in == nil {
 		return nil
 	}
-	out := new(ConnectionPoolStatus)
+	out := new(AutoscaleDisk)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Database) DeepCopyInto(out *Database) {
+func (in *BillingGroup) DeepCopyInto(out *BillingGroup) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -459,18 +389,18 @@ func (in *Database) DeepCopyInto(out *Database) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Database.
-func (in *Database) DeepCopy() *Database {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BillingGroup.
+func (in *BillingGroup) DeepCopy() *BillingGroup {
 	if in == nil {
 		return nil
 	}
-	out := new(Database)
+	out := new(BillingGroup)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Database) DeepCopyObject() runtime.Object {
+func (in *BillingGroup) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -478,31 +408,31 @@ func (in *Database) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DatabaseList) DeepCopyInto(out *DatabaseList) {
+func (in *BillingGroupList) DeepCopyInto(out *BillingGroupList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Database, len(*in))
+		*out = make([]BillingGroup, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseList.
-func (in *DatabaseList) DeepCopy() *DatabaseList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BillingGroupList.
+func (in *BillingGroupList) DeepCopy() *BillingGroupList {
 	if in == nil {
 		return nil
 	}
-	out := new(DatabaseList)
+	out := new(BillingGroupList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DatabaseList) DeepCopyObject() runtime.Object {
+func (in *BillingGroupList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -510,12 +440,17 @@ func (in *DatabaseList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DatabaseSpec) DeepCopyInto(out *DatabaseSpec) {
+func (in *BillingGroupSpec) DeepCopyInto(out *BillingGroupSpec) {
 	*out = *in
-	if in.TerminationProtection != nil {
-		in, out := &in.TerminationProtection, &out.TerminationProtection
-		*out = new(bool)
-		**out = **in
+	if in.BillingEmails != nil {
+		in, out := &in.BillingEmails, &out.BillingEmails
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AddressLines != nil {
+		in, out := &in.AddressLines, &out.AddressLines
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 	if in.AuthSecretRef != nil {
 		in, out := &in.AuthSecretRef, &out.AuthSecretRef
@@ -524,18 +459,18 @@ func (in *DatabaseSpec) DeepCopyInto(out *DatabaseSpec) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseSpec.
-func (in *DatabaseSpec) DeepCopy() *DatabaseSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BillingGroupSpec.
+func (in *BillingGroupSpec) DeepCopy() *BillingGroupSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DatabaseSpec)
+	out := new(BillingGroupSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DatabaseStatus) DeepCopyInto(out *DatabaseStatus) {
+func (in *BillingGroupStatus) DeepCopyInto(out *BillingGroupStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -544,20 +479,25 @@ func (in *DatabaseStatus) DeepCopyInto(out *DatabaseStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Projects != nil {
+		in, out := &in.Projects, &out.Projects
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseStatus.
-func (in *DatabaseStatus) DeepCopy() *DatabaseStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BillingGroupStatus.
+func (in *BillingGroupStatus) DeepCopy() *BillingGroupStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DatabaseStatus)
+	out := new(BillingGroupStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Grafana) DeepCopyInto(out *Grafana) {
+func (in *Cassandra) DeepCopyInto(out *Cassandra) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -565,18 +505,18 @@ func (in *Grafana) DeepCopyInto(out *Grafana) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Grafana.
-func (in *Grafana) DeepCopy() *Grafana {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Cassandra.
+func (in *Cassandra) DeepCopy() *Cassandra {
 	if in == nil {
 		return nil
 	}
-	out := new(Grafana)
+	out := new(Cassandra)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Grafana) DeepCopyObject() runtime.Object {
+func (in *Cassandra) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -584,31 +524,31 @@ func (in *Grafana) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GrafanaList) DeepCopyInto(out *GrafanaList) {
+func (in *CassandraList) DeepCopyInto(out *CassandraList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Grafana, len(*in))
+		*out = make([]Cassandra, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaList.
-func (in *GrafanaList) DeepCopy() *GrafanaList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CassandraList.
+func (in *CassandraList) DeepCopy() *CassandraList {
 	if in == nil {
 		return nil
 	}
-	out := new(GrafanaList)
+	out := new(CassandraList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *GrafanaList) DeepCopyObject() runtime.Object {
+func (in *CassandraList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -616,9 +556,14 @@ func (in *GrafanaList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GrafanaSpec) DeepCopyInto(out *GrafanaSpec) {
+func (in *CassandraSpec) DeepCopyInto(out *CassandraSpec) {
 	*out = *in
 	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
+	if in.AutoscaleDisk != nil {
+		in, out := &in.AutoscaleDisk, &out.AutoscaleDisk
+		*out = new(AutoscaleDisk)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AuthSecretRef != nil {
 		in, out := &in.AuthSecretRef, &out.AuthSecretRef
 		*out = new(AuthSecretReference)
@@ -627,23 +572,23 @@ func (in *GrafanaSpec) DeepCopyInto(out *GrafanaSpec) {
 	in.ConnInfoSecretTarget.DeepCopyInto(&out.ConnInfoSecretTarget)
 	if in.UserConfig != nil {
 		in, out := &in.UserConfig, &out.UserConfig
-		*out = new(grafana.GrafanaUserConfig)
+		*out = new(cassandra.CassandraUserConfig)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaSpec.
-func (in *GrafanaSpec) DeepCopy() *GrafanaSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CassandraSpec.
+func (in *CassandraSpec) DeepCopy() *CassandraSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(GrafanaSpec)
+	out := new(CassandraSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Kafka) DeepCopyInto(out *Kafka) {
+func (in *Clickhouse) DeepCopyInto(out *Clickhouse) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -651,18 +596,18 @@ func (in *Kafka) DeepCopyInto(out *Kafka) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Kafka.
-func (in *Kafka) DeepCopy() *Kafka {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Clickhouse.
+func (in *Clickhouse) DeepCopy() *Clickhouse {
 	if in == nil {
 		return nil
 	}
-	out := new(Kafka)
+	out := new(Clickhouse)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Kafka) DeepCopyObject() runtime.Object {
+func (in *Clickhouse) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -670,7 +615,7 @@ func (in *Kafka) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaACL) DeepCopyInto(out *KafkaACL) {
+func (in *ClickhouseDatabase) DeepCopyInto(out *ClickhouseDatabase) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -678,18 +623,18 @@ func (in *KafkaACL) DeepCopyInto(out *KafkaACL) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaACL.
-func (in *KafkaACL) DeepCopy() *KafkaACL {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseDatabase.
+func (in *ClickhouseDatabase) DeepCopy() *ClickhouseDatabase {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaACL)
+	out := new(ClickhouseDatabase)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KafkaACL) DeepCopyObject() runtime.Object {
+func (in *ClickhouseDatabase) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -697,31 +642,31 @@ func (in *KafkaACL) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaACLList) DeepCopyInto(out *KafkaACLList) {
+func (in *ClickhouseDatabaseList) DeepCopyInto(out *ClickhouseDatabaseList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]KafkaACL, len(*in))
+		*out = make([]ClickhouseDatabase, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaACLList.
-func (in *KafkaACLList) DeepCopy() *KafkaACLList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseDatabaseList.
+func (in *ClickhouseDatabaseList) DeepCopy() *ClickhouseDatabaseList {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaACLList)
+	out := new(ClickhouseDatabaseList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KafkaACLList) DeepCopyObject() runtime.Object {
+func (in *ClickhouseDatabaseList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -729,8 +674,18 @@ func (in *KafkaACLList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaACLSpec) DeepCopyInto(out *KafkaACLSpec) {
+func (in *ClickhouseDatabaseSpec) DeepCopyInto(out *ClickhouseDatabaseSpec) {
 	*out = *in
+	if in.ServiceRef != nil {
+		in, out := &in.ServiceRef, &out.ServiceRef
+		*out = new(ResourceReference)
+		**out = **in
+	}
+	if in.TerminationProtection != nil {
+		in, out := &in.TerminationProtection, &out.TerminationProtection
+		*out = new(bool)
+		**out = **in
+	}
 	if in.AuthSecretRef != nil {
 		in, out := &in.AuthSecretRef, &out.AuthSecretRef
 		*out = new(AuthSecretReference)
@@ -738,18 +693,18 @@ func (in *KafkaACLSpec) DeepCopyInto(out *KafkaACLSpec) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaACLSpec.
-func (in *KafkaACLSpec) DeepCopy() *KafkaACLSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseDatabaseSpec.
+func (in *ClickhouseDatabaseSpec) DeepCopy() *ClickhouseDatabaseSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaACLSpec)
+	out := new(ClickhouseDatabaseSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaACLStatus) DeepCopyInto(out *KafkaACLStatus) {
+func (in *ClickhouseDatabaseStatus) DeepCopyInto(out *ClickhouseDatabaseStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -760,18 +715,18 @@ func (in *KafkaACLStatus) DeepCopyInto(out *KafkaACLStatus) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaACLStatus.
-func (in *KafkaACLStatus) DeepCopy() *KafkaACLStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseDatabaseStatus.
+func (in *ClickhouseDatabaseStatus) DeepCopy() *ClickhouseDatabaseStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaACLStatus)
+	out := new(ClickhouseDatabaseStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaConnect) DeepCopyInto(out *KafkaConnect) {
+func (in *ClickhouseGrant) DeepCopyInto(out *ClickhouseGrant) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -779,18 +734,18 @@ func (in *KafkaConnect) DeepCopyInto(out *KafkaConnect) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnect.
-func (in *KafkaConnect) DeepCopy() *KafkaConnect {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseGrant.
+func (in *ClickhouseGrant) DeepCopy() *ClickhouseGrant {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaConnect)
+	out := new(ClickhouseGrant)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KafkaConnect) DeepCopyObject() runtime.Object {
+func (in *ClickhouseGrant) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -798,31 +753,31 @@ func (in *KafkaConnect) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaConnectList) DeepCopyInto(out *KafkaConnectList) {
+func (in *ClickhouseGrantList) DeepCopyInto(out *ClickhouseGrantList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]KafkaConnect, len(*in))
+		*out = make([]ClickhouseGrant, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectList.
-func (in *KafkaConnectList) DeepCopy() *KafkaConnectList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseGrantList.
+func (in *ClickhouseGrantList) DeepCopy() *ClickhouseGrantList {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaConnectList)
+	out := new(ClickhouseGrantList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KafkaConnectList) DeepCopyObject() runtime.Object {
+func (in *ClickhouseGrantList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -830,197 +785,151 @@ func (in *KafkaConnectList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaConnectSpec) DeepCopyInto(out *KafkaConnectSpec) {
+func (in *ClickhouseGrantSpec) DeepCopyInto(out *ClickhouseGrantSpec) {
 	*out = *in
-	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
+	in.Grantee.DeepCopyInto(&out.Grantee)
+	if in.PrivilegeGrants != nil {
+		in, out := &in.PrivilegeGrants, &out.PrivilegeGrants
+		*out = make([]ClickhousePrivilegeGrant, len(*in))
+		copy(*out, *in)
+	}
+	if in.RoleGrants != nil {
+		in, out := &in.RoleGrants, &out.RoleGrants
+		*out = make([]ClickhouseRoleGrant, len(*in))
+		copy(*out, *in)
+	}
 	if in.AuthSecretRef != nil {
 		in, out := &in.AuthSecretRef, &out.AuthSecretRef
 		*out = new(AuthSecretReference)
 		**out = **in
 	}
-	if in.UserConfig != nil {
-		in, out := &in.UserConfig, &out.UserConfig
-		*out = new(kafka_connect.KafkaConnectUserConfig)
-		(*in).DeepCopyInto(*out)
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectSpec.
-func (in *KafkaConnectSpec) DeepCopy() *KafkaConnectSpec {
-	if in == nil {
-		return nil
-	}
-	out := new(KafkaConnectSpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaConnector) DeepCopyInto(out *KafkaConnector) {
-	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnector.
-func (in *KafkaConnector) DeepCopy() *KafkaConnector {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseGrantSpec.
+func (in *ClickhouseGrantSpec) DeepCopy() *ClickhouseGrantSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaConnector)
+	out := new(ClickhouseGrantSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KafkaConnector) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaConnectorList) DeepCopyInto(out *KafkaConnectorList) {
+func (in *ClickhouseGrantStatus) DeepCopyInto(out *ClickhouseGrantStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]KafkaConnector, len(*in))
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectorList.
-func (in *KafkaConnectorList) DeepCopy() *KafkaConnectorList {
-	if in == nil {
-		return nil
-	}
-	out := new(KafkaConnectorList)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KafkaConnectorList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaConnectorPluginStatus) DeepCopyInto(out *KafkaConnectorPluginStatus) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectorPluginStatus.
-func (in *KafkaConnectorPluginStatus) DeepCopy() *KafkaConnectorPluginStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseGrantStatus.
+func (in *ClickhouseGrantStatus) DeepCopy() *ClickhouseGrantStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaConnectorPluginStatus)
+	out := new(ClickhouseGrantStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaConnectorSpec) DeepCopyInto(out *KafkaConnectorSpec) {
+func (in *ClickhouseGrantee) DeepCopyInto(out *ClickhouseGrantee) {
 	*out = *in
-	if in.AuthSecretRef != nil {
-		in, out := &in.AuthSecretRef, &out.AuthSecretRef
-		*out = new(AuthSecretReference)
+	if in.UserRef != nil {
+		in, out := &in.UserRef, &out.UserRef
+		*out = new(ResourceReference)
 		**out = **in
 	}
-	if in.UserConfig != nil {
-		in, out := &in.UserConfig, &out.UserConfig
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.RoleRef != nil {
+		in, out := &in.RoleRef, &out.RoleRef
+		*out = new(ResourceReference)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectorSpec.
-func (in *KafkaConnectorSpec) DeepCopy() *KafkaConnectorSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseGrantee.
+func (in *ClickhouseGrantee) DeepCopy() *ClickhouseGrantee {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaConnectorSpec)
+	out := new(ClickhouseGrantee)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaConnectorStatus) DeepCopyInto(out *KafkaConnectorStatus) {
+func (in *ClickhouseList) DeepCopyInto(out *ClickhouseList) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Clickhouse, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	out.PluginStatus = in.PluginStatus
-	out.TasksStatus = in.TasksStatus
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectorStatus.
-func (in *KafkaConnectorStatus) DeepCopy() *KafkaConnectorStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseList.
+func (in *ClickhouseList) DeepCopy() *ClickhouseList {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaConnectorStatus)
+	out := new(ClickhouseList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClickhouseList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaConnectorTasksStatus) DeepCopyInto(out *KafkaConnectorTasksStatus) {
+func (in *ClickhousePrivilegeGrant) DeepCopyInto(out *ClickhousePrivilegeGrant) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectorTasksStatus.
-func (in *KafkaConnectorTasksStatus) DeepCopy() *KafkaConnectorTasksStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhousePrivilegeGrant.
+func (in *ClickhousePrivilegeGrant) DeepCopy() *ClickhousePrivilegeGrant {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaConnectorTasksStatus)
+	out := new(ClickhousePrivilegeGrant)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaList) DeepCopyInto(out *KafkaList) {
+func (in *ClickhouseRole) DeepCopyInto(out *ClickhouseRole) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Kafka, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaList.
-func (in *KafkaList) DeepCopy() *KafkaList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseRole.
+func (in *ClickhouseRole) DeepCopy() *ClickhouseRole {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaList)
+	out := new(ClickhouseRole)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KafkaList) DeepCopyObject() runtime.Object {
+func (in *ClickhouseRole) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1028,58 +937,47 @@ func (in *KafkaList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaSchema) DeepCopyInto(out *KafkaSchema) {
+func (in *ClickhouseRoleGrant) DeepCopyInto(out *ClickhouseRoleGrant) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	out.RoleRef = in.RoleRef
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSchema.
-func (in *KafkaSchema) DeepCopy() *KafkaSchema {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseRoleGrant.
+func (in *ClickhouseRoleGrant) DeepCopy() *ClickhouseRoleGrant {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaSchema)
+	out := new(ClickhouseRoleGrant)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KafkaSchema) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaSchemaList) DeepCopyInto(out *KafkaSchemaList) {
+func (in *ClickhouseRoleList) DeepCopyInto(out *ClickhouseRoleList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]KafkaSchema, len(*in))
+		*out = make([]ClickhouseRole, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSchemaList.
-func (in *KafkaSchemaList) DeepCopy() *KafkaSchemaList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseRoleList.
+func (in *ClickhouseRoleList) DeepCopy() *ClickhouseRoleList {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaSchemaList)
+	out := new(ClickhouseRoleList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KafkaSchemaList) DeepCopyObject() runtime.Object {
+func (in *ClickhouseRoleList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1087,7 +985,7 @@ func (in *KafkaSchemaList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaSchemaSpec) DeepCopyInto(out *KafkaSchemaSpec) {
+func (in *ClickhouseRoleSpec) DeepCopyInto(out *ClickhouseRoleSpec) {
 	*out = *in
 	if in.AuthSecretRef != nil {
 		in, out := &in.AuthSecretRef, &out.AuthSecretRef
@@ -1096,18 +994,18 @@ func (in *KafkaSchemaSpec) DeepCopyInto(out *KafkaSchemaSpec) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSchemaSpec.
-func (in *KafkaSchemaSpec) DeepCopy() *KafkaSchemaSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseRoleSpec.
+func (in *ClickhouseRoleSpec) DeepCopy() *ClickhouseRoleSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaSchemaSpec)
+	out := new(ClickhouseRoleSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaSchemaStatus) DeepCopyInto(out *KafkaSchemaStatus) {
+func (in *ClickhouseRoleStatus) DeepCopyInto(out *ClickhouseRoleStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -1118,50 +1016,50 @@ func (in *KafkaSchemaStatus) DeepCopyInto(out *KafkaSchemaStatus) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSchemaStatus.
-func (in *KafkaSchemaStatus) DeepCopy() *KafkaSchemaStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseRoleStatus.
+func (in *ClickhouseRoleStatus) DeepCopy() *ClickhouseRoleStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaSchemaStatus)
+	out := new(ClickhouseRoleStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaSpec) DeepCopyInto(out *KafkaSpec) {
+func (in *ClickhouseSpec) DeepCopyInto(out *ClickhouseSpec) {
 	*out = *in
 	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
+	if in.AutoscaleDisk != nil {
+		in, out := &in.AutoscaleDisk, &out.AutoscaleDisk
+		*out = new(AutoscaleDisk)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AuthSecretRef != nil {
 		in, out := &in.AuthSecretRef, &out.AuthSecretRef
 		*out = new(AuthSecretReference)
 		**out = **in
 	}
 	in.ConnInfoSecretTarget.DeepCopyInto(&out.ConnInfoSecretTarget)
-	if in.Karapace != nil {
-		in, out := &in.Karapace, &out.Karapace
-		*out = new(bool)
-		**out = **in
-	}
 	if in.UserConfig != nil {
 		in, out := &in.UserConfig, &out.UserConfig
-		*out = new(kafka.KafkaUserConfig)
+		*out = new(clickhouse.ClickhouseUserConfig)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSpec.
-func (in *KafkaSpec) DeepCopy() *KafkaSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseSpec.
+func (in *ClickhouseSpec) DeepCopy() *ClickhouseSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaSpec)
+	out := new(ClickhouseSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KafkaTopic) DeepCopyInto(out *KafkaTopic) {
+func (in *ClickhouseUser) DeepCopyInto(out *ClickhouseUser) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1169,12 +1067,1743 @@ func (in *KafkaTopic) DeepCopyInto(out *KafkaTopic) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTopic.
-func (in *KafkaTopic) DeepCopy() *KafkaTopic {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseUser.
+func (in *ClickhouseUser) DeepCopy() *ClickhouseUser {
 	if in == nil {
 		return nil
 	}
-	out := new(KafkaTopic)
+	out := new(ClickhouseUser)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClickhouseUser) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClickhouseUserList) DeepCopyInto(out *ClickhouseUserList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClickhouseUser, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseUserList.
+func (in *ClickhouseUserList) DeepCopy() *ClickhouseUserList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClickhouseUserList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClickhouseUserList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClickhouseUserSpec) DeepCopyInto(out *ClickhouseUserSpec) {
+	*out = *in
+	in.ConnInfoSecretTarget.DeepCopyInto(&out.ConnInfoSecretTarget)
+	if in.PasswordSecretRef != nil {
+		in, out := &in.PasswordSecretRef, &out.PasswordSecretRef
+		*out = new(PasswordSecretReference)
+		**out = **in
+	}
+	if in.AuthSecretRef != nil {
+		in, out := &in.AuthSecretRef, &out.AuthSecretRef
+		*out = new(AuthSecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseUserSpec.
+func (in *ClickhouseUserSpec) DeepCopy() *ClickhouseUserSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClickhouseUserSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClickhouseUserStatus) DeepCopyInto(out *ClickhouseUserStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClickhouseUserStatus.
+func (in *ClickhouseUserStatus) DeepCopy() *ClickhouseUserStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClickhouseUserStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapKeyReference) DeepCopyInto(out *ConfigMapKeyReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapKeyReference.
+func (in *ConfigMapKeyReference) DeepCopy() *ConfigMapKeyReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapKeyReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnInfoSecretTarget) DeepCopyInto(out *ConnInfoSecretTarget) {
+	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnInfoSecretTarget.
+func (in *ConnInfoSecretTarget) DeepCopy() *ConnInfoSecretTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnInfoSecretTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionPool) DeepCopyInto(out *ConnectionPool) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionPool.
+func (in *ConnectionPool) DeepCopy() *ConnectionPool {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ConnectionPool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionPoolList) DeepCopyInto(out *ConnectionPoolList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ConnectionPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionPoolList.
+func (in *ConnectionPoolList) DeepCopy() *ConnectionPoolList {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionPoolList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ConnectionPoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionPoolSpec) DeepCopyInto(out *ConnectionPoolSpec) {
+	*out = *in
+	in.ConnInfoSecretTarget.DeepCopyInto(&out.ConnInfoSecretTarget)
+	if in.AuthSecretRef != nil {
+		in, out := &in.AuthSecretRef, &out.AuthSecretRef
+		*out = new(AuthSecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionPoolSpec.
+func (in *ConnectionPoolSpec) DeepCopy() *ConnectionPoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionPoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConnectionPoolStatus) DeepCopyInto(out *ConnectionPoolStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionPoolStatus.
+func (in *ConnectionPoolStatus) DeepCopy() *ConnectionPoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ConnectionPoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Database) DeepCopyInto(out *Database) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Database.
+func (in *Database) DeepCopy() *Database {
+	if in == nil {
+		return nil
+	}
+	out := new(Database)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Database) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseList) DeepCopyInto(out *DatabaseList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Database, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseList.
+func (in *DatabaseList) DeepCopy() *DatabaseList {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DatabaseList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseSpec) DeepCopyInto(out *DatabaseSpec) {
+	*out = *in
+	if in.TerminationProtection != nil {
+		in, out := &in.TerminationProtection, &out.TerminationProtection
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AuthSecretRef != nil {
+		in, out := &in.AuthSecretRef, &out.AuthSecretRef
+		*out = new(AuthSecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseSpec.
+func (in *DatabaseSpec) DeepCopy() *DatabaseSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseStatus) DeepCopyInto(out *DatabaseStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseStatus.
+func (in *DatabaseStatus) DeepCopy() *DatabaseStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskAutoscaleStep) DeepCopyInto(out *DiskAutoscaleStep) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskAutoscaleStep.
+func (in *DiskAutoscaleStep) DeepCopy() *DiskAutoscaleStep {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskAutoscaleStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Flink) DeepCopyInto(out *Flink) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Flink.
+func (in *Flink) DeepCopy() *Flink {
+	if in == nil {
+		return nil
+	}
+	out := new(Flink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Flink) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlinkApplication) DeepCopyInto(out *FlinkApplication) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkApplication.
+func (in *FlinkApplication) DeepCopy() *FlinkApplication {
+	if in == nil {
+		return nil
+	}
+	out := new(FlinkApplication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FlinkApplication) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlinkApplicationDeployment) DeepCopyInto(out *FlinkApplicationDeployment) {
+	*out = *in
+	if in.RestartEnabled != nil {
+		in, out := &in.RestartEnabled, &out.RestartEnabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkApplicationDeployment.
+func (in *FlinkApplicationDeployment) DeepCopy() *FlinkApplicationDeployment {
+	if in == nil {
+		return nil
+	}
+	out := new(FlinkApplicationDeployment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlinkApplicationList) DeepCopyInto(out *FlinkApplicationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]FlinkApplication, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkApplicationList.
+func (in *FlinkApplicationList) DeepCopy() *FlinkApplicationList {
+	if in == nil {
+		return nil
+	}
+	out := new(FlinkApplicationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FlinkApplicationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlinkApplicationSpec) DeepCopyInto(out *FlinkApplicationSpec) {
+	*out = *in
+	if in.ServiceRef != nil {
+		in, out := &in.ServiceRef, &out.ServiceRef
+		*out = new(ResourceReference)
+		**out = **in
+	}
+	if in.Versions != nil {
+		in, out := &in.Versions, &out.Versions
+		*out = make([]FlinkApplicationVersion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Deployment != nil {
+		in, out := &in.Deployment, &out.Deployment
+		*out = new(FlinkApplicationDeployment)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AuthSecretRef != nil {
+		in, out := &in.AuthSecretRef, &out.AuthSecretRef
+		*out = new(AuthSecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkApplicationSpec.
+func (in *FlinkApplicationSpec) DeepCopy() *FlinkApplicationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FlinkApplicationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlinkApplicationStatus) DeepCopyInto(out *FlinkApplicationStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Versions != nil {
+		in, out := &in.Versions, &out.Versions
+		*out = make([]FlinkApplicationVersionStatus, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkApplicationStatus.
+func (in *FlinkApplicationStatus) DeepCopy() *FlinkApplicationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FlinkApplicationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlinkApplicationVersion) DeepCopyInto(out *FlinkApplicationVersion) {
+	*out = *in
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make([]FlinkApplicationVersionTable, len(*in))
+		copy(*out, *in)
+	}
+	if in.Sinks != nil {
+		in, out := &in.Sinks, &out.Sinks
+		*out = make([]FlinkApplicationVersionTable, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkApplicationVersion.
+func (in *FlinkApplicationVersion) DeepCopy() *FlinkApplicationVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(FlinkApplicationVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlinkApplicationVersionStatus) DeepCopyInto(out *FlinkApplicationVersionStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkApplicationVersionStatus.
+func (in *FlinkApplicationVersionStatus) DeepCopy() *FlinkApplicationVersionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FlinkApplicationVersionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlinkApplicationVersionTable) DeepCopyInto(out *FlinkApplicationVersionTable) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkApplicationVersionTable.
+func (in *FlinkApplicationVersionTable) DeepCopy() *FlinkApplicationVersionTable {
+	if in == nil {
+		return nil
+	}
+	out := new(FlinkApplicationVersionTable)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlinkList) DeepCopyInto(out *FlinkList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Flink, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkList.
+func (in *FlinkList) DeepCopy() *FlinkList {
+	if in == nil {
+		return nil
+	}
+	out := new(FlinkList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FlinkList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlinkSpec) DeepCopyInto(out *FlinkSpec) {
+	*out = *in
+	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
+	if in.AuthSecretRef != nil {
+		in, out := &in.AuthSecretRef, &out.AuthSecretRef
+		*out = new(AuthSecretReference)
+		**out = **in
+	}
+	in.ConnInfoSecretTarget.DeepCopyInto(&out.ConnInfoSecretTarget)
+	if in.UserConfig != nil {
+		in, out := &in.UserConfig, &out.UserConfig
+		*out = new(flink.FlinkUserConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlinkSpec.
+func (in *FlinkSpec) DeepCopy() *FlinkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FlinkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Grafana) DeepCopyInto(out *Grafana) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Grafana.
+func (in *Grafana) DeepCopy() *Grafana {
+	if in == nil {
+		return nil
+	}
+	out := new(Grafana)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Grafana) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrafanaList) DeepCopyInto(out *GrafanaList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Grafana, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaList.
+func (in *GrafanaList) DeepCopy() *GrafanaList {
+	if in == nil {
+		return nil
+	}
+	out := new(GrafanaList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GrafanaList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrafanaSpec) DeepCopyInto(out *GrafanaSpec) {
+	*out = *in
+	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
+	if in.AutoscaleDisk != nil {
+		in, out := &in.AutoscaleDisk, &out.AutoscaleDisk
+		*out = new(AutoscaleDisk)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AuthSecretRef != nil {
+		in, out := &in.AuthSecretRef, &out.AuthSecretRef
+		*out = new(AuthSecretReference)
+		**out = **in
+	}
+	in.ConnInfoSecretTarget.DeepCopyInto(&out.ConnInfoSecretTarget)
+	if in.UserConfig != nil {
+		in, out := &in.UserConfig, &out.UserConfig
+		*out = new(grafana.GrafanaUserConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaSpec.
+func (in *GrafanaSpec) DeepCopy() *GrafanaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GrafanaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPFilterFrom) DeepCopyInto(out *IPFilterFrom) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceRefs != nil {
+		in, out := &in.ServiceRefs, &out.ServiceRefs
+		*out = make([]ResourceReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.ConfigMapKeyRefs != nil {
+		in, out := &in.ConfigMapKeyRefs, &out.ConfigMapKeyRefs
+		*out = make([]ConfigMapKeyReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.Debounce != nil {
+		in, out := &in.Debounce, &out.Debounce
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPFilterFrom.
+func (in *IPFilterFrom) DeepCopy() *IPFilterFrom {
+	if in == nil {
+		return nil
+	}
+	out := new(IPFilterFrom)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPFilterFromStatus) DeepCopyInto(out *IPFilterFromStatus) {
+	*out = *in
+	if in.Applied != nil {
+		in, out := &in.Applied, &out.Applied
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Pending != nil {
+		in, out := &in.Pending, &out.Pending
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PendingSince != nil {
+		in, out := &in.PendingSince, &out.PendingSince
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPFilterFromStatus.
+func (in *IPFilterFromStatus) DeepCopy() *IPFilterFromStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IPFilterFromStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Kafka) DeepCopyInto(out *Kafka) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Kafka.
+func (in *Kafka) DeepCopy() *Kafka {
+	if in == nil {
+		return nil
+	}
+	out := new(Kafka)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Kafka) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaACL) DeepCopyInto(out *KafkaACL) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaACL.
+func (in *KafkaACL) DeepCopy() *KafkaACL {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaACL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaACL) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaACLList) DeepCopyInto(out *KafkaACLList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KafkaACL, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaACLList.
+func (in *KafkaACLList) DeepCopy() *KafkaACLList {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaACLList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaACLList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaACLSpec) DeepCopyInto(out *KafkaACLSpec) {
+	*out = *in
+	if in.AuthSecretRef != nil {
+		in, out := &in.AuthSecretRef, &out.AuthSecretRef
+		*out = new(AuthSecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaACLSpec.
+func (in *KafkaACLSpec) DeepCopy() *KafkaACLSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaACLSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaACLStatus) DeepCopyInto(out *KafkaACLStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaACLStatus.
+func (in *KafkaACLStatus) DeepCopy() *KafkaACLStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaACLStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConnect) DeepCopyInto(out *KafkaConnect) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnect.
+func (in *KafkaConnect) DeepCopy() *KafkaConnect {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConnect)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaConnect) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConnectList) DeepCopyInto(out *KafkaConnectList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KafkaConnect, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectList.
+func (in *KafkaConnectList) DeepCopy() *KafkaConnectList {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConnectList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaConnectList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConnectPlugin) DeepCopyInto(out *KafkaConnectPlugin) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectPlugin.
+func (in *KafkaConnectPlugin) DeepCopy() *KafkaConnectPlugin {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConnectPlugin)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConnectSpec) DeepCopyInto(out *KafkaConnectSpec) {
+	*out = *in
+	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
+	if in.AuthSecretRef != nil {
+		in, out := &in.AuthSecretRef, &out.AuthSecretRef
+		*out = new(AuthSecretReference)
+		**out = **in
+	}
+	if in.UserConfig != nil {
+		in, out := &in.UserConfig, &out.UserConfig
+		*out = new(kafka_connect.KafkaConnectUserConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectSpec.
+func (in *KafkaConnectSpec) DeepCopy() *KafkaConnectSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConnectSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConnectStatus) DeepCopyInto(out *KafkaConnectStatus) {
+	*out = *in
+	in.ServiceStatus.DeepCopyInto(&out.ServiceStatus)
+	if in.Plugins != nil {
+		in, out := &in.Plugins, &out.Plugins
+		*out = make([]KafkaConnectPlugin, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectStatus.
+func (in *KafkaConnectStatus) DeepCopy() *KafkaConnectStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConnectStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConnector) DeepCopyInto(out *KafkaConnector) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnector.
+func (in *KafkaConnector) DeepCopy() *KafkaConnector {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConnector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaConnector) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConnectorAutoRestart) DeepCopyInto(out *KafkaConnectorAutoRestart) {
+	*out = *in
+	out.InitialDelay = in.InitialDelay
+	out.MaxDelay = in.MaxDelay
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectorAutoRestart.
+func (in *KafkaConnectorAutoRestart) DeepCopy() *KafkaConnectorAutoRestart {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConnectorAutoRestart)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConnectorConfigFrom) DeepCopyInto(out *KafkaConnectorConfigFrom) {
+	*out = *in
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(KafkaConnectorKeySelector)
+		**out = **in
+	}
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(KafkaConnectorKeySelector)
+		**out = **in
+	}
+	if in.ServiceUserRef != nil {
+		in, out := &in.ServiceUserRef, &out.ServiceUserRef
+		*out = new(KafkaConnectorKeySelector)
+		**out = **in
+	}
+	if in.KafkaTopicRef != nil {
+		in, out := &in.KafkaTopicRef, &out.KafkaTopicRef
+		*out = new(KafkaConnectorObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectorConfigFrom.
+func (in *KafkaConnectorConfigFrom) DeepCopy() *KafkaConnectorConfigFrom {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConnectorConfigFrom)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConnectorKeySelector) DeepCopyInto(out *KafkaConnectorKeySelector) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectorKeySelector.
+func (in *KafkaConnectorKeySelector) DeepCopy() *KafkaConnectorKeySelector {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConnectorKeySelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConnectorList) DeepCopyInto(out *KafkaConnectorList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KafkaConnector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectorList.
+func (in *KafkaConnectorList) DeepCopy() *KafkaConnectorList {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConnectorList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaConnectorList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConnectorObjectReference) DeepCopyInto(out *KafkaConnectorObjectReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectorObjectReference.
+func (in *KafkaConnectorObjectReference) DeepCopy() *KafkaConnectorObjectReference {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConnectorObjectReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConnectorPluginStatus) DeepCopyInto(out *KafkaConnectorPluginStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectorPluginStatus.
+func (in *KafkaConnectorPluginStatus) DeepCopy() *KafkaConnectorPluginStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConnectorPluginStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConnectorSpec) DeepCopyInto(out *KafkaConnectorSpec) {
+	*out = *in
+	if in.AuthSecretRef != nil {
+		in, out := &in.AuthSecretRef, &out.AuthSecretRef
+		*out = new(AuthSecretReference)
+		**out = **in
+	}
+	if in.UserConfig != nil {
+		in, out := &in.UserConfig, &out.UserConfig
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ConfigFrom != nil {
+		in, out := &in.ConfigFrom, &out.ConfigFrom
+		*out = make([]KafkaConnectorConfigFrom, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AutoRestart != nil {
+		in, out := &in.AutoRestart, &out.AutoRestart
+		*out = new(KafkaConnectorAutoRestart)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectorSpec.
+func (in *KafkaConnectorSpec) DeepCopy() *KafkaConnectorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConnectorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConnectorStatus) DeepCopyInto(out *KafkaConnectorStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.PluginStatus = in.PluginStatus
+	out.TasksStatus = in.TasksStatus
+	if in.LastRestartTime != nil {
+		in, out := &in.LastRestartTime, &out.LastRestartTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectorStatus.
+func (in *KafkaConnectorStatus) DeepCopy() *KafkaConnectorStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConnectorStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaConnectorTasksStatus) DeepCopyInto(out *KafkaConnectorTasksStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaConnectorTasksStatus.
+func (in *KafkaConnectorTasksStatus) DeepCopy() *KafkaConnectorTasksStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaConnectorTasksStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaList) DeepCopyInto(out *KafkaList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Kafka, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaList.
+func (in *KafkaList) DeepCopy() *KafkaList {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaMirrorMaker) DeepCopyInto(out *KafkaMirrorMaker) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaMirrorMaker.
+func (in *KafkaMirrorMaker) DeepCopy() *KafkaMirrorMaker {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaMirrorMaker)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaMirrorMaker) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaMirrorMakerList) DeepCopyInto(out *KafkaMirrorMakerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KafkaMirrorMaker, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaMirrorMakerList.
+func (in *KafkaMirrorMakerList) DeepCopy() *KafkaMirrorMakerList {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaMirrorMakerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaMirrorMakerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaMirrorMakerSpec) DeepCopyInto(out *KafkaMirrorMakerSpec) {
+	*out = *in
+	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
+	if in.AuthSecretRef != nil {
+		in, out := &in.AuthSecretRef, &out.AuthSecretRef
+		*out = new(AuthSecretReference)
+		**out = **in
+	}
+	if in.UserConfig != nil {
+		in, out := &in.UserConfig, &out.UserConfig
+		*out = new(kafka_mirrormaker.KafkaMirrormakerUserConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaMirrorMakerSpec.
+func (in *KafkaMirrorMakerSpec) DeepCopy() *KafkaMirrorMakerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaMirrorMakerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaReplicationFlow) DeepCopyInto(out *KafkaReplicationFlow) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaReplicationFlow.
+func (in *KafkaReplicationFlow) DeepCopy() *KafkaReplicationFlow {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaReplicationFlow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaReplicationFlow) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaReplicationFlowList) DeepCopyInto(out *KafkaReplicationFlowList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KafkaReplicationFlow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaReplicationFlowList.
+func (in *KafkaReplicationFlowList) DeepCopy() *KafkaReplicationFlowList {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaReplicationFlowList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaReplicationFlowList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaReplicationFlowSpec) DeepCopyInto(out *KafkaReplicationFlowSpec) {
+	*out = *in
+	if in.ServiceRef != nil {
+		in, out := &in.ServiceRef, &out.ServiceRef
+		*out = new(ResourceReference)
+		**out = **in
+	}
+	out.SourceKafkaRef = in.SourceKafkaRef
+	out.TargetKafkaRef = in.TargetKafkaRef
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Topics != nil {
+		in, out := &in.Topics, &out.Topics
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TopicsBlacklist != nil {
+		in, out := &in.TopicsBlacklist, &out.TopicsBlacklist
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AuthSecretRef != nil {
+		in, out := &in.AuthSecretRef, &out.AuthSecretRef
+		*out = new(AuthSecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaReplicationFlowSpec.
+func (in *KafkaReplicationFlowSpec) DeepCopy() *KafkaReplicationFlowSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaReplicationFlowSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaReplicationFlowStatus) DeepCopyInto(out *KafkaReplicationFlowStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ManagedIntegrationIDs != nil {
+		in, out := &in.ManagedIntegrationIDs, &out.ManagedIntegrationIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaReplicationFlowStatus.
+func (in *KafkaReplicationFlowStatus) DeepCopy() *KafkaReplicationFlowStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaReplicationFlowStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSchema) DeepCopyInto(out *KafkaSchema) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSchema.
+func (in *KafkaSchema) DeepCopy() *KafkaSchema {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSchema)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaSchema) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSchemaList) DeepCopyInto(out *KafkaSchemaList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KafkaSchema, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSchemaList.
+func (in *KafkaSchemaList) DeepCopy() *KafkaSchemaList {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSchemaList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaSchemaList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSchemaReference) DeepCopyInto(out *KafkaSchemaReference) {
+	*out = *in
+	if in.SchemaRef != nil {
+		in, out := &in.SchemaRef, &out.SchemaRef
+		*out = new(ResourceReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSchemaReference.
+func (in *KafkaSchemaReference) DeepCopy() *KafkaSchemaReference {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSchemaReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSchemaSpec) DeepCopyInto(out *KafkaSchemaSpec) {
+	*out = *in
+	if in.References != nil {
+		in, out := &in.References, &out.References
+		*out = make([]KafkaSchemaReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AuthSecretRef != nil {
+		in, out := &in.AuthSecretRef, &out.AuthSecretRef
+		*out = new(AuthSecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSchemaSpec.
+func (in *KafkaSchemaSpec) DeepCopy() *KafkaSchemaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSchemaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSchemaStatus) DeepCopyInto(out *KafkaSchemaStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Versions != nil {
+		in, out := &in.Versions, &out.Versions
+		*out = make([]KafkaSchemaVersion, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSchemaStatus.
+func (in *KafkaSchemaStatus) DeepCopy() *KafkaSchemaStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSchemaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSchemaVersion) DeepCopyInto(out *KafkaSchemaVersion) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSchemaVersion.
+func (in *KafkaSchemaVersion) DeepCopy() *KafkaSchemaVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSchemaVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSpec) DeepCopyInto(out *KafkaSpec) {
+	*out = *in
+	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
+	if in.AutoscaleDisk != nil {
+		in, out := &in.AutoscaleDisk, &out.AutoscaleDisk
+		*out = new(AutoscaleDisk)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AuthSecretRef != nil {
+		in, out := &in.AuthSecretRef, &out.AuthSecretRef
+		*out = new(AuthSecretReference)
+		**out = **in
+	}
+	in.ConnInfoSecretTarget.DeepCopyInto(&out.ConnInfoSecretTarget)
+	if in.Karapace != nil {
+		in, out := &in.Karapace, &out.Karapace
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UserConfig != nil {
+		in, out := &in.UserConfig, &out.UserConfig
+		*out = new(kafka.KafkaUserConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSpec.
+func (in *KafkaSpec) DeepCopy() *KafkaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaTopic) DeepCopyInto(out *KafkaTopic) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTopic.
+func (in *KafkaTopic) DeepCopy() *KafkaTopic {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaTopic)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1465,6 +3094,11 @@ func (in *MySQLList) DeepCopyObject() runtime.Object {
 func (in *MySQLSpec) DeepCopyInto(out *MySQLSpec) {
 	*out = *in
 	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
+	if in.AutoscaleDisk != nil {
+		in, out := &in.AutoscaleDisk, &out.AutoscaleDisk
+		*out = new(AutoscaleDisk)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AuthSecretRef != nil {
 		in, out := &in.AuthSecretRef, &out.AuthSecretRef
 		*out = new(AuthSecretReference)
@@ -1551,6 +3185,11 @@ func (in *OpenSearchList) DeepCopyObject() runtime.Object {
 func (in *OpenSearchSpec) DeepCopyInto(out *OpenSearchSpec) {
 	*out = *in
 	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
+	if in.AutoscaleDisk != nil {
+		in, out := &in.AutoscaleDisk, &out.AutoscaleDisk
+		*out = new(AutoscaleDisk)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AuthSecretRef != nil {
 		in, out := &in.AuthSecretRef, &out.AuthSecretRef
 		*out = new(AuthSecretReference)
@@ -1574,6 +3213,21 @@ func (in *OpenSearchSpec) DeepCopy() *OpenSearchSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PasswordSecretReference) DeepCopyInto(out *PasswordSecretReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordSecretReference.
+func (in *PasswordSecretReference) DeepCopy() *PasswordSecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(PasswordSecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PostgreSQL) DeepCopyInto(out *PostgreSQL) {
 	*out = *in
@@ -1637,6 +3291,11 @@ func (in *PostgreSQLList) DeepCopyObject() runtime.Object {
 func (in *PostgreSQLSpec) DeepCopyInto(out *PostgreSQLSpec) {
 	*out = *in
 	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
+	if in.AutoscaleDisk != nil {
+		in, out := &in.AutoscaleDisk, &out.AutoscaleDisk
+		*out = new(AutoscaleDisk)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AuthSecretRef != nil {
 		in, out := &in.AuthSecretRef, &out.AuthSecretRef
 		*out = new(AuthSecretReference)
@@ -1727,6 +3386,11 @@ func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.BillingGroupRef != nil {
+		in, out := &in.BillingGroupRef, &out.BillingGroupRef
+		*out = new(ResourceReference)
+		**out = **in
+	}
 	if in.TechnicalEmails != nil {
 		in, out := &in.TechnicalEmails, &out.TechnicalEmails
 		*out = make([]string, len(*in))
@@ -1943,6 +3607,11 @@ func (in *RedisList) DeepCopyObject() runtime.Object {
 func (in *RedisSpec) DeepCopyInto(out *RedisSpec) {
 	*out = *in
 	in.ServiceCommonSpec.DeepCopyInto(&out.ServiceCommonSpec)
+	if in.AutoscaleDisk != nil {
+		in, out := &in.AutoscaleDisk, &out.AutoscaleDisk
+		*out = new(AutoscaleDisk)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AuthSecretRef != nil {
 		in, out := &in.AuthSecretRef, &out.AuthSecretRef
 		*out = new(AuthSecretReference)
@@ -2011,6 +3680,16 @@ func (in *ServiceCommonSpec) DeepCopyInto(out *ServiceCommonSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Powered != nil {
+		in, out := &in.Powered, &out.Powered
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PowerSchedule != nil {
+		in, out := &in.PowerSchedule, &out.PowerSchedule
+		*out = new(ServicePowerSchedule)
+		**out = **in
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make(map[string]string, len(*in))
@@ -2029,6 +3708,16 @@ func (in *ServiceCommonSpec) DeepCopyInto(out *ServiceCommonSpec) {
 			}
 		}
 	}
+	if in.IPFilterFrom != nil {
+		in, out := &in.IPFilterFrom, &out.IPFilterFrom
+		*out = new(IPFilterFrom)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StaticIPRefs != nil {
+		in, out := &in.StaticIPRefs, &out.StaticIPRefs
+		*out = make([]ResourceReference, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceCommonSpec.
@@ -2065,7 +3754,184 @@ func (in *ServiceIntegration) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
-	return nil
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceIntegrationEndpoint) DeepCopyInto(out *ServiceIntegrationEndpoint) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceIntegrationEndpoint.
+func (in *ServiceIntegrationEndpoint) DeepCopy() *ServiceIntegrationEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceIntegrationEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceIntegrationEndpoint) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceIntegrationEndpointConfigFrom) DeepCopyInto(out *ServiceIntegrationEndpointConfigFrom) {
+	*out = *in
+	out.SecretKeyRef = in.SecretKeyRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceIntegrationEndpointConfigFrom.
+func (in *ServiceIntegrationEndpointConfigFrom) DeepCopy() *ServiceIntegrationEndpointConfigFrom {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceIntegrationEndpointConfigFrom)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceIntegrationEndpointKeySelector) DeepCopyInto(out *ServiceIntegrationEndpointKeySelector) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceIntegrationEndpointKeySelector.
+func (in *ServiceIntegrationEndpointKeySelector) DeepCopy() *ServiceIntegrationEndpointKeySelector {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceIntegrationEndpointKeySelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceIntegrationEndpointList) DeepCopyInto(out *ServiceIntegrationEndpointList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ServiceIntegrationEndpoint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceIntegrationEndpointList.
+func (in *ServiceIntegrationEndpointList) DeepCopy() *ServiceIntegrationEndpointList {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceIntegrationEndpointList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceIntegrationEndpointList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceIntegrationEndpointSpec) DeepCopyInto(out *ServiceIntegrationEndpointSpec) {
+	*out = *in
+	if in.DatadogUserConfig != nil {
+		in, out := &in.DatadogUserConfig, &out.DatadogUserConfig
+		*out = new(integrationendpointdatadog.DatadogUserConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExternalElasticsearchLogsUserConfig != nil {
+		in, out := &in.ExternalElasticsearchLogsUserConfig, &out.ExternalElasticsearchLogsUserConfig
+		*out = new(external_elasticsearch_logs.ExternalElasticsearchLogsUserConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExternalGoogleCloudLoggingUserConfig != nil {
+		in, out := &in.ExternalGoogleCloudLoggingUserConfig, &out.ExternalGoogleCloudLoggingUserConfig
+		*out = new(external_google_cloud_logging.ExternalGoogleCloudLoggingUserConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExternalKafkaUserConfig != nil {
+		in, out := &in.ExternalKafkaUserConfig, &out.ExternalKafkaUserConfig
+		*out = new(external_kafka.ExternalKafkaUserConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExternalOpensearchLogsUserConfig != nil {
+		in, out := &in.ExternalOpensearchLogsUserConfig, &out.ExternalOpensearchLogsUserConfig
+		*out = new(external_opensearch_logs.ExternalOpensearchLogsUserConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExternalSchemaRegistryUserConfig != nil {
+		in, out := &in.ExternalSchemaRegistryUserConfig, &out.ExternalSchemaRegistryUserConfig
+		*out = new(external_schema_registry.ExternalSchemaRegistryUserConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrometheusUserConfig != nil {
+		in, out := &in.PrometheusUserConfig, &out.PrometheusUserConfig
+		*out = new(prometheus.PrometheusUserConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RsyslogUserConfig != nil {
+		in, out := &in.RsyslogUserConfig, &out.RsyslogUserConfig
+		*out = new(rsyslog.RsyslogUserConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConfigFrom != nil {
+		in, out := &in.ConfigFrom, &out.ConfigFrom
+		*out = make([]ServiceIntegrationEndpointConfigFrom, len(*in))
+		copy(*out, *in)
+	}
+	if in.AuthSecretRef != nil {
+		in, out := &in.AuthSecretRef, &out.AuthSecretRef
+		*out = new(AuthSecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceIntegrationEndpointSpec.
+func (in *ServiceIntegrationEndpointSpec) DeepCopy() *ServiceIntegrationEndpointSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceIntegrationEndpointSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceIntegrationEndpointStatus) DeepCopyInto(out *ServiceIntegrationEndpointStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceIntegrationEndpointStatus.
+func (in *ServiceIntegrationEndpointStatus) DeepCopy() *ServiceIntegrationEndpointStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceIntegrationEndpointStatus)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -2083,6 +3949,22 @@ func (in *ServiceIntegrationItem) DeepCopy() *ServiceIntegrationItem {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceIntegrationItemStatus) DeepCopyInto(out *ServiceIntegrationItemStatus) {
+	*out = *in
+	out.ServiceIntegrationItem = in.ServiceIntegrationItem
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceIntegrationItemStatus.
+func (in *ServiceIntegrationItemStatus) DeepCopy() *ServiceIntegrationItemStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceIntegrationItemStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceIntegrationList) DeepCopyInto(out *ServiceIntegrationList) {
 	*out = *in
@@ -2118,6 +4000,16 @@ func (in *ServiceIntegrationList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceIntegrationSpec) DeepCopyInto(out *ServiceIntegrationSpec) {
 	*out = *in
+	if in.SourceEndpointRef != nil {
+		in, out := &in.SourceEndpointRef, &out.SourceEndpointRef
+		*out = new(ResourceReference)
+		**out = **in
+	}
+	if in.DestinationEndpointRef != nil {
+		in, out := &in.DestinationEndpointRef, &out.DestinationEndpointRef
+		*out = new(ResourceReference)
+		**out = **in
+	}
 	if in.DatadogUserConfig != nil {
 		in, out := &in.DatadogUserConfig, &out.DatadogUserConfig
 		*out = new(datadog.DatadogUserConfig)
@@ -2150,7 +4042,7 @@ func (in *ServiceIntegrationSpec) DeepCopyInto(out *ServiceIntegrationSpec) {
 	}
 	if in.KafkaMirrormakerUserConfig != nil {
 		in, out := &in.KafkaMirrormakerUserConfig, &out.KafkaMirrormakerUserConfig
-		*out = new(kafka_mirrormaker.KafkaMirrormakerUserConfig)
+		*out = new(integrationkafka_mirrormaker.KafkaMirrormakerUserConfig)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.LogsUserConfig != nil {
@@ -2202,6 +4094,21 @@ func (in *ServiceIntegrationStatus) DeepCopy() *ServiceIntegrationStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServicePowerSchedule) DeepCopyInto(out *ServicePowerSchedule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServicePowerSchedule.
+func (in *ServicePowerSchedule) DeepCopy() *ServicePowerSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(ServicePowerSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceStatus) DeepCopyInto(out *ServiceStatus) {
 	*out = *in
@@ -2212,6 +4119,35 @@ func (in *ServiceStatus) DeepCopyInto(out *ServiceStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ServiceIntegrations != nil {
+		in, out := &in.ServiceIntegrations, &out.ServiceIntegrations
+		*out = make([]ServiceIntegrationItemStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.DiskAutoscaleSteps != nil {
+		in, out := &in.DiskAutoscaleSteps, &out.DiskAutoscaleSteps
+		*out = make([]DiskAutoscaleStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IPFilterFrom != nil {
+		in, out := &in.IPFilterFrom, &out.IPFilterFrom
+		*out = new(IPFilterFromStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StaticIPs != nil {
+		in, out := &in.StaticIPs, &out.StaticIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceStatus.
@@ -2251,6 +4187,41 @@ func (in *ServiceUser) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceUserAccessControl) DeepCopyInto(out *ServiceUserAccessControl) {
+	*out = *in
+	if in.RedisACLCategories != nil {
+		in, out := &in.RedisACLCategories, &out.RedisACLCategories
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RedisACLCommands != nil {
+		in, out := &in.RedisACLCommands, &out.RedisACLCommands
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RedisACLKeys != nil {
+		in, out := &in.RedisACLKeys, &out.RedisACLKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RedisACLChannels != nil {
+		in, out := &in.RedisACLChannels, &out.RedisACLChannels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceUserAccessControl.
+func (in *ServiceUserAccessControl) DeepCopy() *ServiceUserAccessControl {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceUserAccessControl)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceUserList) DeepCopyInto(out *ServiceUserList) {
 	*out = *in
@@ -2286,7 +4257,17 @@ func (in *ServiceUserList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceUserSpec) DeepCopyInto(out *ServiceUserSpec) {
 	*out = *in
+	if in.AccessControl != nil {
+		in, out := &in.AccessControl, &out.AccessControl
+		*out = new(ServiceUserAccessControl)
+		(*in).DeepCopyInto(*out)
+	}
 	in.ConnInfoSecretTarget.DeepCopyInto(&out.ConnInfoSecretTarget)
+	if in.PasswordSecretRef != nil {
+		in, out := &in.PasswordSecretRef, &out.PasswordSecretRef
+		*out = new(PasswordSecretReference)
+		**out = **in
+	}
 	if in.AuthSecretRef != nil {
 		in, out := &in.AuthSecretRef, &out.AuthSecretRef
 		*out = new(AuthSecretReference)
@@ -2314,6 +4295,11 @@ func (in *ServiceUserStatus) DeepCopyInto(out *ServiceUserStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.AccessControl != nil {
+		in, out := &in.AccessControl, &out.AccessControl
+		*out = new(ServiceUserAccessControl)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceUserStatus.
@@ -2325,3 +4311,218 @@ func (in *ServiceUserStatus) DeepCopy() *ServiceUserStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StaticIP) DeepCopyInto(out *StaticIP) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticIP.
+func (in *StaticIP) DeepCopy() *StaticIP {
+	if in == nil {
+		return nil
+	}
+	out := new(StaticIP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *StaticIP) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StaticIPList) DeepCopyInto(out *StaticIPList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]StaticIP, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticIPList.
+func (in *StaticIPList) DeepCopy() *StaticIPList {
+	if in == nil {
+		return nil
+	}
+	out := new(StaticIPList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *StaticIPList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StaticIPSpec) DeepCopyInto(out *StaticIPSpec) {
+	*out = *in
+	if in.AuthSecretRef != nil {
+		in, out := &in.AuthSecretRef, &out.AuthSecretRef
+		*out = new(AuthSecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticIPSpec.
+func (in *StaticIPSpec) DeepCopy() *StaticIPSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(StaticIPSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StaticIPStatus) DeepCopyInto(out *StaticIPStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticIPStatus.
+func (in *StaticIPStatus) DeepCopy() *StaticIPStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(StaticIPStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCPeeringConnection) DeepCopyInto(out *VPCPeeringConnection) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCPeeringConnection.
+func (in *VPCPeeringConnection) DeepCopy() *VPCPeeringConnection {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCPeeringConnection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VPCPeeringConnection) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCPeeringConnectionList) DeepCopyInto(out *VPCPeeringConnectionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VPCPeeringConnection, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCPeeringConnectionList.
+func (in *VPCPeeringConnectionList) DeepCopy() *VPCPeeringConnectionList {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCPeeringConnectionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VPCPeeringConnectionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCPeeringConnectionSpec) DeepCopyInto(out *VPCPeeringConnectionSpec) {
+	*out = *in
+	out.ProjectVPCRef = in.ProjectVPCRef
+	if in.UserPeerNetworkCIDRs != nil {
+		in, out := &in.UserPeerNetworkCIDRs, &out.UserPeerNetworkCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AuthSecretRef != nil {
+		in, out := &in.AuthSecretRef, &out.AuthSecretRef
+		*out = new(AuthSecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCPeeringConnectionSpec.
+func (in *VPCPeeringConnectionSpec) DeepCopy() *VPCPeeringConnectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCPeeringConnectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCPeeringConnectionStatus) DeepCopyInto(out *VPCPeeringConnectionStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StateInfo != nil {
+		in, out := &in.StateInfo, &out.StateInfo
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCPeeringConnectionStatus.
+func (in *VPCPeeringConnectionStatus) DeepCopy() *VPCPeeringConnectionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCPeeringConnectionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
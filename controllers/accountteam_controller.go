@@ -0,0 +1,147 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/aiven/aiven-go-client"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// AccountTeamReconciler reconciles a AccountTeam object
+type AccountTeamReconciler struct {
+	Controller
+}
+
+//+kubebuilder:rbac:groups=aiven.io,resources=accountteams,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=aiven.io,resources=accountteams/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=aiven.io,resources=accountteams/finalizers,verbs=update
+
+func (r *AccountTeamReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.reconcileInstance(ctx, req, accountTeamHandler{}, &v1alpha1.AccountTeam{})
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *AccountTeamReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.AccountTeam{}).
+		Complete(r)
+}
+
+type accountTeamHandler struct{}
+
+func (h accountTeamHandler) createOrUpdate(avn *aiven.Client, obj client.Object, _ []client.Object) error {
+	team, err := h.convert(obj)
+	if err != nil {
+		return err
+	}
+
+	// Adopts the team with the same name
+	if team.Status.ID == "" {
+		list, err := avn.AccountTeams.List(team.Spec.AccountID)
+		if err != nil {
+			return err
+		}
+
+		for _, t := range list.Teams {
+			if t.Name == team.Spec.TeamName {
+				team.Status.ID = t.Id
+				break
+			}
+		}
+	}
+
+	reason := "Updated"
+	if team.Status.ID == "" {
+		reason = "Created"
+		r, err := avn.AccountTeams.Create(team.Spec.AccountID, aiven.AccountTeam{Name: team.Spec.TeamName})
+		if err != nil {
+			return err
+		}
+		team.Status.ID = r.Team.Id
+	} else {
+		_, err = avn.AccountTeams.Update(team.Spec.AccountID, team.Status.ID, aiven.AccountTeam{Name: team.Spec.TeamName})
+		if err != nil {
+			return err
+		}
+	}
+
+	meta.SetStatusCondition(&team.Status.Conditions,
+		getInitializedCondition(reason,
+			"Instance was created or update on Aiven side"))
+
+	meta.SetStatusCondition(&team.Status.Conditions,
+		getRunningCondition(metav1.ConditionUnknown, reason,
+			"Instance was created or update on Aiven side, status remains unknown"))
+
+	metav1.SetMetaDataAnnotation(&team.ObjectMeta,
+		processedGenerationAnnotation, strconv.FormatInt(team.GetGeneration(), formatIntBaseDecimal))
+
+	return nil
+}
+
+func (h accountTeamHandler) delete(avn *aiven.Client, obj client.Object) (bool, error) {
+	team, err := h.convert(obj)
+	if err != nil {
+		return false, err
+	}
+
+	if team.Status.ID == "" {
+		return true, nil
+	}
+
+	err = avn.AccountTeams.Delete(team.Spec.AccountID, team.Status.ID)
+	if err != nil && !aiven.IsNotFound(err) {
+		return false, fmt.Errorf("aiven client delete account team error: %w", err)
+	}
+
+	return true, nil
+}
+
+func (h accountTeamHandler) get(avn *aiven.Client, obj client.Object) (*corev1.Secret, error) {
+	team, err := h.convert(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = avn.AccountTeams.Get(team.Spec.AccountID, team.Status.ID)
+	if aiven.IsNotFound(err) {
+		// Deleted out-of-band, creates it again
+		team.Status.ID = ""
+		delete(team.Annotations, processedGenerationAnnotation)
+		delete(team.Annotations, instanceIsRunningAnnotation)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	meta.SetStatusCondition(&team.Status.Conditions,
+		getRunningCondition(metav1.ConditionTrue, "CheckRunning",
+			"Instance is running on Aiven side"))
+
+	metav1.SetMetaDataAnnotation(&team.ObjectMeta, instanceIsRunningAnnotation, "true")
+	return nil, nil
+}
+
+func (h accountTeamHandler) checkPreconditions(_ *aiven.Client, _ client.Object) (bool, error) {
+	return true, nil
+}
+
+func (h accountTeamHandler) convert(i client.Object) (*v1alpha1.AccountTeam, error) {
+	team, ok := i.(*v1alpha1.AccountTeam)
+	if !ok {
+		return nil, fmt.Errorf("cannot convert object to AccountTeam")
+	}
+
+	return team, nil
+}
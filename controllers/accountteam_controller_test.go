@@ -0,0 +1,123 @@
+package controllers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// fakeAccountAPI serves account "acc1" teams, members, invites and projects
+type fakeAccountAPI struct {
+	*fakeAivenAPI
+	teams    []aiven.AccountTeam
+	members  []aiven.AccountTeamMember
+	invites  []aiven.AccountTeamInvite
+	projects []aiven.AccountTeamProject
+}
+
+func newFakeAccountAPI(t *testing.T, teams ...aiven.AccountTeam) *fakeAccountAPI {
+	f := &fakeAccountAPI{teams: teams}
+	f.fakeAivenAPI = newFakeAivenAPI(t, fakeRoutes{
+		"GET /v1/account/acc1/teams": func(*http.Request, []string) (int, any) {
+			return http.StatusOK, map[string]any{"teams": f.teams}
+		},
+		"POST /v1/account/acc1/teams": func(r *http.Request, _ []string) (int, any) {
+			team := aiven.AccountTeam{}
+			f.decode(r, &team)
+			team.Id = "team1"
+			f.teams = append(f.teams, team)
+			f.call("create team %s", team.Name)
+			return http.StatusOK, map[string]any{"team": team}
+		},
+		"GET /v1/account/acc1/team/team1": f.team(func(*http.Request, []string) (int, any) {
+			return http.StatusOK, map[string]any{"team": f.teams[0]}
+		}),
+		"PUT /v1/account/acc1/team/team1": f.team(func(*http.Request, []string) (int, any) {
+			f.call("PUT team/team1")
+			return http.StatusOK, map[string]any{"team": f.teams[0]}
+		}),
+		"DELETE /v1/account/acc1/team/team1": f.team(func(*http.Request, []string) (int, any) {
+			f.call("DELETE team/team1")
+			return http.StatusOK, map[string]any{}
+		}),
+		"GET /v1/account/acc1/team/team1/members": f.team(func(*http.Request, []string) (int, any) {
+			return http.StatusOK, map[string]any{"members": f.members}
+		}),
+		"POST /v1/account/acc1/team/team1/members": f.team(func(r *http.Request, _ []string) (int, any) {
+			req := map[string]string{}
+			f.decode(r, &req)
+			f.invites = append(f.invites, aiven.AccountTeamInvite{TeamId: "team1", UserEmail: req["email"], InvitedByUserEmail: "admin@example.com"})
+			f.call("invite %s", req["email"])
+			return http.StatusOK, map[string]any{}
+		}),
+		"GET /v1/account/acc1/team/team1/invites": f.team(func(*http.Request, []string) (int, any) {
+			return http.StatusOK, map[string]any{"account_invites": f.invites}
+		}),
+		"DELETE /v1/account/acc1/team/team1/invites/*": f.team(func(_ *http.Request, params []string) (int, any) {
+			f.call("DELETE team/team1/invites/%s", params[0])
+			return http.StatusOK, map[string]any{}
+		}),
+		"GET /v1/account/acc1/team/team1/projects": f.team(func(*http.Request, []string) (int, any) {
+			return http.StatusOK, map[string]any{"projects": f.projects}
+		}),
+		"* /v1/account/acc1/team/team1/project/*": f.team(func(r *http.Request, params []string) (int, any) {
+			p := aiven.AccountTeamProject{}
+			if r.Method != http.MethodDelete {
+				f.decode(r, &p)
+			}
+			p.ProjectName = params[0]
+			f.projects = []aiven.AccountTeamProject{p}
+			f.call("%s %s %s", r.Method, p.ProjectName, p.TeamType)
+			return http.StatusOK, map[string]any{}
+		}),
+	})
+	return f
+}
+
+// team returns 404 until the team is created
+func (f *fakeAccountAPI) team(h fakeHandler) fakeHandler {
+	return func(r *http.Request, params []string) (int, any) {
+		if len(f.teams) == 0 {
+			return fakeNotFound(r, params)
+		}
+		return h(r, params)
+	}
+}
+
+func TestAccountTeamHandler(t *testing.T) {
+	api := newFakeAccountAPI(t)
+	avn := newFakeAivenClient(api)
+	h := accountTeamHandler{}
+	team := &v1alpha1.AccountTeam{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-team", Generation: 1},
+		Spec:       v1alpha1.AccountTeamSpec{AccountID: "acc1", TeamName: "developers"},
+	}
+
+	// Creates
+	require.NoError(t, h.createOrUpdate(avn, team, nil))
+	assert.Equal(t, "team1", team.Status.ID)
+	_, err := h.get(avn, team)
+	require.NoError(t, err)
+	assert.True(t, IsAlreadyRunning(team))
+
+	// Adopts the team with the same name
+	adopted := &v1alpha1.AccountTeam{
+		ObjectMeta: metav1.ObjectMeta{Name: "same-team", Generation: 1},
+		Spec:       v1alpha1.AccountTeamSpec{AccountID: "acc1", TeamName: "developers"},
+	}
+	require.NoError(t, h.createOrUpdate(avn, adopted, nil))
+	assert.Equal(t, "team1", adopted.Status.ID)
+	assert.Equal(t, []string{"create team developers", "PUT team/team1"}, api.calls)
+
+	// Deletes
+	deleted, err := h.delete(avn, team)
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	assert.Equal(t, "DELETE team/team1", api.calls[2])
+}
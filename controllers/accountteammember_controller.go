@@ -0,0 +1,215 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// accountTeamMemberResyncInterval how often the invitation state is checked
+const accountTeamMemberResyncInterval = 5 * time.Minute
+
+// AccountTeamMemberReconciler reconciles a AccountTeamMember object
+type AccountTeamMemberReconciler struct {
+	Controller
+}
+
+//+kubebuilder:rbac:groups=aiven.io,resources=accountteammembers,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=aiven.io,resources=accountteammembers/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=aiven.io,resources=accountteammembers/finalizers,verbs=update
+
+func (r *AccountTeamMemberReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	result, err := r.reconcileInstance(ctx, req, accountTeamMemberHandler{}, &v1alpha1.AccountTeamMember{})
+
+	// The user accepts the invitation outside the operator, comes back to update the status
+	if err == nil && result.IsZero() {
+		result.RequeueAfter = accountTeamMemberResyncInterval
+	}
+	return result, err
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *AccountTeamMemberReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.AccountTeamMember{}).
+		Complete(r)
+}
+
+type accountTeamMemberHandler struct{}
+
+func (h accountTeamMemberHandler) createOrUpdate(avn *aiven.Client, obj client.Object, refs []client.Object) error {
+	member, err := h.convert(obj)
+	if err != nil {
+		return err
+	}
+
+	team := v1alpha1.FindAccountTeam(refs)
+	if team == nil || team.Status.ID == "" {
+		return fmt.Errorf("account team %q is not ready", member.Spec.TeamRef.Name)
+	}
+
+	member.Status.AccountID = team.Spec.AccountID
+	member.Status.TeamID = team.Status.ID
+
+	// Doesn't invite twice
+	reason := "Updated"
+	err = h.updateState(avn, member)
+	if err != nil {
+		return err
+	}
+
+	if member.Status.State == "" {
+		reason = "Created"
+		err = avn.AccountTeamMembers.Invite(member.Status.AccountID, member.Status.TeamID, member.Spec.UserEmail)
+		if err != nil {
+			return err
+		}
+		member.Status.State = v1alpha1.AccountTeamMemberStateInvited
+	}
+
+	meta.SetStatusCondition(&member.Status.Conditions,
+		getInitializedCondition(reason,
+			"Instance was created or update on Aiven side"))
+
+	meta.SetStatusCondition(&member.Status.Conditions,
+		getRunningCondition(metav1.ConditionUnknown, reason,
+			"Instance was created or update on Aiven side, status remains unknown"))
+
+	metav1.SetMetaDataAnnotation(&member.ObjectMeta,
+		processedGenerationAnnotation, strconv.FormatInt(member.GetGeneration(), formatIntBaseDecimal))
+
+	return nil
+}
+
+func (h accountTeamMemberHandler) delete(avn *aiven.Client, obj client.Object) (bool, error) {
+	member, err := h.convert(obj)
+	if err != nil {
+		return false, err
+	}
+
+	if member.Status.TeamID == "" {
+		return true, nil
+	}
+
+	err = h.updateState(avn, member)
+	if aiven.IsNotFound(err) {
+		return true, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	switch member.Status.State {
+	case v1alpha1.AccountTeamMemberStateMember:
+		err = avn.AccountTeamMembers.Delete(member.Status.AccountID, member.Status.TeamID, member.Status.UserID)
+	case v1alpha1.AccountTeamMemberStateInvited:
+		err = avn.AccountTeamInvites.Delete(member.Status.AccountID, member.Status.TeamID, member.Spec.UserEmail)
+	}
+
+	if err != nil && !aiven.IsNotFound(err) {
+		return false, fmt.Errorf("aiven client delete account team member error: %w", err)
+	}
+
+	return true, nil
+}
+
+func (h accountTeamMemberHandler) get(avn *aiven.Client, obj client.Object) (*corev1.Secret, error) {
+	member, err := h.convert(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.updateState(avn, member)
+	if err != nil {
+		return nil, err
+	}
+
+	if member.Status.State == "" {
+		// The invitation is declined, expired or the user is removed out-of-band.
+		// Invites again only on request, otherwise the user would get an invitation every resync
+		if _, ok := member.Annotations[v1alpha1.AccountTeamMemberReinviteAnnotation]; ok {
+			delete(member.Annotations, v1alpha1.AccountTeamMemberReinviteAnnotation)
+			delete(member.Annotations, processedGenerationAnnotation)
+			delete(member.Annotations, instanceIsRunningAnnotation)
+			return nil, nil
+		}
+
+		// Settled, the state is checked again on resync or when the annotation is set
+		member.Status.State = v1alpha1.AccountTeamMemberStateDeclined
+		meta.SetStatusCondition(&member.Status.Conditions,
+			getRunningCondition(metav1.ConditionFalse, v1alpha1.AccountTeamMemberStateDeclined,
+				fmt.Sprintf("The invitation is declined or expired, or the user is removed from the team. Set the %q annotation to invite again",
+					v1alpha1.AccountTeamMemberReinviteAnnotation)))
+		metav1.SetMetaDataAnnotation(&member.ObjectMeta, instanceIsRunningAnnotation, "true")
+		return nil, nil
+	}
+
+	meta.SetStatusCondition(&member.Status.Conditions,
+		getRunningCondition(metav1.ConditionTrue, "CheckRunning",
+			"Instance is running on Aiven side"))
+
+	metav1.SetMetaDataAnnotation(&member.ObjectMeta, instanceIsRunningAnnotation, "true")
+	return nil, nil
+}
+
+func (h accountTeamMemberHandler) checkPreconditions(_ *aiven.Client, _ client.Object) (bool, error) {
+	return true, nil
+}
+
+// updateState sets the membership state: the user is a member, has a pending invitation, or neither
+func (h accountTeamMemberHandler) updateState(avn *aiven.Client, member *v1alpha1.AccountTeamMember) error {
+	member.Status.State = ""
+	member.Status.UserID = ""
+	member.Status.InvitedBy = ""
+
+	members, err := avn.AccountTeamMembers.List(member.Status.AccountID, member.Status.TeamID)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range members.Members {
+		if strings.EqualFold(m.UserEmail, member.Spec.UserEmail) {
+			member.Status.State = v1alpha1.AccountTeamMemberStateMember
+			member.Status.UserID = m.UserId
+			return nil
+		}
+	}
+
+	invites, err := avn.AccountTeamInvites.List(member.Status.AccountID, member.Status.TeamID)
+	if err != nil {
+		return err
+	}
+
+	for _, i := range invites.Invites {
+		if strings.EqualFold(i.UserEmail, member.Spec.UserEmail) {
+			member.Status.State = v1alpha1.AccountTeamMemberStateInvited
+			member.Status.InvitedBy = i.InvitedByUserEmail
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func (h accountTeamMemberHandler) convert(i client.Object) (*v1alpha1.AccountTeamMember, error) {
+	member, ok := i.(*v1alpha1.AccountTeamMember)
+	if !ok {
+		return nil, fmt.Errorf("cannot convert object to AccountTeamMember")
+	}
+
+	return member, nil
+}
@@ -0,0 +1,77 @@
+package controllers
+
+import (
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestAccountTeamMemberHandler(t *testing.T) {
+	api := newFakeAccountAPI(t, aiven.AccountTeam{Id: "team1", Name: "developers"})
+	avn := newFakeAivenClient(api)
+	h := accountTeamMemberHandler{}
+	team := &v1alpha1.AccountTeam{
+		Spec:   v1alpha1.AccountTeamSpec{AccountID: "acc1"},
+		Status: v1alpha1.AccountTeamStatus{ID: "team1"},
+	}
+	member := &v1alpha1.AccountTeamMember{
+		ObjectMeta: metav1.ObjectMeta{Name: "jane", Generation: 1},
+		Spec:       v1alpha1.AccountTeamMemberSpec{TeamRef: v1alpha1.ResourceReference{Name: "my-team"}, UserEmail: "jane.doe@example.com"},
+	}
+
+	// Invites once
+	require.NoError(t, h.createOrUpdate(avn, member, []client.Object{team}))
+	require.NoError(t, h.createOrUpdate(avn, member, []client.Object{team}))
+	assert.Equal(t, []string{"invite jane.doe@example.com"}, api.calls)
+
+	_, err := h.get(avn, member)
+	require.NoError(t, err)
+	assert.True(t, IsAlreadyRunning(member))
+	assert.Equal(t, v1alpha1.AccountTeamMemberStateInvited, member.Status.State)
+	assert.Equal(t, "admin@example.com", member.Status.InvitedBy)
+
+	// Accepted
+	api.invites = nil
+	api.members = []aiven.AccountTeamMember{{UserId: "u1", UserEmail: "Jane.Doe@example.com"}}
+	_, err = h.get(avn, member)
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.AccountTeamMemberStateMember, member.Status.State)
+	assert.Equal(t, "u1", member.Status.UserID)
+
+	// Removed out-of-band, doesn't invite again on its own, settles until resync
+	api.members = nil
+	for i := 0; i < 2; i++ {
+		_, err = h.get(avn, member)
+		require.NoError(t, err)
+		assert.Equal(t, v1alpha1.AccountTeamMemberStateDeclined, member.Status.State)
+		assert.True(t, isAlreadyProcessed(member))
+		assert.True(t, IsAlreadyRunning(member))
+	}
+	c := meta.FindStatusCondition(member.Status.Conditions, conditionTypeRunning)
+	require.NotNil(t, c)
+	assert.Equal(t, metav1.ConditionFalse, c.Status)
+	assert.Equal(t, v1alpha1.AccountTeamMemberStateDeclined, c.Reason)
+	assert.Equal(t, []string{"invite jane.doe@example.com"}, api.calls)
+
+	// Invites again on request
+	metav1.SetMetaDataAnnotation(&member.ObjectMeta, v1alpha1.AccountTeamMemberReinviteAnnotation, "true")
+	_, err = h.get(avn, member)
+	require.NoError(t, err)
+	assert.False(t, isAlreadyProcessed(member))
+	assert.False(t, IsAlreadyRunning(member))
+	assert.NotContains(t, member.Annotations, v1alpha1.AccountTeamMemberReinviteAnnotation)
+
+	// Deletes the invitation
+	require.NoError(t, h.createOrUpdate(avn, member, []client.Object{team}))
+	deleted, err := h.delete(avn, member)
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	assert.Equal(t, []string{"invite jane.doe@example.com", "invite jane.doe@example.com", "DELETE team/team1/invites/jane.doe@example.com"}, api.calls)
+}
@@ -0,0 +1,168 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// accountTeamProjectResyncInterval how often the project access is checked
+const accountTeamProjectResyncInterval = 5 * time.Minute
+
+// AccountTeamProjectReconciler reconciles a AccountTeamProject object
+type AccountTeamProjectReconciler struct {
+	Controller
+}
+
+//+kubebuilder:rbac:groups=aiven.io,resources=accountteamprojects,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=aiven.io,resources=accountteamprojects/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=aiven.io,resources=accountteamprojects/finalizers,verbs=update
+
+func (r *AccountTeamProjectReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	result, err := r.reconcileInstance(ctx, req, accountTeamProjectHandler{}, &v1alpha1.AccountTeamProject{})
+
+	// Reverts changes made in the console
+	if err == nil && result.IsZero() {
+		result.RequeueAfter = accountTeamProjectResyncInterval
+	}
+	return result, err
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *AccountTeamProjectReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.AccountTeamProject{}).
+		Complete(r)
+}
+
+type accountTeamProjectHandler struct{}
+
+func (h accountTeamProjectHandler) createOrUpdate(avn *aiven.Client, obj client.Object, refs []client.Object) error {
+	p, err := h.convert(obj)
+	if err != nil {
+		return err
+	}
+
+	team := v1alpha1.FindAccountTeam(refs)
+	if team == nil || team.Status.ID == "" {
+		return fmt.Errorf("account team %q is not ready", p.Spec.TeamRef.Name)
+	}
+
+	p.Status.AccountID = team.Spec.AccountID
+	p.Status.TeamID = team.Status.ID
+
+	current, err := h.getTeamProject(avn, p)
+	if err != nil {
+		return err
+	}
+
+	reason := "Updated"
+	req := aiven.AccountTeamProject{ProjectName: p.Spec.Project, TeamType: p.Spec.TeamType}
+	if current == nil {
+		reason = "Created"
+		err = avn.AccountTeamProjects.Create(p.Status.AccountID, p.Status.TeamID, req)
+	} else if current.TeamType != p.Spec.TeamType {
+		err = avn.AccountTeamProjects.Update(p.Status.AccountID, p.Status.TeamID, req)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	meta.SetStatusCondition(&p.Status.Conditions,
+		getInitializedCondition(reason,
+			"Instance was created or update on Aiven side"))
+
+	meta.SetStatusCondition(&p.Status.Conditions,
+		getRunningCondition(metav1.ConditionUnknown, reason,
+			"Instance was created or update on Aiven side, status remains unknown"))
+
+	metav1.SetMetaDataAnnotation(&p.ObjectMeta,
+		processedGenerationAnnotation, strconv.FormatInt(p.GetGeneration(), formatIntBaseDecimal))
+
+	return nil
+}
+
+func (h accountTeamProjectHandler) delete(avn *aiven.Client, obj client.Object) (bool, error) {
+	p, err := h.convert(obj)
+	if err != nil {
+		return false, err
+	}
+
+	if p.Status.TeamID == "" {
+		return true, nil
+	}
+
+	err = avn.AccountTeamProjects.Delete(p.Status.AccountID, p.Status.TeamID, p.Spec.Project)
+	if err != nil && !aiven.IsNotFound(err) {
+		return false, fmt.Errorf("aiven client delete account team project error: %w", err)
+	}
+
+	return true, nil
+}
+
+func (h accountTeamProjectHandler) get(avn *aiven.Client, obj client.Object) (*corev1.Secret, error) {
+	p, err := h.convert(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := h.getTeamProject(avn, p)
+	if err != nil {
+		return nil, err
+	}
+
+	if current == nil || current.TeamType != p.Spec.TeamType {
+		// Changed out-of-band, applies the spec again
+		delete(p.Annotations, processedGenerationAnnotation)
+		delete(p.Annotations, instanceIsRunningAnnotation)
+		return nil, nil
+	}
+
+	meta.SetStatusCondition(&p.Status.Conditions,
+		getRunningCondition(metav1.ConditionTrue, "CheckRunning",
+			"Instance is running on Aiven side"))
+
+	metav1.SetMetaDataAnnotation(&p.ObjectMeta, instanceIsRunningAnnotation, "true")
+	return nil, nil
+}
+
+func (h accountTeamProjectHandler) checkPreconditions(_ *aiven.Client, _ client.Object) (bool, error) {
+	return true, nil
+}
+
+// getTeamProject returns the team project, or nil if the team has no access to it
+func (h accountTeamProjectHandler) getTeamProject(avn *aiven.Client, p *v1alpha1.AccountTeamProject) (*aiven.AccountTeamProject, error) {
+	list, err := avn.AccountTeamProjects.List(p.Status.AccountID, p.Status.TeamID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range list.Projects {
+		if v.ProjectName == p.Spec.Project {
+			return &v, nil
+		}
+	}
+	return nil, nil
+}
+
+func (h accountTeamProjectHandler) convert(i client.Object) (*v1alpha1.AccountTeamProject, error) {
+	p, ok := i.(*v1alpha1.AccountTeamProject)
+	if !ok {
+		return nil, fmt.Errorf("cannot convert object to AccountTeamProject")
+	}
+
+	return p, nil
+}
@@ -0,0 +1,46 @@
+package controllers
+
+import (
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestAccountTeamProjectHandler(t *testing.T) {
+	api := newFakeAccountAPI(t, aiven.AccountTeam{Id: "team1", Name: "developers"})
+	avn := newFakeAivenClient(api)
+	h := accountTeamProjectHandler{}
+	team := &v1alpha1.AccountTeam{
+		Spec:   v1alpha1.AccountTeamSpec{AccountID: "acc1"},
+		Status: v1alpha1.AccountTeamStatus{ID: "team1"},
+	}
+	p := &v1alpha1.AccountTeamProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-team-project", Generation: 1},
+		Spec:       v1alpha1.AccountTeamProjectSpec{TeamRef: v1alpha1.ResourceReference{Name: "my-team"}, Project: "my-project", TeamType: "developer"},
+	}
+
+	// Creates
+	require.NoError(t, h.createOrUpdate(avn, p, []client.Object{team}))
+	_, err := h.get(avn, p)
+	require.NoError(t, err)
+	assert.True(t, IsAlreadyRunning(p))
+
+	// Changed out-of-band
+	api.projects[0].TeamType = "admin"
+	_, err = h.get(avn, p)
+	require.NoError(t, err)
+	assert.False(t, isAlreadyProcessed(p))
+
+	// Updates
+	require.NoError(t, h.createOrUpdate(avn, p, []client.Object{team}))
+	deleted, err := h.delete(avn, p)
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	assert.Equal(t, []string{"POST my-project developer", "PUT my-project developer", "DELETE my-project "}, api.calls)
+}
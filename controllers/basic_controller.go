@@ -40,6 +40,10 @@ type (
 		Scheme       *runtime.Scheme
 		Recorder     record.EventRecorder
 		DefaultToken string
+		// ServiceTags is used by the service kinds only
+		ServiceTags ServiceTagsConfig
+		// APIReader is used by the service kinds only, reads nodes, services and configmaps without a cache
+		APIReader client.Reader
 	}
 
 	// Handlers represents Aiven API handlers
@@ -505,7 +509,7 @@ func UserConfigurationToAPI(c interface{}) interface{} {
 // UserConfigurationToAPIV2 same as UserConfigurationToAPI but uses sheriff.Marshal
 // which can subset fields from create or update operation
 func UserConfigurationToAPIV2(userConfig interface{}, groups []string) (map[string]interface{}, error) {
-	if userConfig == nil {
+	if isNil(userConfig) {
 		return nil, nil
 	}
 
@@ -0,0 +1,172 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aiven/aiven-go-client"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// BillingGroupReconciler reconciles a BillingGroup object
+type BillingGroupReconciler struct {
+	Controller
+}
+
+//+kubebuilder:rbac:groups=aiven.io,resources=billinggroups,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=aiven.io,resources=billinggroups/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=aiven.io,resources=billinggroups/finalizers,verbs=update
+
+func (r *BillingGroupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.reconcileInstance(ctx, req, billingGroupHandler{}, &v1alpha1.BillingGroup{})
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *BillingGroupReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.BillingGroup{}).
+		Complete(r)
+}
+
+type billingGroupHandler struct{}
+
+func (h billingGroupHandler) createOrUpdate(avn *aiven.Client, obj client.Object, _ []client.Object) error {
+	group, err := h.convert(obj)
+	if err != nil {
+		return err
+	}
+
+	cardID, err := ProjectHandler{}.getLongCardID(avn, group.Spec.CardID)
+	if err != nil {
+		return fmt.Errorf("cannot get long card id: %w", err)
+	}
+
+	req := aiven.BillingGroupRequest{
+		BillingGroupName: group.GetBillingGroupName(),
+		AccountId:        toOptionalStringPointer(group.Spec.AccountID),
+		CardId:           cardID,
+		VatId:            toOptionalStringPointer(group.Spec.VatID),
+		BillingCurrency:  toOptionalStringPointer(group.Spec.BillingCurrency),
+		BillingExtraText: toOptionalStringPointer(group.Spec.BillingExtraText),
+		Company:          toOptionalStringPointer(group.Spec.Company),
+		AddressLines:     group.Spec.AddressLines,
+		CountryCode:      toOptionalStringPointer(group.Spec.CountryCode),
+		City:             toOptionalStringPointer(group.Spec.City),
+		State:            toOptionalStringPointer(group.Spec.State),
+		ZipCode:          toOptionalStringPointer(group.Spec.ZipCode),
+	}
+
+	if len(group.Spec.BillingEmails) > 0 {
+		req.BillingEmails = *aiven.ContactEmailFromStringSlice(group.Spec.BillingEmails)
+	}
+
+	reason := "Updated"
+	if group.Status.ID == "" {
+		reason = "Created"
+		g, err := avn.BillingGroup.Create(req)
+		if err != nil {
+			return err
+		}
+		group.Status.ID = g.Id
+	} else {
+		_, err = avn.BillingGroup.Update(group.Status.ID, req)
+		if err != nil {
+			return err
+		}
+	}
+
+	meta.SetStatusCondition(&group.Status.Conditions,
+		getInitializedCondition(reason,
+			"Instance was created or update on Aiven side"))
+
+	meta.SetStatusCondition(&group.Status.Conditions,
+		getRunningCondition(metav1.ConditionUnknown, reason,
+			"Instance was created or update on Aiven side, status remains unknown"))
+
+	metav1.SetMetaDataAnnotation(&group.ObjectMeta,
+		processedGenerationAnnotation, strconv.FormatInt(group.GetGeneration(), formatIntBaseDecimal))
+
+	return nil
+}
+
+func (h billingGroupHandler) delete(avn *aiven.Client, obj client.Object) (bool, error) {
+	group, err := h.convert(obj)
+	if err != nil {
+		return false, err
+	}
+
+	if group.Status.ID == "" {
+		return true, nil
+	}
+
+	// Projects must be moved to another billing group or deleted first
+	projects, err := avn.BillingGroup.GetProjects(group.Status.ID)
+	if aiven.IsNotFound(err) {
+		return true, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	if len(projects) > 0 {
+		return false, fmt.Errorf("%w: billing group has projects %s", v1alpha1.ErrDeleteDependencies, strings.Join(projects, ", "))
+	}
+
+	err = avn.BillingGroup.Delete(group.Status.ID)
+	if err != nil && !aiven.IsNotFound(err) {
+		return false, fmt.Errorf("aiven client delete billing group error: %w", err)
+	}
+
+	return true, nil
+}
+
+func (h billingGroupHandler) get(avn *aiven.Client, obj client.Object) (*corev1.Secret, error) {
+	group, err := h.convert(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	projects, err := avn.BillingGroup.GetProjects(group.Status.ID)
+	if aiven.IsNotFound(err) {
+		// Deleted out-of-band, creates it again
+		group.Status.ID = ""
+		delete(group.Annotations, processedGenerationAnnotation)
+		delete(group.Annotations, instanceIsRunningAnnotation)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	group.Status.Projects = projects
+	meta.SetStatusCondition(&group.Status.Conditions,
+		getRunningCondition(metav1.ConditionTrue, "CheckRunning",
+			"Instance is running on Aiven side"))
+
+	metav1.SetMetaDataAnnotation(&group.ObjectMeta, instanceIsRunningAnnotation, "true")
+	return nil, nil
+}
+
+func (h billingGroupHandler) checkPreconditions(_ *aiven.Client, _ client.Object) (bool, error) {
+	return true, nil
+}
+
+func (h billingGroupHandler) convert(i client.Object) (*v1alpha1.BillingGroup, error) {
+	group, ok := i.(*v1alpha1.BillingGroup)
+	if !ok {
+		return nil, fmt.Errorf("cannot convert object to BillingGroup")
+	}
+
+	return group, nil
+}
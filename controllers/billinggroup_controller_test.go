@@ -0,0 +1,150 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// fakeBillingGroupAPI serves billing group "bg1" and project "my-project"
+type fakeBillingGroupAPI struct {
+	*fakeAivenAPI
+	projects map[string]string // project name to billing group ID
+}
+
+func newFakeBillingGroupAPI(t *testing.T, projects map[string]string) *fakeBillingGroupAPI {
+	f := &fakeBillingGroupAPI{projects: projects}
+	f.fakeAivenAPI = newFakeAivenAPI(t, fakeRoutes{
+		"POST /v1/billing-group": func(r *http.Request, _ []string) (int, any) {
+			req := aiven.BillingGroupRequest{}
+			f.decode(r, &req)
+			f.call("create %s %s", req.BillingGroupName, *req.BillingCurrency)
+			return http.StatusOK, map[string]any{"billing_group": aiven.BillingGroup{Id: "bg1"}}
+		},
+		"GET /v1/billing-group/bg1/projects": func(*http.Request, []string) (int, any) {
+			list := make([]aiven.BillingGroupProject, 0)
+			for name, id := range f.projects {
+				if id == "bg1" {
+					list = append(list, aiven.BillingGroupProject{ProjectName: name})
+				}
+			}
+			return http.StatusOK, map[string]any{"projects": list}
+		},
+		"POST /v1/billing-group/bg1/projects-assign": func(r *http.Request, _ []string) (int, any) {
+			req := map[string][]string{}
+			f.decode(r, &req)
+			for _, name := range req["projects_names"] {
+				f.projects[name] = "bg1"
+				f.call("assign %s", name)
+			}
+			return http.StatusOK, map[string]any{}
+		},
+		"DELETE /v1/billing-group/bg1": func(*http.Request, []string) (int, any) {
+			f.call("delete bg1")
+			return http.StatusOK, map[string]any{}
+		},
+		"GET /v1/project/my-project":        f.project,
+		"PUT /v1/project/my-project":        f.project,
+		"GET /v1/project/my-project/kms/ca": fakeResponse(http.StatusOK, map[string]any{"certificate": "my-ca"}),
+	})
+	return f
+}
+
+func (f *fakeBillingGroupAPI) project(*http.Request, []string) (int, any) {
+	return http.StatusOK, map[string]any{"project": aiven.Project{Name: "my-project", BillingGroupId: f.projects["my-project"]}}
+}
+
+func TestBillingGroupHandler(t *testing.T) {
+	api := newFakeBillingGroupAPI(t, map[string]string{"my-project": "bg0"})
+	avn := newFakeAivenClient(api)
+	h := billingGroupHandler{}
+	group := &v1alpha1.BillingGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-billing-group", Generation: 1},
+		Spec:       v1alpha1.BillingGroupSpec{BillingCurrency: "EUR"},
+	}
+
+	// Creates
+	require.NoError(t, h.createOrUpdate(avn, group, nil))
+	assert.Equal(t, "bg1", group.Status.ID)
+	_, err := h.get(avn, group)
+	require.NoError(t, err)
+	assert.True(t, IsAlreadyRunning(group))
+	assert.Empty(t, group.Status.Projects)
+
+	// Moves the existing project
+	project := &v1alpha1.Project{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-project", Generation: 2},
+		Spec:       v1alpha1.ProjectSpec{BillingGroupRef: &v1alpha1.ResourceReference{Name: "my-billing-group"}},
+	}
+	require.NoError(t, ProjectHandler{}.createOrUpdate(avn, project, []client.Object{group}))
+	assert.Equal(t, "bg1", project.Status.BillingGroupID)
+	assert.NoError(t, project.ValidateCreate())
+	project.Spec.CardID = "1234"
+	assert.EqualError(t, project.ValidateCreate(), "billing fields can't be set with 'billingGroupRef', set them in the BillingGroup")
+
+	// Can't be deleted with projects
+	_, err = h.get(avn, group)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"my-project"}, group.Status.Projects)
+	deleted, err := h.delete(avn, group)
+	assert.False(t, deleted)
+	assert.True(t, errors.Is(err, v1alpha1.ErrDeleteDependencies))
+
+	delete(api.projects, "my-project")
+	deleted, err = h.delete(avn, group)
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	assert.Equal(t, []string{"create my-billing-group EUR", "assign my-project", "delete bg1"}, api.calls)
+}
+
+func TestProjectBillingGroupRef(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+	group := &v1alpha1.BillingGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-billing-group", Namespace: "billing"},
+		Status:     v1alpha1.BillingGroupStatus{ID: "bg1"},
+	}
+	k8s := fake.NewClientBuilder().WithScheme(scheme).WithObjects(group).Build()
+
+	api := newFakeBillingGroupAPI(t, map[string]string{"my-project": "bg0"})
+	avn := newFakeAivenClient(api)
+	h := ProjectHandler{k8s: k8s}
+	project := &v1alpha1.Project{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "my-project",
+			Namespace:   "default",
+			Generation:  1,
+			Annotations: map[string]string{processedGenerationAnnotation: "1"},
+		},
+		Spec:   v1alpha1.ProjectSpec{BillingGroupRef: &v1alpha1.ResourceReference{Name: "my-billing-group", Namespace: "billing"}},
+		Status: v1alpha1.ProjectStatus{BillingGroupID: "bg1"},
+	}
+	assert.Equal(t, []string{"billing/my-billing-group"}, projectBillingGroupRefIndexFunc(project))
+	assert.Nil(t, projectBillingGroupRefIndexFunc(&v1alpha1.Project{}))
+
+	// The project is in the billing group
+	_, err := h.get(avn, project)
+	require.NoError(t, err)
+	assert.True(t, isAlreadyProcessed(project))
+
+	// The billing group is replaced, the project must be assigned again
+	group.Status.ID = "bg2"
+	require.NoError(t, k8s.Status().Update(context.Background(), group))
+	_, err = h.get(avn, project)
+	require.NoError(t, err)
+	assert.False(t, isAlreadyProcessed(project))
+	assert.True(t, IsAlreadyRunning(project))
+}
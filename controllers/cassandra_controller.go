@@ -26,7 +26,7 @@ type CassandraReconciler struct {
 // +kubebuilder:rbac:groups=aiven.io,resources=cassandras/finalizers,verbs=update
 
 func (r *CassandraReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	return r.reconcileInstance(ctx, req, newGenericServiceHandler(newCassandraAdapter), &v1alpha1.Cassandra{})
+	return r.reconcileService(ctx, req, newCassandraAdapter, &v1alpha1.Cassandra{})
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -86,3 +86,7 @@ func (a *cassandraAdapter) getServiceType() string {
 func (a *cassandraAdapter) getDiskSpace() string {
 	return a.Spec.DiskSpace
 }
+
+func (a *cassandraAdapter) getAutoscaleDisk() *v1alpha1.AutoscaleDisk {
+	return a.Spec.AutoscaleDisk
+}
@@ -25,7 +25,7 @@ type ClickhouseReconciler struct {
 //+kubebuilder:rbac:groups=aiven.io,resources=clickhouses/finalizers,verbs=update
 
 func (r *ClickhouseReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	return r.reconcileInstance(ctx, req, newGenericServiceHandler(newClickhouseAdapter), &v1alpha1.Clickhouse{})
+	return r.reconcileService(ctx, req, newClickhouseAdapter, &v1alpha1.Clickhouse{})
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -83,3 +83,7 @@ func (a *clickhouseAdapter) getServiceType() string {
 func (a *clickhouseAdapter) getDiskSpace() string {
 	return a.Spec.DiskSpace
 }
+
+func (a *clickhouseAdapter) getAutoscaleDisk() *v1alpha1.AutoscaleDisk {
+	return a.Spec.AutoscaleDisk
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aiven/aiven-go-client"
+)
+
+// clickhouseSystemDatabase queries that manage access entities run in the system database
+const clickhouseSystemDatabase = "system"
+
+// clickhouseQuery runs the query and returns rows as column name to value maps
+func clickhouseQuery(avn *aiven.Client, project, serviceName, query string) ([]map[string]any, error) {
+	r, err := avn.ClickHouseQuery.Query(project, serviceName, clickhouseSystemDatabase, query)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]map[string]any, 0, len(r.Data))
+	for _, d := range r.Data {
+		values, ok := d.([]any)
+		if !ok || len(values) != len(r.Meta) {
+			return nil, fmt.Errorf("unexpected ClickHouse query result row %v", d)
+		}
+
+		row := make(map[string]any, len(values))
+		for i, m := range r.Meta {
+			row[m.Name] = values[i]
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// escapeClickhouseIdentifier quotes database, table, user and role names
+func escapeClickhouseIdentifier(s string) string {
+	return "`" + strings.NewReplacer(`\`, `\\`, "`", "\\`").Replace(s) + "`"
+}
+
+// escapeClickhouseString quotes string literals
+func escapeClickhouseString(s string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(s) + "'"
+}
+
+// clickhouseString returns a string column value, NULL is an empty string
+func clickhouseString(v any) string {
+	if v == nil {
+		return ""
+	}
+	return fmt.Sprint(v)
+}
+
+// clickhouseBool returns a boolean column value, ClickHouse returns them as UInt8
+func clickhouseBool(v any) bool {
+	switch b := v.(type) {
+	case bool:
+		return b
+	case float64:
+		return b != 0
+	case json.Number:
+		return b.String() != "0"
+	case string:
+		return b == "1" || b == "true"
+	}
+	return false
+}
@@ -0,0 +1,138 @@
+package controllers
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClickhouseAPI keeps roles and grants of a single grantee, changes them with the queries it receives
+type fakeClickhouseAPI struct {
+	*fakeAivenAPI
+	queries    []string
+	roles      map[string]bool
+	grants     [][]any // access_type, database, table, grant_option
+	roleGrants [][]any // granted_role_name, with_admin_option
+}
+
+func newFakeClickhouseAPI(t *testing.T) *fakeClickhouseAPI {
+	f := &fakeClickhouseAPI{roles: make(map[string]bool)}
+	f.fakeAivenAPI = newFakeAivenAPI(t, fakeRoutes{
+		"POST /v1/project/my-project/service/my-clickhouse/clickhouse/query": f.query,
+	})
+	return f
+}
+
+var (
+	fakeClickhouseGrantPrivilege  = regexp.MustCompile("^GRANT ([A-Z ]+) ON (.+) TO `[^`]+`( WITH GRANT OPTION)?$")
+	fakeClickhouseRevokePrivilege = regexp.MustCompile("^REVOKE ([A-Z ]+) ON (.+) FROM `[^`]+`$")
+	fakeClickhouseGrantRole       = regexp.MustCompile("^GRANT `([^`]+)` TO `[^`]+`( WITH ADMIN OPTION)?$")
+	fakeClickhouseRevokeRole      = regexp.MustCompile("^REVOKE `([^`]+)` FROM `[^`]+`$")
+	fakeClickhouseTarget          = regexp.MustCompile("^(?:`([^`]+)`|\\*)\\.(?:`([^`]+)`|\\*)$")
+)
+
+func (f *fakeClickhouseAPI) query(r *http.Request, _ []string) (int, any) {
+	req := new(aiven.ClickhouseQueryRequest)
+	f.decode(r, req)
+	require.Equal(f.t, "system", req.Database)
+
+	q := req.Query
+	meta := make([]map[string]string, 0)
+	data := make([][]any, 0)
+	switch {
+	case strings.HasPrefix(q, "SELECT name FROM system.roles"):
+		meta = append(meta, map[string]string{"name": "name", "type": "String"})
+		for name := range f.roles {
+			if strings.HasSuffix(q, "'"+name+"'") {
+				data = append(data, []any{name})
+			}
+		}
+	case strings.HasPrefix(q, "SELECT access_type"):
+		for _, c := range []string{"access_type", "database", "table", "grant_option"} {
+			meta = append(meta, map[string]string{"name": c})
+		}
+		data = f.grants
+	case strings.HasPrefix(q, "SELECT granted_role_name"):
+		for _, c := range []string{"granted_role_name", "with_admin_option"} {
+			meta = append(meta, map[string]string{"name": c})
+		}
+		data = f.roleGrants
+	default:
+		f.queries = append(f.queries, q)
+		f.exec(q)
+	}
+	return http.StatusOK, map[string]any{"meta": meta, "data": data}
+}
+
+func (f *fakeClickhouseAPI) exec(q string) {
+	if m := fakeClickhouseGrantPrivilege.FindStringSubmatch(q); m != nil {
+		target := f.target(m[2])
+		f.grants = append(f.grants, []any{m[1], target[0], target[1], boolToInt(m[3] != "")})
+		return
+	}
+	if m := fakeClickhouseRevokePrivilege.FindStringSubmatch(q); m != nil {
+		target := f.target(m[2])
+		grants := make([][]any, 0)
+		for _, g := range f.grants {
+			if g[0] != m[1] || g[1] != target[0] || g[2] != target[1] {
+				grants = append(grants, g)
+			}
+		}
+		f.grants = grants
+		return
+	}
+	if m := fakeClickhouseGrantRole.FindStringSubmatch(q); m != nil {
+		f.roleGrants = append(f.roleGrants, []any{m[1], boolToInt(m[2] != "")})
+		return
+	}
+	if m := fakeClickhouseRevokeRole.FindStringSubmatch(q); m != nil {
+		roleGrants := make([][]any, 0)
+		for _, g := range f.roleGrants {
+			if g[0] != m[1] {
+				roleGrants = append(roleGrants, g)
+			}
+		}
+		f.roleGrants = roleGrants
+		return
+	}
+
+	switch {
+	case strings.HasPrefix(q, "CREATE ROLE IF NOT EXISTS "):
+		f.roles[strings.Trim(strings.TrimPrefix(q, "CREATE ROLE IF NOT EXISTS "), "`")] = true
+	case strings.HasPrefix(q, "DROP ROLE IF EXISTS "):
+		delete(f.roles, strings.Trim(strings.TrimPrefix(q, "DROP ROLE IF EXISTS "), "`"))
+	default:
+		f.t.Fatalf("unexpected query %q", q)
+	}
+}
+
+// target returns database and table, nil for any
+func (f *fakeClickhouseAPI) target(s string) []any {
+	m := fakeClickhouseTarget.FindStringSubmatch(s)
+	require.NotNil(f.t, m, s)
+	target := []any{nil, nil}
+	for i, v := range m[1:] {
+		if v != "" {
+			target[i] = v
+		}
+	}
+	return target
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func TestEscapeClickhouse(t *testing.T) {
+	assert.Equal(t, "`my\\`db`", escapeClickhouseIdentifier("my`db"))
+	assert.Equal(t, `'it\'s'`, escapeClickhouseString("it's"))
+	assert.Equal(t, `'back\\slash'`, escapeClickhouseString(`back\slash`))
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/aiven/aiven-go-client"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// ClickhouseDatabaseReconciler reconciles a ClickhouseDatabase object
+type ClickhouseDatabaseReconciler struct {
+	Controller
+}
+
+//+kubebuilder:rbac:groups=aiven.io,resources=clickhousedatabases,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=aiven.io,resources=clickhousedatabases/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=aiven.io,resources=clickhousedatabases/finalizers,verbs=update
+
+func (r *ClickhouseDatabaseReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.reconcileInstance(ctx, req, clickhouseDatabaseHandler{}, &v1alpha1.ClickhouseDatabase{})
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ClickhouseDatabaseReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.ClickhouseDatabase{}).
+		Complete(r)
+}
+
+type clickhouseDatabaseHandler struct{}
+
+func (h clickhouseDatabaseHandler) createOrUpdate(avn *aiven.Client, obj client.Object, refs []client.Object) error {
+	db, err := h.convert(obj)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range refs {
+		if s, ok := r.(*v1alpha1.Clickhouse); ok && s.Spec.Project != db.Spec.Project {
+			return fmt.Errorf("referenced Clickhouse %q belongs to project %q", s.Name, s.Spec.Project)
+		}
+	}
+
+	// Databases of integrations exist already, the resource only adopts them
+	_, err = avn.ClickhouseDatabase.Get(db.Spec.Project, db.Spec.ServiceName, db.GetDatabaseName())
+	if aiven.IsNotFound(err) {
+		err = avn.ClickhouseDatabase.Create(db.Spec.Project, db.Spec.ServiceName, db.GetDatabaseName())
+	}
+	if err != nil {
+		return fmt.Errorf("cannot create ClickHouse database on Aiven side: %w", err)
+	}
+
+	meta.SetStatusCondition(&db.Status.Conditions,
+		getInitializedCondition("Created",
+			"Instance was created or update on Aiven side"))
+
+	meta.SetStatusCondition(&db.Status.Conditions,
+		getRunningCondition(metav1.ConditionUnknown, "Created",
+			"Instance was created or update on Aiven side, status remains unknown"))
+
+	metav1.SetMetaDataAnnotation(&db.ObjectMeta,
+		processedGenerationAnnotation, strconv.FormatInt(db.GetGeneration(), formatIntBaseDecimal))
+
+	return nil
+}
+
+func (h clickhouseDatabaseHandler) delete(avn *aiven.Client, obj client.Object) (bool, error) {
+	db, err := h.convert(obj)
+	if err != nil {
+		return false, err
+	}
+
+	if fromAnyPointer(db.Spec.TerminationProtection) {
+		return false, errTerminationProtectionOn
+	}
+
+	// The integration owns the database, it is removed with the integration
+	if db.Status.ManagedByIntegration {
+		return true, nil
+	}
+
+	err = avn.ClickhouseDatabase.Delete(db.Spec.Project, db.Spec.ServiceName, db.GetDatabaseName())
+	if err != nil && !aiven.IsNotFound(err) {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (h clickhouseDatabaseHandler) get(avn *aiven.Client, obj client.Object) (*corev1.Secret, error) {
+	db, err := h.convert(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := avn.ClickhouseDatabase.Get(db.Spec.Project, db.Spec.ServiceName, db.GetDatabaseName())
+	if err != nil {
+		return nil, err
+	}
+
+	db.Status.Engine = d.Engine
+	db.Status.ManagedByIntegration = d.Required
+
+	meta.SetStatusCondition(&db.Status.Conditions,
+		getRunningCondition(metav1.ConditionTrue, "CheckRunning",
+			"Instance is running on Aiven side"))
+
+	metav1.SetMetaDataAnnotation(&db.ObjectMeta, instanceIsRunningAnnotation, "true")
+	return nil, nil
+}
+
+func (h clickhouseDatabaseHandler) checkPreconditions(avn *aiven.Client, obj client.Object) (bool, error) {
+	db, err := h.convert(obj)
+	if err != nil {
+		return false, err
+	}
+
+	meta.SetStatusCondition(&db.Status.Conditions,
+		getInitializedCondition("Preconditions", "Checking preconditions"))
+
+	return checkServiceIsRunning(avn, db.Spec.Project, db.Spec.ServiceName)
+}
+
+func (h clickhouseDatabaseHandler) convert(i client.Object) (*v1alpha1.ClickhouseDatabase, error) {
+	db, ok := i.(*v1alpha1.ClickhouseDatabase)
+	if !ok {
+		return nil, fmt.Errorf("cannot convert object to ClickhouseDatabase")
+	}
+	return db, nil
+}
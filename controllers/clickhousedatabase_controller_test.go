@@ -0,0 +1,120 @@
+package controllers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// fakeClickhouseDatabaseAPI keeps databases of a single service
+type fakeClickhouseDatabaseAPI struct {
+	*fakeAivenAPI
+	databases []aiven.ClickhouseDatabase
+	created   []string
+}
+
+func newFakeClickhouseDatabaseAPI(t *testing.T, databases ...aiven.ClickhouseDatabase) *fakeClickhouseDatabaseAPI {
+	const dbPath = "/v1/project/my-project/service/my-clickhouse/clickhouse/db"
+
+	f := &fakeClickhouseDatabaseAPI{databases: databases}
+	f.fakeAivenAPI = newFakeAivenAPI(t, fakeRoutes{
+		"GET " + dbPath: func(*http.Request, []string) (int, any) {
+			return http.StatusOK, map[string]any{"databases": f.databases}
+		},
+		"POST " + dbPath: func(r *http.Request, _ []string) (int, any) {
+			req := new(aiven.ClickhouseDatabaseRequest)
+			f.decode(r, req)
+			f.created = append(f.created, req.Database)
+			f.databases = append(f.databases, aiven.ClickhouseDatabase{Name: req.Database, Engine: "Replicated"})
+			return http.StatusOK, map[string]any{}
+		},
+		"DELETE " + dbPath + "/*": func(r *http.Request, params []string) (int, any) {
+			for i, d := range f.databases {
+				if d.Name == params[0] {
+					f.databases = append(f.databases[:i], f.databases[i+1:]...)
+					return http.StatusOK, map[string]any{}
+				}
+			}
+			return fakeNotFound(r, params)
+		},
+	})
+	return f
+}
+
+func TestClickhouseDatabaseHandler(t *testing.T) {
+	api := newFakeClickhouseDatabaseAPI(t)
+	avn := newFakeAivenClient(api)
+	db := &v1alpha1.ClickhouseDatabase{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-db", Namespace: "default"},
+		Spec: v1alpha1.ClickhouseDatabaseSpec{
+			Project:      "my-project",
+			ServiceName:  "my-clickhouse",
+			ServiceRef:   &v1alpha1.ResourceReference{Name: "my-clickhouse"},
+			DatabaseName: "my_db",
+		},
+	}
+	assert.Len(t, db.GetRefs(), 1)
+
+	// The referenced service must be in the same project
+	service := &v1alpha1.Clickhouse{ObjectMeta: metav1.ObjectMeta{Name: "my-clickhouse"}}
+	service.Spec.Project = "other-project"
+	err := clickhouseDatabaseHandler{}.createOrUpdate(avn, db, []client.Object{service})
+	assert.EqualError(t, err, `referenced Clickhouse "my-clickhouse" belongs to project "other-project"`)
+
+	// Creates
+	service.Spec.Project = "my-project"
+	require.NoError(t, clickhouseDatabaseHandler{}.createOrUpdate(avn, db, []client.Object{service}))
+	assert.Equal(t, []string{"my_db"}, api.created)
+	_, err = clickhouseDatabaseHandler{}.get(avn, db)
+	require.NoError(t, err)
+	assert.Equal(t, "Replicated", db.Status.Engine)
+	assert.False(t, db.Status.ManagedByIntegration)
+
+	// Termination protection
+	protected := true
+	db.Spec.TerminationProtection = &protected
+	_, err = clickhouseDatabaseHandler{}.delete(avn, db)
+	assert.ErrorIs(t, err, errTerminationProtectionOn)
+
+	// Deletes
+	db.Spec.TerminationProtection = nil
+	deleted, err := clickhouseDatabaseHandler{}.delete(avn, db)
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	assert.Empty(t, api.databases)
+}
+
+func TestClickhouseDatabaseHandlerIntegration(t *testing.T) {
+	api := newFakeClickhouseDatabaseAPI(t, aiven.ClickhouseDatabase{
+		Name: "service_my-kafka", Engine: "Memory", Required: true,
+	})
+	avn := newFakeAivenClient(api)
+	db := &v1alpha1.ClickhouseDatabase{
+		ObjectMeta: metav1.ObjectMeta{Name: "kafka-db"},
+		Spec: v1alpha1.ClickhouseDatabaseSpec{
+			Project:      "my-project",
+			ServiceName:  "my-clickhouse",
+			DatabaseName: "service_my-kafka",
+		},
+	}
+
+	// Adopts the integration database
+	require.NoError(t, clickhouseDatabaseHandler{}.createOrUpdate(avn, db, nil))
+	assert.Empty(t, api.created)
+	_, err := clickhouseDatabaseHandler{}.get(avn, db)
+	require.NoError(t, err)
+	assert.True(t, db.Status.ManagedByIntegration)
+
+	// Is kept on delete
+	deleted, err := clickhouseDatabaseHandler{}.delete(avn, db)
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	assert.Len(t, api.databases, 1)
+}
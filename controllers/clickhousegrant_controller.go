@@ -0,0 +1,614 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aiven/aiven-go-client"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// ClickhouseGrantReconciler reconciles a ClickhouseGrant object
+type ClickhouseGrantReconciler struct {
+	Controller
+}
+
+//+kubebuilder:rbac:groups=aiven.io,resources=clickhousegrants,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=aiven.io,resources=clickhousegrants/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=aiven.io,resources=clickhousegrants/finalizers,verbs=update
+
+func (r *ClickhouseGrantReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	result, err := r.reconcileInstance(ctx, req, clickhouseGrantHandler{ctx: ctx, k8s: r.Client}, &v1alpha1.ClickhouseGrant{})
+
+	// Comes back to revert privileges changed out-of-band
+	if err == nil && result.IsZero() {
+		result.RequeueAfter = clickhouseResyncInterval
+	}
+	return result, err
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ClickhouseGrantReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.ClickhouseGrant{}).
+		Complete(r)
+}
+
+// clickhouseGrantHandler keeps grantee privileges and roles in sync with the spec.
+// ClickHouse has no API for access entities, so grants are read from system tables and changed with GRANT/REVOKE.
+type clickhouseGrantHandler struct {
+	ctx context.Context
+	k8s client.Client
+}
+
+// clickhouseGrantee user or role name, column is the one that keeps it in system.grants and system.role_grants
+type clickhouseGrantee struct {
+	column string
+	name   string
+}
+
+type clickhousePrivilege struct {
+	privilege   string
+	database    string
+	table       string
+	grantOption bool
+}
+
+// target returns the privilege with the database and table it is granted on
+func (p clickhousePrivilege) target() string {
+	on := "*.*"
+	if p.database != "" {
+		on = escapeClickhouseIdentifier(p.database) + ".*"
+		if p.table != "" {
+			on = escapeClickhouseIdentifier(p.database) + "." + escapeClickhouseIdentifier(p.table)
+		}
+	}
+	return p.privilege + " ON " + on
+}
+
+type clickhouseGrantedRole struct {
+	role        string
+	adminOption bool
+}
+
+func (h clickhouseGrantHandler) createOrUpdate(avn *aiven.Client, obj client.Object, _ []client.Object) error {
+	g, err := h.convert(obj)
+	if err != nil {
+		return err
+	}
+
+	grantee, err := h.getGrantee(g)
+	if err != nil {
+		return err
+	}
+
+	statements, err := h.diff(avn, g, grantee)
+	if err != nil {
+		return err
+	}
+
+	for _, s := range statements {
+		_, err = clickhouseQuery(avn, g.Spec.Project, g.Spec.ServiceName, s)
+		if err != nil {
+			return fmt.Errorf("cannot apply ClickHouse grant: %w", err)
+		}
+	}
+
+	meta.SetStatusCondition(&g.Status.Conditions,
+		getInitializedCondition("Created",
+			"Instance was created or update on Aiven side"))
+
+	meta.SetStatusCondition(&g.Status.Conditions,
+		getRunningCondition(metav1.ConditionUnknown, "Created",
+			"Instance was created or update on Aiven side, status remains unknown"))
+
+	metav1.SetMetaDataAnnotation(&g.ObjectMeta,
+		processedGenerationAnnotation, strconv.FormatInt(g.GetGeneration(), formatIntBaseDecimal))
+
+	return nil
+}
+
+// delete revokes everything granted to the grantee
+func (h clickhouseGrantHandler) delete(avn *aiven.Client, obj client.Object) (bool, error) {
+	g, err := h.convert(obj)
+	if err != nil {
+		return false, err
+	}
+
+	grantee, err := h.getGrantee(g)
+	if apierrors.IsNotFound(err) {
+		// The grantee role is removed with its grants
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	owner, err := h.getOwner(g, grantee)
+	if err != nil {
+		return false, err
+	}
+	if owner != "" {
+		// The grants belong to another ClickhouseGrant, this one has never applied them
+		return true, nil
+	}
+
+	privileges, roles, err := h.list(avn, g, grantee)
+	if err != nil {
+		return false, err
+	}
+
+	for _, s := range clickhouseGrantStatements(grantee, nil, privileges, nil, roles) {
+		_, err = clickhouseQuery(avn, g.Spec.Project, g.Spec.ServiceName, s)
+		if err != nil && !aiven.IsNotFound(err) {
+			return false, fmt.Errorf("cannot revoke ClickHouse grant: %w", err)
+		}
+	}
+
+	return true, nil
+}
+
+func (h clickhouseGrantHandler) get(avn *aiven.Client, obj client.Object) (*corev1.Secret, error) {
+	g, err := h.convert(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	grantee, err := h.getGrantee(g)
+	if err != nil {
+		return nil, err
+	}
+
+	statements, err := h.diff(avn, g, grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(statements) > 0 {
+		// Changed out-of-band, marks the generation as not processed, so grants are applied again
+		delete(g.Annotations, processedGenerationAnnotation)
+		delete(g.Annotations, instanceIsRunningAnnotation)
+		meta.SetStatusCondition(&g.Status.Conditions,
+			getRunningCondition(metav1.ConditionFalse, "DriftDetected",
+				fmt.Sprintf("Grants were changed out-of-band, applying: %s", strings.Join(statements, "; "))))
+		return nil, nil
+	}
+
+	meta.SetStatusCondition(&g.Status.Conditions,
+		getRunningCondition(metav1.ConditionTrue, "CheckRunning",
+			"Instance is running on Aiven side"))
+
+	metav1.SetMetaDataAnnotation(&g.ObjectMeta, instanceIsRunningAnnotation, "true")
+	return nil, nil
+}
+
+func (h clickhouseGrantHandler) checkPreconditions(avn *aiven.Client, obj client.Object) (bool, error) {
+	g, err := h.convert(obj)
+	if err != nil {
+		return false, err
+	}
+
+	meta.SetStatusCondition(&g.Status.Conditions,
+		getInitializedCondition("Preconditions", "Checking preconditions"))
+
+	grantee, err := h.getGrantee(g)
+	if err != nil {
+		return false, err
+	}
+
+	owner, err := h.getOwner(g, grantee)
+	if err != nil {
+		return false, err
+	}
+	if owner != "" {
+		return false, fmt.Errorf("grantee %q is already managed by ClickhouseGrant %s", grantee.name, owner)
+	}
+
+	return checkServiceIsRunning(avn, g.Spec.Project, g.Spec.ServiceName)
+}
+
+// getOwner returns the name of another ClickhouseGrant that manages the same grantee.
+// Two grants would revoke each other's privileges, so the oldest one owns the grantee
+func (h clickhouseGrantHandler) getOwner(g *v1alpha1.ClickhouseGrant, grantee clickhouseGrantee) (string, error) {
+	list := &v1alpha1.ClickhouseGrantList{}
+	err := h.k8s.List(h.ctx, list)
+	if err != nil {
+		return "", err
+	}
+
+	for i := range list.Items {
+		o := &list.Items[i]
+		if o.UID == g.UID || o.Spec.Project != g.Spec.Project || o.Spec.ServiceName != g.Spec.ServiceName {
+			continue
+		}
+
+		if !clickhouseGrantIsOlder(o, g) {
+			continue
+		}
+
+		other, err := h.getGrantee(o)
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+
+		if other == grantee {
+			return o.Namespace + "/" + o.Name, nil
+		}
+	}
+	return "", nil
+}
+
+// clickhouseGrantIsOlder compares creation time, then names, so exactly one of two grants is older
+func clickhouseGrantIsOlder(a, b *v1alpha1.ClickhouseGrant) bool {
+	if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+		return a.CreationTimestamp.Before(&b.CreationTimestamp)
+	}
+	return a.Namespace+"/"+a.Name < b.Namespace+"/"+b.Name
+}
+
+func (h clickhouseGrantHandler) convert(i client.Object) (*v1alpha1.ClickhouseGrant, error) {
+	g, ok := i.(*v1alpha1.ClickhouseGrant)
+	if !ok {
+		return nil, fmt.Errorf("cannot convert object to ClickhouseGrant")
+	}
+	return g, nil
+}
+
+// getGrantee returns ClickHouse name of the user or role.
+// ClickhouseUser is named after the object, ClickhouseRole has it in the spec.
+func (h clickhouseGrantHandler) getGrantee(g *v1alpha1.ClickhouseGrant) (clickhouseGrantee, error) {
+	if g.Spec.Grantee.UserRef != nil {
+		return clickhouseGrantee{column: "user_name", name: g.Spec.Grantee.GetRef(g.Namespace).NamespacedName.Name}, nil
+	}
+
+	name, err := h.getRoleName(g.Spec.Grantee.GetRef(g.Namespace))
+	return clickhouseGrantee{column: "role_name", name: name}, err
+}
+
+func (h clickhouseGrantHandler) getRoleName(ref *v1alpha1.ResourceReferenceObject) (string, error) {
+	role := new(v1alpha1.ClickhouseRole)
+	err := h.k8s.Get(h.ctx, ref.NamespacedName, role)
+	if err != nil {
+		return "", err
+	}
+	return role.Spec.Role, nil
+}
+
+// diff returns statements that make grantee privileges and roles match the spec
+func (h clickhouseGrantHandler) diff(avn *aiven.Client, g *v1alpha1.ClickhouseGrant, grantee clickhouseGrantee) ([]string, error) {
+	privileges := make([]clickhousePrivilege, 0, len(g.Spec.PrivilegeGrants))
+	for _, p := range g.Spec.PrivilegeGrants {
+		privileges = append(privileges, clickhousePrivilege{
+			privilege:   normalizeClickhousePrivilege(p.Privilege),
+			database:    p.Database,
+			table:       p.Table,
+			grantOption: p.WithGrantOption,
+		})
+	}
+
+	privileges = compactClickhousePrivileges(privileges)
+
+	roles := make([]clickhouseGrantedRole, 0, len(g.Spec.RoleGrants))
+	for _, r := range g.Spec.RoleGrants {
+		name, err := h.getRoleName(r.RoleRef.ClickhouseRole(g.Namespace))
+		if err != nil {
+			return nil, err
+		}
+		roles = append(roles, clickhouseGrantedRole{role: name, adminOption: r.WithAdminOption})
+	}
+
+	currentPrivileges, currentRoles, err := h.list(avn, g, grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	return clickhouseGrantStatements(grantee, privileges, currentPrivileges, roles, currentRoles), nil
+}
+
+// list returns privileges and roles granted to the grantee.
+// Column level privileges and partial revokes are not managed.
+func (h clickhouseGrantHandler) list(avn *aiven.Client, g *v1alpha1.ClickhouseGrant, grantee clickhouseGrantee) ([]clickhousePrivilege, []clickhouseGrantedRole, error) {
+	rows, err := clickhouseQuery(avn, g.Spec.Project, g.Spec.ServiceName, fmt.Sprintf(
+		"SELECT access_type, database, table, grant_option FROM system.grants WHERE %s = %s AND column IS NULL AND is_partial_revoke = 0",
+		grantee.column, escapeClickhouseString(grantee.name),
+	))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	privileges := make([]clickhousePrivilege, 0, len(rows))
+	for _, r := range rows {
+		privileges = append(privileges, clickhousePrivilege{
+			privilege:   normalizeClickhousePrivilege(clickhouseString(r["access_type"])),
+			database:    clickhouseString(r["database"]),
+			table:       clickhouseString(r["table"]),
+			grantOption: clickhouseBool(r["grant_option"]),
+		})
+	}
+
+	rows, err = clickhouseQuery(avn, g.Spec.Project, g.Spec.ServiceName, fmt.Sprintf(
+		"SELECT granted_role_name, with_admin_option FROM system.role_grants WHERE %s = %s",
+		grantee.column, escapeClickhouseString(grantee.name),
+	))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	roles := make([]clickhouseGrantedRole, 0, len(rows))
+	for _, r := range rows {
+		roles = append(roles, clickhouseGrantedRole{
+			role:        clickhouseString(r["granted_role_name"]),
+			adminOption: clickhouseBool(r["with_admin_option"]),
+		})
+	}
+
+	return privileges, roles, nil
+}
+
+// clickhouseGrantStatements returns REVOKE statements for what is granted but not wanted,
+// then GRANT statements for what is wanted but missing.
+// A changed grant or admin option is revoked and granted again.
+func clickhouseGrantStatements(
+	grantee clickhouseGrantee,
+	privileges, currentPrivileges []clickhousePrivilege,
+	roles, currentRoles []clickhouseGrantedRole,
+) []string {
+	to := escapeClickhouseIdentifier(grantee.name)
+	revokes := make([]string, 0)
+	grants := make([]string, 0)
+
+	wantPrivileges := make(map[clickhousePrivilege]bool, len(privileges))
+	for _, p := range privileges {
+		wantPrivileges[p] = true
+	}
+	hasPrivileges := make(map[clickhousePrivilege]bool, len(currentPrivileges))
+	for _, p := range currentPrivileges {
+		hasPrivileges[p] = true
+		if !wantPrivileges[p] {
+			revokes = append(revokes, fmt.Sprintf("REVOKE %s FROM %s", p.target(), to))
+		}
+	}
+	for _, p := range privileges {
+		if !hasPrivileges[p] {
+			s := fmt.Sprintf("GRANT %s TO %s", p.target(), to)
+			if p.grantOption {
+				s += " WITH GRANT OPTION"
+			}
+			grants = append(grants, s)
+		}
+	}
+
+	wantRoles := make(map[clickhouseGrantedRole]bool, len(roles))
+	for _, r := range roles {
+		wantRoles[r] = true
+	}
+	hasRoles := make(map[clickhouseGrantedRole]bool, len(currentRoles))
+	for _, r := range currentRoles {
+		hasRoles[r] = true
+		if !wantRoles[r] {
+			revokes = append(revokes, fmt.Sprintf("REVOKE %s FROM %s", escapeClickhouseIdentifier(r.role), to))
+		}
+	}
+	for _, r := range roles {
+		if !hasRoles[r] {
+			s := fmt.Sprintf("GRANT %s TO %s", escapeClickhouseIdentifier(r.role), to)
+			if r.adminOption {
+				s += " WITH ADMIN OPTION"
+			}
+			grants = append(grants, s)
+		}
+	}
+
+	// System tables have no particular order
+	sort.Strings(revokes)
+	sort.Strings(grants)
+	return append(revokes, grants...)
+}
+
+// clickhousePrivilegeParents the privilege hierarchy: a privilege is a part of its parent.
+// Privileges that are not listed belong to ALL.
+// https://clickhouse.com/docs/en/sql-reference/statements/grant#privileges
+var clickhousePrivilegeParents = map[string]string{
+	"ALTER TABLE":               "ALTER",
+	"ALTER VIEW":                "ALTER",
+	"ALTER UPDATE":              "ALTER TABLE",
+	"ALTER DELETE":              "ALTER TABLE",
+	"ALTER COLUMN":              "ALTER TABLE",
+	"ALTER ADD COLUMN":          "ALTER COLUMN",
+	"ALTER DROP COLUMN":         "ALTER COLUMN",
+	"ALTER MODIFY COLUMN":       "ALTER COLUMN",
+	"ALTER COMMENT COLUMN":      "ALTER COLUMN",
+	"ALTER CLEAR COLUMN":        "ALTER COLUMN",
+	"ALTER RENAME COLUMN":       "ALTER COLUMN",
+	"ALTER INDEX":               "ALTER TABLE",
+	"ALTER ORDER BY":            "ALTER INDEX",
+	"ALTER SAMPLE BY":           "ALTER INDEX",
+	"ALTER ADD INDEX":           "ALTER INDEX",
+	"ALTER DROP INDEX":          "ALTER INDEX",
+	"ALTER MATERIALIZE INDEX":   "ALTER INDEX",
+	"ALTER CLEAR INDEX":         "ALTER INDEX",
+	"ALTER CONSTRAINT":          "ALTER TABLE",
+	"ALTER ADD CONSTRAINT":      "ALTER CONSTRAINT",
+	"ALTER DROP CONSTRAINT":     "ALTER CONSTRAINT",
+	"ALTER TTL":                 "ALTER TABLE",
+	"ALTER MATERIALIZE TTL":     "ALTER TTL",
+	"ALTER SETTINGS":            "ALTER TABLE",
+	"ALTER MOVE PARTITION":      "ALTER TABLE",
+	"ALTER FETCH PARTITION":     "ALTER TABLE",
+	"ALTER FREEZE PARTITION":    "ALTER TABLE",
+	"ALTER VIEW REFRESH":        "ALTER VIEW",
+	"ALTER VIEW MODIFY QUERY":   "ALTER VIEW",
+	"CREATE DATABASE":           "CREATE",
+	"CREATE TABLE":              "CREATE",
+	"CREATE VIEW":               "CREATE",
+	"CREATE DICTIONARY":         "CREATE",
+	"CREATE FUNCTION":           "CREATE",
+	"CREATE TEMPORARY TABLE":    "CREATE",
+	"DROP DATABASE":             "DROP",
+	"DROP TABLE":                "DROP",
+	"DROP VIEW":                 "DROP",
+	"DROP DICTIONARY":           "DROP",
+	"DROP FUNCTION":             "DROP",
+	"SHOW DATABASES":            "SHOW",
+	"SHOW TABLES":               "SHOW",
+	"SHOW COLUMNS":              "SHOW",
+	"SHOW DICTIONARIES":         "SHOW",
+	"SYSTEM SHUTDOWN":           "SYSTEM",
+	"SYSTEM DROP CACHE":         "SYSTEM",
+	"SYSTEM RELOAD":             "SYSTEM",
+	"SYSTEM MERGES":             "SYSTEM",
+	"SYSTEM TTL MERGES":         "SYSTEM",
+	"SYSTEM FETCHES":            "SYSTEM",
+	"SYSTEM MOVES":              "SYSTEM",
+	"SYSTEM SENDS":              "SYSTEM",
+	"SYSTEM REPLICATION QUEUES": "SYSTEM",
+	"SYSTEM SYNC REPLICA":       "SYSTEM",
+	"SYSTEM RESTART REPLICA":    "SYSTEM",
+	"SYSTEM FLUSH":              "SYSTEM",
+	"SYSTEM FLUSH DISTRIBUTED":  "SYSTEM FLUSH",
+	"SYSTEM FLUSH LOGS":         "SYSTEM FLUSH",
+	"FILE":                      "SOURCES",
+	"URL":                       "SOURCES",
+	"REMOTE":                    "SOURCES",
+	"MYSQL":                     "SOURCES",
+	"ODBC":                      "SOURCES",
+	"JDBC":                      "SOURCES",
+	"HDFS":                      "SOURCES",
+	"S3":                        "SOURCES",
+}
+
+// clickhousePrivilegeAliases maps aliases to the names system.grants shows
+var clickhousePrivilegeAliases = map[string]string{
+	"ALL PRIVILEGES":           "ALL",
+	"UPDATE":                   "ALTER UPDATE",
+	"DELETE":                   "ALTER DELETE",
+	"ADD COLUMN":               "ALTER ADD COLUMN",
+	"DROP COLUMN":              "ALTER DROP COLUMN",
+	"MODIFY COLUMN":            "ALTER MODIFY COLUMN",
+	"COMMENT COLUMN":           "ALTER COMMENT COLUMN",
+	"CLEAR COLUMN":             "ALTER CLEAR COLUMN",
+	"RENAME COLUMN":            "ALTER RENAME COLUMN",
+	"INDEX":                    "ALTER INDEX",
+	"ALTER MODIFY ORDER BY":    "ALTER ORDER BY",
+	"MODIFY ORDER BY":          "ALTER ORDER BY",
+	"ALTER MODIFY SAMPLE BY":   "ALTER SAMPLE BY",
+	"MODIFY SAMPLE BY":         "ALTER SAMPLE BY",
+	"ADD INDEX":                "ALTER ADD INDEX",
+	"DROP INDEX":               "ALTER DROP INDEX",
+	"MATERIALIZE INDEX":        "ALTER MATERIALIZE INDEX",
+	"CLEAR INDEX":              "ALTER CLEAR INDEX",
+	"CONSTRAINT":               "ALTER CONSTRAINT",
+	"ADD CONSTRAINT":           "ALTER ADD CONSTRAINT",
+	"DROP CONSTRAINT":          "ALTER DROP CONSTRAINT",
+	"ALTER MODIFY TTL":         "ALTER TTL",
+	"MODIFY TTL":               "ALTER TTL",
+	"MATERIALIZE TTL":          "ALTER MATERIALIZE TTL",
+	"ALTER SETTING":            "ALTER SETTINGS",
+	"ALTER MODIFY SETTING":     "ALTER SETTINGS",
+	"MODIFY SETTING":           "ALTER SETTINGS",
+	"ALTER MOVE PART":          "ALTER MOVE PARTITION",
+	"MOVE PARTITION":           "ALTER MOVE PARTITION",
+	"MOVE PART":                "ALTER MOVE PARTITION",
+	"ALTER FETCH PART":         "ALTER FETCH PARTITION",
+	"FETCH PARTITION":          "ALTER FETCH PARTITION",
+	"FREEZE PARTITION":         "ALTER FREEZE PARTITION",
+	"ALTER LIVE VIEW REFRESH":  "ALTER VIEW REFRESH",
+	"REFRESH VIEW":             "ALTER VIEW REFRESH",
+	"ALTER TABLE MODIFY QUERY": "ALTER VIEW MODIFY QUERY",
+	"TRUNCATE TABLE":           "TRUNCATE",
+	"OPTIMIZE TABLE":           "OPTIMIZE",
+	"SYSTEM KILL":              "SYSTEM SHUTDOWN",
+	"SHUTDOWN":                 "SYSTEM SHUTDOWN",
+	"DROP CACHE":               "SYSTEM DROP CACHE",
+	"SYSTEM STOP MERGES":       "SYSTEM MERGES",
+	"SYSTEM START MERGES":      "SYSTEM MERGES",
+	"STOP MERGES":              "SYSTEM MERGES",
+	"START MERGES":             "SYSTEM MERGES",
+	"SYNC REPLICA":             "SYSTEM SYNC REPLICA",
+	"RESTART REPLICA":          "SYSTEM RESTART REPLICA",
+	"FLUSH":                    "SYSTEM FLUSH",
+	"FLUSH DISTRIBUTED":        "SYSTEM FLUSH DISTRIBUTED",
+	"FLUSH LOGS":               "SYSTEM FLUSH LOGS",
+	"INTROSPECTION FUNCTIONS":  "INTROSPECTION",
+	"CREATE POLICY":            "CREATE ROW POLICY",
+	"ALTER POLICY":             "ALTER ROW POLICY",
+	"DROP POLICY":              "DROP ROW POLICY",
+	"CREATE PROFILE":           "CREATE SETTINGS PROFILE",
+	"ALTER PROFILE":            "ALTER SETTINGS PROFILE",
+	"DROP PROFILE":             "DROP SETTINGS PROFILE",
+	"DICTGET":                  "dictGet",
+	"DICTHAS":                  "dictGet",
+	"DICTGETHIERARCHY":         "dictGet",
+	"DICTISIN":                 "dictGet",
+}
+
+// normalizeClickhousePrivilege returns the privilege the way system.grants shows it
+func normalizeClickhousePrivilege(s string) string {
+	p := strings.ToUpper(strings.Join(strings.Fields(s), " "))
+	if name, ok := clickhousePrivilegeAliases[p]; ok {
+		return name
+	}
+	return p
+}
+
+// clickhousePrivilegeIncludes returns true if the privilege is the other one or one of its parents
+func clickhousePrivilegeIncludes(privilege, other string) bool {
+	for p := other; ; p = clickhousePrivilegeParents[p] {
+		switch {
+		case p == privilege:
+			return true
+		case p == "ALL":
+			return false
+		case p == "":
+			// Not listed privileges are the top ones
+			return privilege == "ALL"
+		}
+	}
+}
+
+// includes returns true if the privilege is a part of this one:
+// the privilege is the same or nested, granted on the same or nested target, with the same or weaker grant option
+func (p clickhousePrivilege) includes(other clickhousePrivilege) bool {
+	if p.database != "" && (p.database != other.database || p.table != "" && p.table != other.table) {
+		return false
+	}
+	if other.grantOption && !p.grantOption {
+		return false
+	}
+	return clickhousePrivilegeIncludes(p.privilege, other.privilege)
+}
+
+// compactClickhousePrivileges removes privileges included in others,
+// ClickHouse doesn't show them in system.grants
+func compactClickhousePrivileges(privileges []clickhousePrivilege) []clickhousePrivilege {
+	result := make([]clickhousePrivilege, 0, len(privileges))
+	for i, p := range privileges {
+		included := false
+		for j, o := range privileges {
+			// Keeps the first of duplicates
+			if i != j && o.includes(p) && (o != p || j < i) {
+				included = true
+				break
+			}
+		}
+		if !included {
+			result = append(result, p)
+		}
+	}
+	return result
+}
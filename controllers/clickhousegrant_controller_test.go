@@ -0,0 +1,266 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestClickhouseGrantHandler(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+	k8s := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&v1alpha1.ClickhouseRole{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-role", Namespace: "default"},
+		Spec:       v1alpha1.ClickhouseRoleSpec{Project: "my-project", ServiceName: "my-clickhouse", Role: "readers"},
+	}).Build()
+
+	api := newFakeClickhouseAPI(t)
+	avn := newFakeAivenClient(api)
+	h := clickhouseGrantHandler{ctx: context.Background(), k8s: k8s}
+	grant := &v1alpha1.ClickhouseGrant{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-grant", Namespace: "default", Generation: 1},
+		Spec: v1alpha1.ClickhouseGrantSpec{
+			Project:     "my-project",
+			ServiceName: "my-clickhouse",
+			Grantee:     v1alpha1.ClickhouseGrantee{UserRef: &v1alpha1.ResourceReference{Name: "my-user"}},
+			PrivilegeGrants: []v1alpha1.ClickhousePrivilegeGrant{
+				{Privilege: "select", Database: "analytics"},
+				{Privilege: "ALTER  UPDATE", Database: "analytics", Table: "events", WithGrantOption: true},
+			},
+			RoleGrants: []v1alpha1.ClickhouseRoleGrant{
+				{RoleRef: v1alpha1.ResourceReference{Name: "my-role"}},
+			},
+		},
+	}
+	assert.Len(t, grant.GetRefs(), 2)
+
+	// Grants
+	require.NoError(t, h.createOrUpdate(avn, grant, nil))
+	assert.Equal(t, []string{
+		"GRANT ALTER UPDATE ON `analytics`.`events` TO `my-user` WITH GRANT OPTION",
+		"GRANT SELECT ON `analytics`.* TO `my-user`",
+		"GRANT `readers` TO `my-user`",
+	}, api.queries)
+	_, err := h.get(avn, grant)
+	require.NoError(t, err)
+	assert.True(t, IsAlreadyRunning(grant))
+
+	// Changed out-of-band
+	api.queries = nil
+	api.grants = append(api.grants, []any{"DROP TABLE", nil, nil, 0})
+	api.roleGrants[0][1] = 1
+	_, err = h.get(avn, grant)
+	require.NoError(t, err)
+	assert.False(t, isAlreadyProcessed(grant))
+	assert.False(t, IsAlreadyRunning(grant))
+	c := meta.FindStatusCondition(grant.Status.Conditions, conditionTypeRunning)
+	require.NotNil(t, c)
+	assert.Equal(t, "DriftDetected", c.Reason)
+	assert.Empty(t, api.queries)
+
+	// Reverts
+	require.NoError(t, h.createOrUpdate(avn, grant, nil))
+	assert.Equal(t, []string{
+		"REVOKE DROP TABLE ON *.* FROM `my-user`",
+		"REVOKE `readers` FROM `my-user`",
+		"GRANT `readers` TO `my-user`",
+	}, api.queries)
+	_, err = h.get(avn, grant)
+	require.NoError(t, err)
+	assert.True(t, IsAlreadyRunning(grant))
+
+	// Revokes removed privileges
+	api.queries = nil
+	grant.Spec.PrivilegeGrants = grant.Spec.PrivilegeGrants[:1]
+	require.NoError(t, h.createOrUpdate(avn, grant, nil))
+	assert.Equal(t, []string{"REVOKE ALTER UPDATE ON `analytics`.`events` FROM `my-user`"}, api.queries)
+
+	// Revokes everything on delete
+	deleted, err := h.delete(avn, grant)
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	assert.Empty(t, api.grants)
+	assert.Empty(t, api.roleGrants)
+}
+
+func TestClickhouseGrantToRole(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+	k8s := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	api := newFakeClickhouseAPI(t)
+	avn := newFakeAivenClient(api)
+	h := clickhouseGrantHandler{ctx: context.Background(), k8s: k8s}
+	grant := &v1alpha1.ClickhouseGrant{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-grant", Namespace: "default"},
+		Spec: v1alpha1.ClickhouseGrantSpec{
+			Project:         "my-project",
+			ServiceName:     "my-clickhouse",
+			Grantee:         v1alpha1.ClickhouseGrantee{RoleRef: &v1alpha1.ResourceReference{Name: "my-role"}},
+			PrivilegeGrants: []v1alpha1.ClickhousePrivilegeGrant{{Privilege: "SELECT"}},
+		},
+	}
+
+	// The role is not created yet
+	require.Error(t, h.createOrUpdate(avn, grant, nil))
+
+	// The role is removed, so are its grants
+	deleted, err := h.delete(avn, grant)
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	assert.Empty(t, api.queries)
+}
+
+func TestClickhouseGrantOwner(t *testing.T) {
+	newGrant := func(name string, created time.Time) *v1alpha1.ClickhouseGrant {
+		return &v1alpha1.ClickhouseGrant{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         "default",
+				UID:               types.UID(name),
+				CreationTimestamp: metav1.NewTime(created),
+			},
+			Spec: v1alpha1.ClickhouseGrantSpec{
+				Project:         "my-project",
+				ServiceName:     "my-clickhouse",
+				Grantee:         v1alpha1.ClickhouseGrantee{UserRef: &v1alpha1.ResourceReference{Name: "my-user"}},
+				PrivilegeGrants: []v1alpha1.ClickhousePrivilegeGrant{{Privilege: "SELECT"}},
+			},
+		}
+	}
+
+	now := time.Now().Truncate(time.Second)
+	first := newGrant("first", now)
+	second := newGrant("second", now.Add(time.Minute))
+	other := newGrant("other", now)
+	other.Spec.Grantee.UserRef.Name = "other-user"
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+	k8s := fake.NewClientBuilder().WithScheme(scheme).WithObjects(first, second, other).Build()
+
+	api := newFakeClickhouseAPI(t)
+	avn := newFakeAivenClient(api)
+	h := clickhouseGrantHandler{ctx: context.Background(), k8s: k8s}
+
+	// The oldest grant owns the grantee
+	owner, err := h.getOwner(first, clickhouseGrantee{column: "user_name", name: "my-user"})
+	require.NoError(t, err)
+	assert.Empty(t, owner)
+
+	_, err = h.checkPreconditions(avn, second)
+	assert.EqualError(t, err, `grantee "my-user" is already managed by ClickhouseGrant default/first`)
+
+	// Deleting the other grant doesn't revoke the owner's privileges
+	deleted, err := h.delete(avn, second)
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	assert.Empty(t, api.queries)
+}
+
+func TestClickhouseGrantAliases(t *testing.T) {
+	api := newFakeClickhouseAPI(t)
+	avn := newFakeAivenClient(api)
+	h := clickhouseGrantHandler{}
+	grant := &v1alpha1.ClickhouseGrant{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-grant", Namespace: "default", Generation: 1},
+		Spec: v1alpha1.ClickhouseGrantSpec{
+			Project:     "my-project",
+			ServiceName: "my-clickhouse",
+			Grantee:     v1alpha1.ClickhouseGrantee{UserRef: &v1alpha1.ResourceReference{Name: "my-user"}},
+			PrivilegeGrants: []v1alpha1.ClickhousePrivilegeGrant{
+				{Privilege: "all privileges", Database: "analytics"},
+				{Privilege: "INSERT", Database: "analytics", Table: "events"},
+				{Privilege: "delete", Database: "logs"},
+				{Privilege: "ALTER DELETE", Database: "logs", Table: "events"},
+			},
+		},
+	}
+
+	// Grants what system.grants shows
+	require.NoError(t, h.createOrUpdate(avn, grant, nil))
+	assert.Equal(t, []string{
+		"GRANT ALL ON `analytics`.* TO `my-user`",
+		"GRANT ALTER DELETE ON `logs`.* TO `my-user`",
+	}, api.queries)
+
+	// No drift
+	api.queries = nil
+	_, err := h.get(avn, grant)
+	require.NoError(t, err)
+	assert.True(t, IsAlreadyRunning(grant))
+	assert.Empty(t, api.queries)
+}
+
+func TestNormalizeClickhousePrivilege(t *testing.T) {
+	cases := map[string]string{
+		"select":          "SELECT",
+		"ALL  PRIVILEGES": "ALL",
+		"update":          "ALTER UPDATE",
+		"Modify Setting":  "ALTER SETTINGS",
+		"dictHas":         "dictGet",
+		"ALTER":           "ALTER",
+	}
+	for in, expected := range cases {
+		assert.Equal(t, expected, normalizeClickhousePrivilege(in), in)
+	}
+}
+
+func TestCompactClickhousePrivileges(t *testing.T) {
+	all := clickhousePrivilege{privilege: "ALL", database: "db"}
+	selectTable := clickhousePrivilege{privilege: "SELECT", database: "db", table: "t"}
+	selectOther := clickhousePrivilege{privilege: "SELECT", database: "other"}
+	alterGrant := clickhousePrivilege{privilege: "ALTER UPDATE", database: "db", grantOption: true}
+	alterColumn := clickhousePrivilege{privilege: "ALTER ADD COLUMN", database: "db", table: "t"}
+	alterTable := clickhousePrivilege{privilege: "ALTER TABLE", database: "db", table: "t"}
+	global := clickhousePrivilege{privilege: "SELECT"}
+
+	cases := []struct {
+		name       string
+		privileges []clickhousePrivilege
+		expected   []clickhousePrivilege
+	}{
+		{
+			name:       "all includes table privileges",
+			privileges: []clickhousePrivilege{selectTable, all, selectOther},
+			expected:   []clickhousePrivilege{all, selectOther},
+		},
+		{
+			name:       "grant option is not included",
+			privileges: []clickhousePrivilege{all, alterGrant},
+			expected:   []clickhousePrivilege{all, alterGrant},
+		},
+		{
+			name:       "nested group",
+			privileges: []clickhousePrivilege{alterColumn, alterTable},
+			expected:   []clickhousePrivilege{alterTable},
+		},
+		{
+			name:       "global privilege",
+			privileges: []clickhousePrivilege{selectTable, global},
+			expected:   []clickhousePrivilege{global},
+		},
+		{
+			name:       "duplicates",
+			privileges: []clickhousePrivilege{selectOther, selectOther},
+			expected:   []clickhousePrivilege{selectOther},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expected, compactClickhousePrivileges(c.privileges))
+		})
+	}
+}
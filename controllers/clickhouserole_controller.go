@@ -0,0 +1,142 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// clickhouseResyncInterval how often roles and grants are checked for being changed out-of-band
+const clickhouseResyncInterval = 5 * time.Minute
+
+// ClickhouseRoleReconciler reconciles a ClickhouseRole object
+type ClickhouseRoleReconciler struct {
+	Controller
+}
+
+//+kubebuilder:rbac:groups=aiven.io,resources=clickhouseroles,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=aiven.io,resources=clickhouseroles/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=aiven.io,resources=clickhouseroles/finalizers,verbs=update
+
+func (r *ClickhouseRoleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	result, err := r.reconcileInstance(ctx, req, clickhouseRoleHandler{}, &v1alpha1.ClickhouseRole{})
+
+	// Comes back to recreate the role if it is dropped out-of-band
+	if err == nil && result.IsZero() {
+		result.RequeueAfter = clickhouseResyncInterval
+	}
+	return result, err
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ClickhouseRoleReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.ClickhouseRole{}).
+		Complete(r)
+}
+
+type clickhouseRoleHandler struct{}
+
+func (h clickhouseRoleHandler) createOrUpdate(avn *aiven.Client, obj client.Object, _ []client.Object) error {
+	role, err := h.convert(obj)
+	if err != nil {
+		return err
+	}
+
+	_, err = clickhouseQuery(avn, role.Spec.Project, role.Spec.ServiceName,
+		"CREATE ROLE IF NOT EXISTS "+escapeClickhouseIdentifier(role.Spec.Role))
+	if err != nil {
+		return fmt.Errorf("cannot create ClickHouse role: %w", err)
+	}
+
+	meta.SetStatusCondition(&role.Status.Conditions,
+		getInitializedCondition("Created",
+			"Instance was created or update on Aiven side"))
+
+	meta.SetStatusCondition(&role.Status.Conditions,
+		getRunningCondition(metav1.ConditionUnknown, "Created",
+			"Instance was created or update on Aiven side, status remains unknown"))
+
+	metav1.SetMetaDataAnnotation(&role.ObjectMeta,
+		processedGenerationAnnotation, strconv.FormatInt(role.GetGeneration(), formatIntBaseDecimal))
+
+	return nil
+}
+
+func (h clickhouseRoleHandler) delete(avn *aiven.Client, obj client.Object) (bool, error) {
+	role, err := h.convert(obj)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = clickhouseQuery(avn, role.Spec.Project, role.Spec.ServiceName,
+		"DROP ROLE IF EXISTS "+escapeClickhouseIdentifier(role.Spec.Role))
+	if err != nil && !aiven.IsNotFound(err) {
+		return false, fmt.Errorf("cannot drop ClickHouse role: %w", err)
+	}
+
+	return true, nil
+}
+
+func (h clickhouseRoleHandler) get(avn *aiven.Client, obj client.Object) (*corev1.Secret, error) {
+	role, err := h.convert(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := clickhouseQuery(avn, role.Spec.Project, role.Spec.ServiceName,
+		"SELECT name FROM system.roles WHERE name = "+escapeClickhouseString(role.Spec.Role))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		// Dropped out-of-band, marks the generation as not processed, so it is created again
+		delete(role.Annotations, processedGenerationAnnotation)
+		delete(role.Annotations, instanceIsRunningAnnotation)
+		meta.SetStatusCondition(&role.Status.Conditions,
+			getRunningCondition(metav1.ConditionFalse, "NotFound",
+				"Instance was not found on Aiven side, recreating"))
+		return nil, aiven.Error{Status: http.StatusNotFound, Message: fmt.Sprintf("ClickHouse role %q not found", role.Spec.Role)}
+	}
+
+	meta.SetStatusCondition(&role.Status.Conditions,
+		getRunningCondition(metav1.ConditionTrue, "CheckRunning",
+			"Instance is running on Aiven side"))
+
+	metav1.SetMetaDataAnnotation(&role.ObjectMeta, instanceIsRunningAnnotation, "true")
+	return nil, nil
+}
+
+func (h clickhouseRoleHandler) checkPreconditions(avn *aiven.Client, obj client.Object) (bool, error) {
+	role, err := h.convert(obj)
+	if err != nil {
+		return false, err
+	}
+
+	meta.SetStatusCondition(&role.Status.Conditions,
+		getInitializedCondition("Preconditions", "Checking preconditions"))
+
+	return checkServiceIsRunning(avn, role.Spec.Project, role.Spec.ServiceName)
+}
+
+func (h clickhouseRoleHandler) convert(i client.Object) (*v1alpha1.ClickhouseRole, error) {
+	role, ok := i.(*v1alpha1.ClickhouseRole)
+	if !ok {
+		return nil, fmt.Errorf("cannot convert object to ClickhouseRole")
+	}
+	return role, nil
+}
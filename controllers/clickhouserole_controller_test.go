@@ -0,0 +1,48 @@
+package controllers
+
+import (
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestClickhouseRoleHandler(t *testing.T) {
+	api := newFakeClickhouseAPI(t)
+	avn := newFakeAivenClient(api)
+	role := &v1alpha1.ClickhouseRole{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-role", Generation: 1},
+		Spec: v1alpha1.ClickhouseRoleSpec{
+			Project:     "my-project",
+			ServiceName: "my-clickhouse",
+			Role:        "readers",
+		},
+	}
+
+	// Creates
+	require.NoError(t, clickhouseRoleHandler{}.createOrUpdate(avn, role, nil))
+	assert.Equal(t, []string{"CREATE ROLE IF NOT EXISTS `readers`"}, api.queries)
+	_, err := clickhouseRoleHandler{}.get(avn, role)
+	require.NoError(t, err)
+	assert.True(t, IsAlreadyRunning(role))
+
+	// Dropped out-of-band, recreates
+	delete(api.roles, "readers")
+	_, err = clickhouseRoleHandler{}.get(avn, role)
+	assert.True(t, aiven.IsNotFound(err))
+	assert.False(t, isAlreadyProcessed(role))
+	assert.False(t, IsAlreadyRunning(role))
+
+	require.NoError(t, clickhouseRoleHandler{}.createOrUpdate(avn, role, nil))
+	assert.True(t, api.roles["readers"])
+
+	// Deletes
+	deleted, err := clickhouseRoleHandler{}.delete(avn, role)
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	assert.Empty(t, api.roles)
+}
@@ -15,6 +15,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"github.com/aiven/aiven-operator/api/v1alpha1"
 )
@@ -29,18 +31,28 @@ type ClickhouseUserReconciler struct {
 //+kubebuilder:rbac:groups=aiven.io,resources=clickhouseusers/finalizers,verbs=update
 
 func (r *ClickhouseUserReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	return r.reconcileInstance(ctx, req, &clickhouseUserHandler{}, &v1alpha1.ClickhouseUser{})
+	return r.reconcileInstance(ctx, req, &clickhouseUserHandler{k8s: r.Client}, &v1alpha1.ClickhouseUser{})
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *ClickhouseUserReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	err := mgr.GetFieldIndexer().IndexField(context.Background(), &v1alpha1.ClickhouseUser{}, passwordSecretRefIndexKey, passwordSecretRefIndexFunc)
+	if err != nil {
+		return fmt.Errorf("unable to add index for password secret ref: %w", err)
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.ClickhouseUser{}).
 		Owns(&corev1.Secret{}).
+		Watches(&source.Kind{Type: &corev1.Secret{}}, handler.EnqueueRequestsFromMapFunc(
+			findObjectsByPasswordSecret(r.Client, func() client.ObjectList { return &v1alpha1.ClickhouseUserList{} }),
+		)).
 		Complete(r)
 }
 
-type clickhouseUserHandler struct{}
+type clickhouseUserHandler struct {
+	k8s client.Client
+}
 
 func (h *clickhouseUserHandler) createOrUpdate(avn *aiven.Client, obj client.Object, _ []client.Object) error {
 	user, err := h.convert(obj)
@@ -107,7 +119,15 @@ func (h *clickhouseUserHandler) get(avn *aiven.Client, obj client.Object) (*core
 	// while password is returned on create only.
 	// And all other GET methods return empty password, even this one.
 	// So the only way to have a secret here is to reset it manually
-	password := randPassword(maxUserPasswordLength)
+	password, err := getPasswordFromSecret(h.k8s, user)
+	if err != nil {
+		return nil, err
+	}
+
+	if password == "" {
+		password = randPassword(maxUserPasswordLength)
+	}
+
 	_, err = avn.ClickhouseUser.ResetPassword(user.Spec.Project, user.Spec.ServiceName, user.Status.UUID, password)
 	if err != nil {
 		return nil, err
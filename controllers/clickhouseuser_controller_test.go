@@ -0,0 +1,62 @@
+package controllers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestClickhouseUserHandlerPasswordSecretRef(t *testing.T) {
+	var password string
+	var api *fakeAivenAPI
+	api = newFakeAivenAPI(t, fakeRoutes{
+		"GET /v1/project/my-project/service/my-clickhouse": fakeResponse(http.StatusOK, map[string]any{"service": map[string]any{
+			"state":              "RUNNING",
+			"service_uri_params": map[string]any{"host": "my-clickhouse.aivencloud.com", "port": "24947"},
+		}}),
+		"PUT /v1/project/my-project/service/my-clickhouse/clickhouse/user/my-uuid/password": func(r *http.Request, _ []string) (int, any) {
+			req := struct {
+				Password string `json:"password"`
+			}{}
+			api.decode(r, &req)
+			password = req.Password
+			return http.StatusOK, map[string]any{"password": password}
+		},
+	})
+	avn := newFakeAivenClient(api)
+
+	k8s := fake.NewClientBuilder().WithObjects(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "legacy-password", Namespace: "default"},
+		Data:       map[string][]byte{"password": []byte("vendor-password")},
+	}).Build()
+	h := &clickhouseUserHandler{k8s: k8s}
+	user := &v1alpha1.ClickhouseUser{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-user", Namespace: "default"},
+		Spec: v1alpha1.ClickhouseUserSpec{
+			Project:           "my-project",
+			ServiceName:       "my-clickhouse",
+			PasswordSecretRef: &v1alpha1.PasswordSecretReference{Name: "legacy-password", Key: "password"},
+		},
+		Status: v1alpha1.ClickhouseUserStatus{UUID: "my-uuid"},
+	}
+
+	s, err := h.get(avn, user)
+	require.NoError(t, err)
+	assert.Equal(t, "vendor-password", password)
+	assert.Equal(t, "vendor-password", s.StringData["PASSWORD"])
+	assert.Equal(t, "my-user", s.StringData["USERNAME"])
+
+	// Generated when the secret is not set
+	user.Spec.PasswordSecretRef = nil
+	s, err = h.get(avn, user)
+	require.NoError(t, err)
+	assert.Len(t, password, maxUserPasswordLength)
+	assert.Equal(t, password, s.StringData["PASSWORD"])
+}
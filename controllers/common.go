@@ -1,15 +1,25 @@
 package controllers
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
 	"net/http"
+	"os"
 	"strconv"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/aiven/aiven-go-client"
 
@@ -99,11 +109,71 @@ func isAivenServerError(err error) bool {
 	return ok && e.Status >= http.StatusInternalServerError
 }
 
+// aivenErrorMessage returns the message field of the error body, or the body as is
+func aivenErrorMessage(e aiven.Error) string {
+	r := struct {
+		Message string `json:"message"`
+	}{}
+	if json.Unmarshal([]byte(e.Message), &r) == nil && r.Message != "" {
+		return r.Message
+	}
+	return e.Message
+}
+
 // NewAivenClient returns Aiven client
 func NewAivenClient(token string) (*aiven.Client, error) {
 	return aiven.NewTokenClient(token, "k8s-operator/"+version)
 }
 
+// aivenAPIURL returns Aiven API v1 URL the same way aiven.Client does
+func aivenAPIURL() string {
+	if v, ok := os.LookupEnv("AIVEN_WEB_URL"); ok {
+		return v + "/v1"
+	}
+	return "https://api.aiven.io/v1"
+}
+
+// doAivenRequest calls an Aiven API endpoint that is not supported by aiven.Client.
+// Returns aiven.Error on failure, so it plays well with aiven.IsNotFound(err)
+func doAivenRequest(ctx context.Context, avn *aiven.Client, method, path string, in, out any) error {
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, aivenAPIURL()+path, body)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", avn.UserAgent)
+	req.Header.Set("Authorization", "aivenv1 "+avn.APIKey)
+	rsp, err := avn.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer rsp.Body.Close()
+
+	b, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return err
+	}
+
+	if rsp.StatusCode < http.StatusOK || rsp.StatusCode >= http.StatusMultipleChoices {
+		return aiven.Error{Message: string(b), Status: rsp.StatusCode}
+	}
+
+	if out == nil {
+		return nil
+	}
+	return json.Unmarshal(b, out)
+}
+
 func fromAnyPointer[T any](v *T) T {
 	if v != nil {
 		return *v
@@ -137,3 +207,70 @@ func newSecret(o client.Object, target v1alpha1.ConnInfoSecretTarget, stringData
 		StringData: stringData,
 	}
 }
+
+// getSecretValue returns the value of the secret key.
+// Errors must not contain the value
+func getSecretValue(ctx context.Context, k8s client.Reader, namespace, name, key string) (string, error) {
+	var secret corev1.Secret
+	err := k8s.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, &secret)
+	if err != nil {
+		return "", fmt.Errorf("unable to fetch secret: %w", err)
+	}
+	v, ok := secret.Data[key]
+	if !ok {
+		return "", fmt.Errorf("no such key in secret '%s': '%s'", name, key)
+	}
+	return string(v), nil
+}
+
+// passwordSecretObject is a user which password can be set from a secret
+type passwordSecretObject interface {
+	client.Object
+	PasswordSecretRef() *v1alpha1.PasswordSecretReference
+}
+
+// passwordSecretRefIndexKey is the key we index the name of the password secret with
+const passwordSecretRefIndexKey = "spec.passwordSecretRef.name"
+
+func passwordSecretRefIndexFunc(o client.Object) []string {
+	if u, ok := o.(passwordSecretObject); ok {
+		if ref := u.PasswordSecretRef(); ref != nil {
+			return []string{ref.Name}
+		}
+	}
+	return nil
+}
+
+// findObjectsByPasswordSecret returns objects that use the secret as the password secret
+func findObjectsByPasswordSecret(k8s client.Reader, newList func() client.ObjectList) handler.MapFunc {
+	return func(secret client.Object) []reconcile.Request {
+		list := newList()
+		err := k8s.List(context.Background(), list, client.InNamespace(secret.GetNamespace()), client.MatchingFields{passwordSecretRefIndexKey: secret.GetName()})
+		if err != nil {
+			return nil
+		}
+
+		requests := make([]reconcile.Request, 0)
+		_ = meta.EachListItem(list, func(o runtime.Object) error {
+			if obj, ok := o.(client.Object); ok {
+				requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(obj)})
+			}
+			return nil
+		})
+		return requests
+	}
+}
+
+// getPasswordFromSecret returns the password, empty if the secret is not set
+func getPasswordFromSecret(k8s client.Reader, o passwordSecretObject) (string, error) {
+	ref := o.PasswordSecretRef()
+	if ref == nil {
+		return "", nil
+	}
+
+	password, err := getSecretValue(context.Background(), k8s, o.GetNamespace(), ref.Name, ref.Key)
+	if err != nil {
+		return "", fmt.Errorf("unable to get password: %w", err)
+	}
+	return password, nil
+}
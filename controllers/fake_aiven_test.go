@@ -0,0 +1,141 @@
+package controllers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeTransport sends requests to the handler instead of Aiven API
+type fakeTransport struct {
+	handler http.Handler
+}
+
+func (t *fakeTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	rec := httptest.NewRecorder()
+	t.handler.ServeHTTP(rec, req)
+	return rec.Result(), nil
+}
+
+// newFakeAivenClient returns Aiven client which calls the given fake API handler
+func newFakeAivenClient(handler http.Handler) *aiven.Client {
+	avn := &aiven.Client{
+		APIKey:    "fake-token",
+		Client:    &http.Client{Transport: &fakeTransport{handler: handler}},
+		UserAgent: "k8s-operator/test",
+	}
+	avn.Init()
+	return avn
+}
+
+// writeFakeResponse writes JSON response
+func writeFakeResponse(t *testing.T, w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	require.NoError(t, json.NewEncoder(w).Encode(body))
+}
+
+// fakeHandler returns the response status and body.
+// The params are the values of the "*" segments of the route
+type fakeHandler func(r *http.Request, params []string) (int, any)
+
+// fakeRoutes maps "METHOD /path" to handlers, like "GET /v1/project/my-project/service/*".
+// A "*" segment or method matches any value
+type fakeRoutes map[string]fakeHandler
+
+// fakeAivenAPI is a table-driven fake of Aiven API, it serves the routes one request at a time.
+// Requests without a route get 404, or fail the test when strict is set
+type fakeAivenAPI struct {
+	t      *testing.T
+	mu     sync.Mutex
+	routes fakeRoutes
+	strict bool
+	calls  []string
+}
+
+func newFakeAivenAPI(t *testing.T, routes fakeRoutes) *fakeAivenAPI {
+	return &fakeAivenAPI{t: t, routes: routes}
+}
+
+func (f *fakeAivenAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	handler, params := f.route(r.Method, r.URL.Path)
+	if handler == nil {
+		if f.strict {
+			f.t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		handler = fakeNotFound
+	}
+
+	status, body := handler(r, params)
+	writeFakeResponse(f.t, w, status, body)
+}
+
+// route returns the most specific route, the one with the fewest "*" segments
+func (f *fakeAivenAPI) route(method, path string) (fakeHandler, []string) {
+	if h, ok := f.routes[method+" "+path]; ok {
+		return h, nil
+	}
+
+	var handler fakeHandler
+	var params []string
+	var key string
+	segments := strings.Split(path, "/")
+	for k, h := range f.routes {
+		m, p, _ := strings.Cut(k, " ")
+		pattern := strings.Split(p, "/")
+		if (m != "*" && m != method) || len(pattern) != len(segments) {
+			continue
+		}
+
+		matched := make([]string, 0)
+		for i, s := range pattern {
+			if s == "*" {
+				matched = append(matched, segments[i])
+			} else if s != segments[i] {
+				matched = nil
+				break
+			}
+		}
+
+		switch {
+		case matched == nil:
+			continue
+		case handler == nil || len(matched) < len(params):
+			handler, params, key = h, matched, k
+		case len(matched) == len(params):
+			f.t.Fatalf("routes %q and %q both match %s %s", key, k, method, path)
+		}
+	}
+	return handler, params
+}
+
+// decode reads the request body
+func (f *fakeAivenAPI) decode(r *http.Request, v any) {
+	require.NoError(f.t, json.NewDecoder(r.Body).Decode(v))
+}
+
+// call records a call to check the order of the requests
+func (f *fakeAivenAPI) call(format string, args ...any) {
+	f.calls = append(f.calls, fmt.Sprintf(format, args...))
+}
+
+// fakeResponse returns a handler with a static response
+func fakeResponse(status int, body any) fakeHandler {
+	return func(*http.Request, []string) (int, any) {
+		return status, body
+	}
+}
+
+func fakeNotFound(*http.Request, []string) (int, any) {
+	return http.StatusNotFound, map[string]any{"message": "not found"}
+}
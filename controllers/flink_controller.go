@@ -0,0 +1,92 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aiven/aiven-go-client"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// FlinkReconciler reconciles a Flink object
+type FlinkReconciler struct {
+	Controller
+}
+
+// +kubebuilder:rbac:groups=aiven.io,resources=flinks,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=aiven.io,resources=flinks/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=aiven.io,resources=flinks/finalizers,verbs=update
+
+func (r *FlinkReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.reconcileService(ctx, req, newFlinkAdapter, &v1alpha1.Flink{})
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *FlinkReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.Flink{}).
+		Owns(&corev1.Secret{}).
+		Complete(r)
+}
+
+func newFlinkAdapter(_ *aiven.Client, object client.Object) (serviceAdapter, error) {
+	flink, ok := object.(*v1alpha1.Flink)
+	if !ok {
+		return nil, fmt.Errorf("object is not of type v1alpha1.Flink")
+	}
+	return &flinkAdapter{flink}, nil
+}
+
+// flinkAdapter handles an Aiven Flink service
+type flinkAdapter struct {
+	*v1alpha1.Flink
+}
+
+func (a *flinkAdapter) getObjectMeta() *metav1.ObjectMeta {
+	return &a.ObjectMeta
+}
+
+func (a *flinkAdapter) getServiceStatus() *v1alpha1.ServiceStatus {
+	return &a.Status
+}
+
+func (a *flinkAdapter) getServiceCommonSpec() *v1alpha1.ServiceCommonSpec {
+	return &a.Spec.ServiceCommonSpec
+}
+
+func (a *flinkAdapter) getUserConfig() any {
+	return &a.Spec.UserConfig
+}
+
+func (a *flinkAdapter) newSecret(s *aiven.Service) (*corev1.Secret, error) {
+	stringData := map[string]string{
+		"FLINK_HOST":     s.URIParams["host"],
+		"FLINK_PORT":     s.URIParams["port"],
+		"FLINK_USER":     s.URIParams["user"],
+		"FLINK_PASSWORD": s.URIParams["password"],
+		"FLINK_URI":      s.URI,
+		"FLINK_HOSTS":    strings.Join(s.ConnectionInfo.FlinkHostPorts, ","),
+	}
+
+	return newSecret(a, a.Spec.ConnInfoSecretTarget, stringData), nil
+}
+
+func (a *flinkAdapter) getServiceType() string {
+	return "flink"
+}
+
+func (a *flinkAdapter) getDiskSpace() string {
+	return ""
+}
+
+func (a *flinkAdapter) getAutoscaleDisk() *v1alpha1.AutoscaleDisk {
+	return nil
+}
@@ -0,0 +1,428 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// flinkApplicationResyncInterval how often the job state is checked
+const flinkApplicationResyncInterval = 5 * time.Minute
+
+// FlinkApplicationReconciler reconciles a FlinkApplication object
+type FlinkApplicationReconciler struct {
+	Controller
+}
+
+//+kubebuilder:rbac:groups=aiven.io,resources=flinkapplications,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=aiven.io,resources=flinkapplications/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=aiven.io,resources=flinkapplications/finalizers,verbs=update
+
+func (r *FlinkApplicationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	result, err := r.reconcileInstance(ctx, req, flinkApplicationHandler{ctx: ctx}, &v1alpha1.FlinkApplication{})
+
+	// Jobs fail and restart on their own, comes back to update the status
+	if err == nil && result.IsZero() {
+		result.RequeueAfter = flinkApplicationResyncInterval
+	}
+	return result, err
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *FlinkApplicationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.FlinkApplication{}).
+		Complete(r)
+}
+
+// flinkDeployment is not supported by aiven.Client
+type flinkDeployment struct {
+	ID                string `json:"id,omitempty"`
+	VersionID         string `json:"version_id"`
+	Status            string `json:"status,omitempty"`
+	ErrorMsg          string `json:"error_msg,omitempty"`
+	Parallelism       int    `json:"parallelism,omitempty"`
+	RestartEnabled    bool   `json:"restart_enabled"`
+	StartingSavepoint string `json:"starting_savepoint,omitempty"`
+	LastSavepoint     string `json:"last_savepoint,omitempty"`
+}
+
+// isFlinkDeploymentDone the job is not running and won't be
+func isFlinkDeploymentDone(state string) bool {
+	switch state {
+	case "CANCELED", "FAILED", "FINISHED":
+		return true
+	}
+	return false
+}
+
+// isFlinkDeploymentStopping the job is going to be done
+func isFlinkDeploymentStopping(state string) bool {
+	switch state {
+	case "CANCELLING_REQUESTED", "CANCELLING", "SAVING_AND_STOP_REQUESTED", "SAVING_AND_STOP", "DELETE_REQUESTED", "DELETING":
+		return true
+	}
+	return false
+}
+
+type flinkApplicationHandler struct {
+	ctx context.Context
+}
+
+func (h flinkApplicationHandler) createOrUpdate(avn *aiven.Client, obj client.Object, _ []client.Object) error {
+	app, err := h.convert(obj)
+	if err != nil {
+		return err
+	}
+
+	// Adopts the application with the same name
+	name := app.GetApplicationName()
+	if app.Status.ID == "" {
+		list, err := avn.FlinkApplications.List(app.Spec.Project, app.Spec.ServiceName)
+		if err != nil {
+			return err
+		}
+
+		for _, a := range list.Applications {
+			if a.Name == name {
+				app.Status.ID = a.ID
+				break
+			}
+		}
+	}
+
+	reason := "Updated"
+	if app.Status.ID == "" {
+		reason = "Created"
+		r, err := avn.FlinkApplications.Create(app.Spec.Project, app.Spec.ServiceName, aiven.CreateFlinkApplicationRequest{Name: name})
+		if err != nil {
+			return err
+		}
+		app.Status.ID = r.ID
+	} else {
+		_, err = avn.FlinkApplications.Update(app.Spec.Project, app.Spec.ServiceName, app.Status.ID, aiven.UpdateFlinkApplicationRequest{Name: name})
+		if err != nil {
+			return err
+		}
+	}
+
+	err = h.createVersions(avn, app)
+	if err != nil {
+		return err
+	}
+
+	meta.SetStatusCondition(&app.Status.Conditions,
+		getInitializedCondition(reason,
+			"Instance was created or update on Aiven side"))
+
+	meta.SetStatusCondition(&app.Status.Conditions,
+		getRunningCondition(metav1.ConditionUnknown, reason,
+			"Instance was created or update on Aiven side, status remains unknown"))
+
+	metav1.SetMetaDataAnnotation(&app.ObjectMeta,
+		processedGenerationAnnotation, strconv.FormatInt(app.GetGeneration(), formatIntBaseDecimal))
+
+	return nil
+}
+
+// createVersions creates new and changed versions.
+// Aiven side versions are immutable and kept until the application is deleted, so the previous ones can be deployed again
+func (h flinkApplicationHandler) createVersions(avn *aiven.Client, app *v1alpha1.FlinkApplication) error {
+	current := make(map[string]v1alpha1.FlinkApplicationVersionStatus, len(app.Status.Versions))
+	for _, v := range app.Status.Versions {
+		current[v.Name] = v
+	}
+
+	versions := make([]v1alpha1.FlinkApplicationVersionStatus, 0, len(app.Spec.Versions))
+	for _, v := range app.Spec.Versions {
+		req := aiven.GenericFlinkApplicationVersionRequest{
+			Statement: v.Statement,
+			Sources:   flinkVersionRelations(v.Sources),
+			Sinks:     flinkVersionRelations(v.Sinks),
+		}
+
+		b, err := json.Marshal(req)
+		if err != nil {
+			return err
+		}
+
+		hash := fmt.Sprintf("%x", sha256.Sum256(b))
+		if c, ok := current[v.Name]; ok && c.Hash == hash {
+			versions = append(versions, c)
+			continue
+		}
+
+		valid, err := avn.FlinkApplicationVersions.Validate(app.Spec.Project, app.Spec.ServiceName, app.Status.ID, req)
+		if err != nil {
+			return err
+		}
+
+		if valid.Message != "" {
+			return fmt.Errorf(
+				"version %q is invalid at line %d, character %d: %s",
+				v.Name, valid.Position.LineNumber, valid.Position.CharacterNumber, valid.Message,
+			)
+		}
+
+		r, err := avn.FlinkApplicationVersions.Create(app.Spec.Project, app.Spec.ServiceName, app.Status.ID, req)
+		if err != nil {
+			return fmt.Errorf("unable to create version %q: %w", v.Name, err)
+		}
+
+		versions = append(versions, v1alpha1.FlinkApplicationVersionStatus{
+			Name:    v.Name,
+			ID:      r.ID,
+			Version: r.Version,
+			Hash:    hash,
+		})
+	}
+
+	app.Status.Versions = versions
+	return nil
+}
+
+func (h flinkApplicationHandler) delete(avn *aiven.Client, obj client.Object) (bool, error) {
+	app, err := h.convert(obj)
+	if err != nil {
+		return false, err
+	}
+
+	if app.Status.ID == "" {
+		return true, nil
+	}
+
+	// The job must be stopped first
+	stopped, err := h.stopDeployment(avn, app, false)
+	if err != nil || !stopped {
+		return false, err
+	}
+
+	_, err = avn.FlinkApplications.Delete(app.Spec.Project, app.Spec.ServiceName, app.Status.ID)
+	if err != nil && !aiven.IsNotFound(err) {
+		return false, fmt.Errorf("aiven client delete flink application error: %w", err)
+	}
+
+	return true, nil
+}
+
+func (h flinkApplicationHandler) get(avn *aiven.Client, obj client.Object) (*corev1.Secret, error) {
+	app, err := h.convert(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = avn.FlinkApplications.Get(app.Spec.Project, app.Spec.ServiceName, app.Status.ID)
+	if aiven.IsNotFound(err) {
+		// Deleted out-of-band, creates it again
+		app.Status = v1alpha1.FlinkApplicationStatus{Conditions: app.Status.Conditions}
+		delete(app.Annotations, processedGenerationAnnotation)
+		delete(app.Annotations, instanceIsRunningAnnotation)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	running, err := h.syncDeployment(avn, app)
+	if err != nil {
+		return nil, err
+	}
+
+	if !running {
+		delete(app.Annotations, instanceIsRunningAnnotation)
+		return nil, nil
+	}
+
+	meta.SetStatusCondition(&app.Status.Conditions,
+		getRunningCondition(metav1.ConditionTrue, "CheckRunning",
+			"Instance is running on Aiven side"))
+
+	metav1.SetMetaDataAnnotation(&app.ObjectMeta, instanceIsRunningAnnotation, "true")
+	return nil, nil
+}
+
+// syncDeployment deploys the desired version, the running job is stopped with a savepoint first,
+// the new job starts from it. A failed job is deployed again when the spec changes.
+// Returns true when the desired job is running, or when no job is desired and none is running
+func (h flinkApplicationHandler) syncDeployment(avn *aiven.Client, app *v1alpha1.FlinkApplication) (bool, error) {
+	current, err := h.getDeployment(avn, app)
+	if err != nil {
+		return false, err
+	}
+
+	d := app.Spec.Deployment
+	if d == nil {
+		return h.stopDeployment(avn, app, true)
+	}
+
+	var desired *flinkDeployment
+	for _, v := range app.Status.Versions {
+		if v.Name == d.Version {
+			desired = &flinkDeployment{
+				VersionID:         v.ID,
+				Parallelism:       d.Parallelism,
+				RestartEnabled:    d.RestartEnabled == nil || *d.RestartEnabled,
+				StartingSavepoint: d.StartingSavepoint,
+			}
+		}
+	}
+
+	if desired == nil {
+		return false, fmt.Errorf("deployment version %q is not created", d.Version)
+	}
+
+	if desired.Parallelism == 0 {
+		desired.Parallelism = 1
+	}
+
+	unchanged := current != nil && current.VersionID == desired.VersionID &&
+		current.Parallelism == desired.Parallelism && current.RestartEnabled == desired.RestartEnabled
+	redeploy := current != nil && current.Status == "FAILED" && app.Status.DeploymentGeneration < app.Generation
+	if unchanged && !redeploy {
+		if current.Status == "FAILED" {
+			meta.SetStatusCondition(&app.Status.Conditions,
+				getRunningCondition(metav1.ConditionFalse, "Failed",
+					fmt.Sprintf("%s. The job is deployed again when the spec changes", current.ErrorMsg)))
+		}
+		return current.Status == "RUNNING", nil
+	}
+
+	// Replaces the current job
+	stopped, err := h.stopDeployment(avn, app, true)
+	if err != nil || !stopped {
+		return false, err
+	}
+
+	if desired.StartingSavepoint == "" {
+		desired.StartingSavepoint = app.Status.Savepoint
+	}
+
+	path := h.deploymentsPath(app)
+	err = doAivenRequest(h.ctx, avn, http.MethodPost, path, desired, desired)
+	if err != nil {
+		return false, fmt.Errorf("unable to deploy version %q: %w", d.Version, err)
+	}
+
+	app.Status.DeploymentID = desired.ID
+	app.Status.DeploymentVersion = d.Version
+	app.Status.DeploymentGeneration = app.Generation
+	app.Status.JobState = desired.Status
+	app.Status.JobError = ""
+	return false, nil
+}
+
+// getDeployment returns the current deployment and updates the job state, returns nil if there is no deployment
+func (h flinkApplicationHandler) getDeployment(avn *aiven.Client, app *v1alpha1.FlinkApplication) (*flinkDeployment, error) {
+	if app.Status.DeploymentID == "" {
+		return nil, nil
+	}
+
+	current := new(flinkDeployment)
+	err := doAivenRequest(h.ctx, avn, http.MethodGet, h.deploymentsPath(app)+"/"+url.PathEscape(app.Status.DeploymentID), nil, current)
+	if aiven.IsNotFound(err) {
+		app.Status.DeploymentID = ""
+		app.Status.DeploymentVersion = ""
+		app.Status.JobState = ""
+		app.Status.JobError = ""
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	app.Status.JobState = current.Status
+	app.Status.JobError = current.ErrorMsg
+	return current, nil
+}
+
+// stopDeployment stops the current job with a savepoint, or cancels it, returns true when it is done.
+// The last savepoint of the job is kept in the status
+func (h flinkApplicationHandler) stopDeployment(avn *aiven.Client, app *v1alpha1.FlinkApplication, savepoint bool) (bool, error) {
+	current, err := h.getDeployment(avn, app)
+	if err != nil {
+		return false, err
+	}
+
+	if current == nil {
+		return true, nil
+	}
+
+	if isFlinkDeploymentDone(current.Status) {
+		if current.LastSavepoint != "" {
+			app.Status.Savepoint = current.LastSavepoint
+		}
+		return true, nil
+	}
+
+	if isFlinkDeploymentStopping(current.Status) {
+		return false, nil
+	}
+
+	action := "cancel"
+	if savepoint {
+		action = "stop"
+	}
+
+	path := h.deploymentsPath(app) + "/" + url.PathEscape(current.ID) + "/" + action
+	err = doAivenRequest(h.ctx, avn, http.MethodPost, path, nil, nil)
+	if err != nil && !aiven.IsNotFound(err) {
+		return false, fmt.Errorf("unable to %s deployment: %w", action, err)
+	}
+	return false, nil
+}
+
+func (h flinkApplicationHandler) deploymentsPath(app *v1alpha1.FlinkApplication) string {
+	return fmt.Sprintf(
+		"/project/%s/service/%s/flink/application/%s/deployment",
+		url.PathEscape(app.Spec.Project), url.PathEscape(app.Spec.ServiceName), url.PathEscape(app.Status.ID),
+	)
+}
+
+func (h flinkApplicationHandler) checkPreconditions(avn *aiven.Client, obj client.Object) (bool, error) {
+	app, err := h.convert(obj)
+	if err != nil {
+		return false, err
+	}
+
+	meta.SetStatusCondition(&app.Status.Conditions,
+		getInitializedCondition("Preconditions", "Checking preconditions"))
+
+	return checkServiceIsRunning(avn, app.Spec.Project, app.Spec.ServiceName)
+}
+
+func (h flinkApplicationHandler) convert(i client.Object) (*v1alpha1.FlinkApplication, error) {
+	app, ok := i.(*v1alpha1.FlinkApplication)
+	if !ok {
+		return nil, fmt.Errorf("cannot convert object to FlinkApplication")
+	}
+
+	return app, nil
+}
+
+func flinkVersionRelations(tables []v1alpha1.FlinkApplicationVersionTable) []aiven.FlinkApplicationVersionRelation {
+	relations := make([]aiven.FlinkApplicationVersionRelation, 0, len(tables))
+	for _, t := range tables {
+		relations = append(relations, aiven.FlinkApplicationVersionRelation{
+			IntegrationID: t.IntegrationID,
+			CreateTable:   t.CreateTable,
+		})
+	}
+	return relations
+}
@@ -0,0 +1,191 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// fakeFlinkAPI serves "my-flink" applications, versions and deployments.
+// Deployments are cancelled or stopped with a savepoint at once
+type fakeFlinkAPI struct {
+	*fakeAivenAPI
+	apps        map[string]string
+	versions    int
+	deployments map[string]*flinkDeployment
+}
+
+func newFakeFlinkAPI(t *testing.T) *fakeFlinkAPI {
+	const appPath = "/v1/project/my-project/service/my-flink/flink/application"
+
+	f := &fakeFlinkAPI{apps: map[string]string{}, deployments: map[string]*flinkDeployment{}}
+	f.fakeAivenAPI = newFakeAivenAPI(t, fakeRoutes{
+		"GET " + appPath: func(*http.Request, []string) (int, any) {
+			apps := make([]map[string]string, 0)
+			for id, name := range f.apps {
+				apps = append(apps, map[string]string{"id": id, "name": name})
+			}
+			return http.StatusOK, map[string]any{"applications": apps}
+		},
+		"POST " + appPath: func(r *http.Request, _ []string) (int, any) {
+			req := map[string]string{}
+			f.decode(r, &req)
+			f.apps["app1"] = req["name"]
+			f.call("create app %s", req["name"])
+			return http.StatusOK, map[string]any{"id": "app1", "name": req["name"]}
+		},
+		"GET " + appPath + "/*": f.app(func(_ *http.Request, params []string) (int, any) {
+			return http.StatusOK, map[string]any{"id": params[0], "name": f.apps[params[0]]}
+		}),
+		"PUT " + appPath + "/*": f.app(func(_ *http.Request, params []string) (int, any) {
+			return http.StatusOK, map[string]any{"id": params[0], "name": f.apps[params[0]]}
+		}),
+		"DELETE " + appPath + "/*": f.app(func(_ *http.Request, params []string) (int, any) {
+			delete(f.apps, params[0])
+			f.call("delete app")
+			return http.StatusOK, map[string]any{}
+		}),
+		"POST " + appPath + "/*/version/validate": f.app(fakeResponse(http.StatusOK, map[string]any{})),
+		"POST " + appPath + "/*/version": f.app(func(*http.Request, []string) (int, any) {
+			f.versions++
+			f.call("create version")
+			return http.StatusOK, map[string]any{"id": "version" + string(rune('0'+f.versions)), "version": f.versions}
+		}),
+		"POST " + appPath + "/*/deployment": f.app(func(r *http.Request, _ []string) (int, any) {
+			d := new(flinkDeployment)
+			f.decode(r, d)
+			d.ID = "deployment" + string(rune('0'+len(f.deployments)+1))
+			d.Status = "INITIALIZING"
+			f.deployments[d.ID] = d
+			f.call("deploy %s", d.VersionID)
+			return http.StatusOK, d
+		}),
+		"GET " + appPath + "/*/deployment/*": f.app(func(_ *http.Request, params []string) (int, any) {
+			return http.StatusOK, f.deployments[params[1]]
+		}),
+		"POST " + appPath + "/*/deployment/*/cancel": f.app(func(_ *http.Request, params []string) (int, any) {
+			id := params[1]
+			f.deployments[id].Status = "CANCELED"
+			f.call("cancel %s", id)
+			return http.StatusOK, f.deployments[id]
+		}),
+		"POST " + appPath + "/*/deployment/*/stop": f.app(func(_ *http.Request, params []string) (int, any) {
+			id := params[1]
+			f.deployments[id].Status = "FINISHED"
+			f.deployments[id].LastSavepoint = "s3://savepoints/" + id
+			f.call("stop %s", id)
+			return http.StatusOK, f.deployments[id]
+		}),
+	})
+	f.strict = true
+	return f
+}
+
+// app returns 404 for unknown applications
+func (f *fakeFlinkAPI) app(h fakeHandler) fakeHandler {
+	return func(r *http.Request, params []string) (int, any) {
+		if f.apps[params[0]] == "" {
+			return fakeNotFound(r, params)
+		}
+		return h(r, params)
+	}
+}
+
+func TestFlinkApplicationHandler(t *testing.T) {
+	api := newFakeFlinkAPI(t)
+	avn := newFakeAivenClient(api)
+	h := flinkApplicationHandler{ctx: context.Background()}
+	app := &v1alpha1.FlinkApplication{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-app", Generation: 1},
+		Spec: v1alpha1.FlinkApplicationSpec{
+			Project:     "my-project",
+			ServiceName: "my-flink",
+			Versions: []v1alpha1.FlinkApplicationVersion{
+				{Name: "v1", Statement: "INSERT INTO b SELECT * FROM a"},
+			},
+			Deployment: &v1alpha1.FlinkApplicationDeployment{Version: "v1", Parallelism: 1},
+		},
+	}
+
+	// Creates the application, the version and deploys it
+	require.NoError(t, h.createOrUpdate(avn, app, nil))
+	assert.Equal(t, "app1", app.Status.ID)
+	require.Len(t, app.Status.Versions, 1)
+	assert.Equal(t, "version1", app.Status.Versions[0].ID)
+	assert.Len(t, app.Status.Versions[0].Hash, 64)
+
+	_, err := h.get(avn, app)
+	require.NoError(t, err)
+	assert.False(t, IsAlreadyRunning(app))
+	assert.Equal(t, "v1", app.Status.DeploymentVersion)
+
+	api.deployments["deployment1"].Status = "RUNNING"
+	_, err = h.get(avn, app)
+	require.NoError(t, err)
+	assert.True(t, IsAlreadyRunning(app))
+	assert.Equal(t, "RUNNING", app.Status.JobState)
+
+	// Same versions are not created twice
+	app.Generation = 2
+	require.NoError(t, h.createOrUpdate(avn, app, nil))
+	assert.Len(t, app.Status.Versions, 1)
+
+	// A new version replaces the running job
+	app.Generation = 3
+	app.Spec.Versions = append(app.Spec.Versions, v1alpha1.FlinkApplicationVersion{Name: "v2", Statement: "INSERT INTO c SELECT * FROM a"})
+	app.Spec.Deployment.Version = "v2"
+	require.NoError(t, h.createOrUpdate(avn, app, nil))
+	_, err = h.get(avn, app)
+	require.NoError(t, err)
+	assert.False(t, IsAlreadyRunning(app))
+	assert.Equal(t, "v1", app.Status.DeploymentVersion)
+
+	// Starts from the savepoint of the stopped job
+	_, err = h.get(avn, app)
+	require.NoError(t, err)
+	assert.Equal(t, "v2", app.Status.DeploymentVersion)
+	assert.Equal(t, "deployment2", app.Status.DeploymentID)
+	assert.Equal(t, "s3://savepoints/deployment1", app.Status.Savepoint)
+	assert.Equal(t, "s3://savepoints/deployment1", api.deployments["deployment2"].StartingSavepoint)
+
+	// Failed job stays failed until the spec changes
+	api.deployments["deployment2"].Status = "FAILED"
+	api.deployments["deployment2"].ErrorMsg = "table not found"
+	for i := 0; i < 2; i++ {
+		_, err = h.get(avn, app)
+		require.NoError(t, err)
+		assert.False(t, IsAlreadyRunning(app))
+		assert.Equal(t, "table not found", app.Status.JobError)
+		assert.Equal(t, "deployment2", app.Status.DeploymentID)
+	}
+
+	// Deployed again once the spec is applied again
+	app.Generation = 4
+	require.NoError(t, h.createOrUpdate(avn, app, nil))
+	_, err = h.get(avn, app)
+	require.NoError(t, err)
+	assert.Equal(t, "deployment3", app.Status.DeploymentID)
+	assert.Equal(t, int64(4), app.Status.DeploymentGeneration)
+	api.deployments["deployment3"].Status = "FAILED"
+
+	// Deletes the application, failed job doesn't need cancelling
+	deleted, err := h.delete(avn, app)
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	assert.Equal(t, []string{
+		"create app my-app",
+		"create version",
+		"deploy version1",
+		"create version",
+		"stop deployment1",
+		"deploy version2",
+		"deploy version2",
+		"delete app",
+	}, api.calls)
+}
@@ -1,26 +1,92 @@
 package controllers
 
 import (
+	"context"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/aiven/aiven-go-client"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/aiven/aiven-operator/api/v1alpha1"
 )
 
-func newGenericServiceHandler(fabric serviceAdapterFabric) Handlers {
-	return &genericServiceHandler{fabric: fabric}
+const (
+	serviceStateRunning  = "RUNNING"
+	serviceStatePowerOff = "POWEROFF"
+
+	conditionTypePowered = "Powered"
+	eventPoweredOn       = "PoweredOn"
+	eventPoweredOff      = "PoweredOff"
+	eventPowerOffRefused = "PowerOffRefused"
+
+	// eventUnableToUpdateStatus the service is running, but the additional status data is not updated
+	eventUnableToUpdateStatus = "UnableToUpdateStatus"
+
+	// powerOffRetryInterval how often a service without backups is checked to be powered off
+	powerOffRetryInterval = time.Hour
+)
+
+// reconcileService reconciles a service and comes back when its powerSchedule changes the powered state,
+// or to check the disk usage for autoscaleDisk and ipFilterFrom addresses
+func (c *Controller) reconcileService(ctx context.Context, req ctrl.Request, fabric serviceAdapterFabric, o aivenManagedObject) (ctrl.Result, error) {
+	result, err := c.reconcileInstance(ctx, req, newGenericServiceHandler(ctx, fabric, c.Client, c.APIReader, c.Recorder, c.ServiceTags), o)
+	if err != nil || !result.IsZero() {
+		return result, err
+	}
+
+	a, err := fabric(nil, o)
+	if err != nil {
+		return result, err
+	}
+
+	next, err := nextPowerTransition(a.getServiceCommonSpec(), time.Now())
+	if err == nil && !next.IsZero() {
+		result.RequeueAfter = time.Until(next)
+	}
+
+	// Checks the disk usage periodically
+	if a.getAutoscaleDisk() != nil && (result.RequeueAfter == 0 || result.RequeueAfter > diskAutoscaleInterval) {
+		result.RequeueAfter = diskAutoscaleInterval
+	}
+
+	// Discovers ipFilterFrom addresses periodically
+	if spec := a.getServiceCommonSpec(); spec.IPFilterFrom != nil {
+		d := nextIPFilterFromCheck(spec, a.getServiceStatus())
+		if result.RequeueAfter == 0 || result.RequeueAfter > d {
+			result.RequeueAfter = d
+		}
+	}
+
+	// Tries again later, the service might have backups by then
+	powered := meta.FindStatusCondition(a.getServiceStatus().Conditions, conditionTypePowered)
+	if powered != nil && powered.Reason == eventPowerOffRefused && (result.RequeueAfter == 0 || result.RequeueAfter > powerOffRetryInterval) {
+		result.RequeueAfter = powerOffRetryInterval
+	}
+	return result, err
+}
+
+func newGenericServiceHandler(ctx context.Context, fabric serviceAdapterFabric, k8s client.Client, reader client.Reader, rec record.EventRecorder, tags ServiceTagsConfig) Handlers {
+	return &genericServiceHandler{ctx: ctx, fabric: fabric, k8s: k8s, reader: reader, rec: rec, tags: tags}
 }
 
 // genericServiceHandler provides common CRUD management for all service types using serviceAdapter,
 // which turns specific service (mysql, redis) into a generic.
 type genericServiceHandler struct {
+	// ctx the reconcile context, for requests to kubernetes
+	ctx    context.Context
 	fabric serviceAdapterFabric
+	k8s    client.Client
+	// reader reads the objects used by ipFilterFrom from the API server, they aren't cached
+	reader client.Reader
+	rec    record.EventRecorder
+	tags   ServiceTagsConfig
 }
 
 func (h *genericServiceHandler) createOrUpdate(a *aiven.Client, object client.Object, refs []client.Object) error {
@@ -41,7 +107,15 @@ func (h *genericServiceHandler) createOrUpdate(a *aiven.Client, object client.Ob
 		}
 	}
 
-	_, err = a.Services.Get(spec.Project, ometa.Name)
+	var ipFilter []string
+	if spec.IPFilterFrom != nil {
+		ipFilter, err = h.ipFilterFrom(object, o)
+		if err != nil {
+			return err
+		}
+	}
+
+	current, err := a.Services.Get(spec.Project, ometa.Name)
 	exists := err == nil
 	if !exists && !aiven.IsNotFound(err) {
 		return fmt.Errorf("failed to fetch service: %w", err)
@@ -55,6 +129,12 @@ func (h *genericServiceHandler) createOrUpdate(a *aiven.Client, object client.Ob
 		if err != nil {
 			return err
 		}
+		userConfig = mergeIPFilter(userConfig, ipFilter)
+
+		// Enabled once the static IPs are associated
+		if len(spec.StaticIPRefs) > 0 {
+			delete(userConfig, "static_ips")
+		}
 
 		req := aiven.CreateServiceRequest{
 			Cloud:                 spec.CloudName,
@@ -69,7 +149,11 @@ func (h *genericServiceHandler) createOrUpdate(a *aiven.Client, object client.Ob
 			UserConfig:            userConfig,
 		}
 
+		// Integrations where the service is the source are created once it is running
 		for _, s := range spec.ServiceIntegrations {
+			if s.SourceServiceName == "" {
+				continue
+			}
 			i := aiven.NewServiceIntegration{
 				IntegrationType: s.IntegrationType,
 				SourceService:   &s.SourceServiceName,
@@ -89,13 +173,25 @@ func (h *genericServiceHandler) createOrUpdate(a *aiven.Client, object client.Ob
 		if err != nil {
 			return err
 		}
+		userConfig = mergeIPFilter(userConfig, ipFilter)
+		if len(spec.StaticIPRefs) > 0 || len(o.getServiceStatus().StaticIPs) > 0 {
+			userConfig = keepStaticIPs(userConfig, current.UserConfig)
+		}
+
+		// Never decreases the disk space increased by autoscaleDisk,
+		// the increases are kept in the status even if the policy is removed
+		diskSpaceMB := v1alpha1.ConvertDiscSpace(o.getDiskSpace())
+		autoscaled := o.getAutoscaleDisk() != nil || len(o.getServiceStatus().DiskAutoscaleSteps) > 0
+		if autoscaled && current.DiskSpaceMB > diskSpaceMB {
+			diskSpaceMB = current.DiskSpaceMB
+		}
 
 		req := aiven.UpdateServiceRequest{
 			Cloud:                 spec.CloudName,
-			DiskSpaceMB:           v1alpha1.ConvertDiscSpace(o.getDiskSpace()),
+			DiskSpaceMB:           diskSpaceMB,
 			MaintenanceWindow:     getMaintenanceWindow(spec.MaintenanceWindowDow, spec.MaintenanceWindowTime),
 			Plan:                  spec.Plan,
-			Powered:               true,
+			Powered:               current.Powered, // changed in get()
 			ProjectVPCID:          toOptionalStringPointer(projectVPCID),
 			TerminationProtection: fromAnyPointer(spec.TerminationProtection),
 			UserConfig:            userConfig,
@@ -152,7 +248,56 @@ func (h *genericServiceHandler) get(a *aiven.Client, object client.Object) (*cor
 
 	status := o.getServiceStatus()
 	status.State = s.State
-	if s.State == "RUNNING" {
+	status.Powered = s.Powered
+
+	s, err = h.applyPowered(a, object, o, s)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.State == serviceStatePowerOff && !status.Powered {
+		meta.SetStatusCondition(&status.Conditions,
+			getRunningCondition(metav1.ConditionTrue, eventPoweredOff, "Instance is powered off on Aiven side"))
+
+		metav1.SetMetaDataAnnotation(o.getObjectMeta(), instanceIsRunningAnnotation, "true")
+		return nil, nil
+	}
+
+	if s.State == serviceStateRunning {
+		err = h.syncServiceIntegrations(a, o)
+		if err != nil {
+			return nil, err
+		}
+
+		err = h.syncServiceTags(a, o)
+		if err != nil {
+			return nil, err
+		}
+
+		err = h.autoscaleDisk(a, object, o, s)
+		if err != nil {
+			return nil, err
+		}
+
+		// Waits for the service to stop using the removed static IPs
+		done, err := h.syncStaticIPs(a, object, o, s)
+		if err != nil {
+			return nil, err
+		}
+
+		if !done {
+			delete(o.getObjectMeta().Annotations, instanceIsRunningAnnotation)
+			return nil, nil
+		}
+
+		// Keeps the previous data on errors, they must not block the secret
+		if u, ok := o.(serviceStatusAdapter); ok {
+			err = u.updateStatus(h.ctx, s)
+			if err != nil {
+				h.rec.Event(object, corev1.EventTypeWarning, eventUnableToUpdateStatus, err.Error())
+			}
+		}
+
 		meta.SetStatusCondition(&status.Conditions,
 			getRunningCondition(metav1.ConditionTrue, "CheckRunning", "Instance is running on Aiven side"))
 
@@ -172,12 +317,38 @@ func (h *genericServiceHandler) checkPreconditions(a *aiven.Client, object clien
 		return false, err
 	}
 
+	// Discovered addresses have changed, applies them with the update
 	spec := o.getServiceCommonSpec()
+	if spec.IPFilterFrom != nil && isAlreadyProcessed(object) {
+		changed, err := h.checkIPFilterFrom(object, o)
+		if err != nil {
+			return false, err
+		}
+
+		if changed {
+			delete(o.getObjectMeta().Annotations, processedGenerationAnnotation)
+		}
+	}
+
+	// Validates that services of new integrations are running.
+	// If not, the wrapper controller will try later.
+	// Existing integrations are not checked, so a powered off service doesn't block updates
+	created := make(map[v1alpha1.ServiceIntegrationItem]bool)
+	for _, i := range o.getServiceStatus().ServiceIntegrations {
+		created[i.ServiceIntegrationItem] = true
+	}
+
 	for _, s := range spec.ServiceIntegrations {
-		// Validates that read_replica is running
-		// If not, the wrapper controller will try later
-		if s.IntegrationType == "read_replica" {
-			r, err := checkServiceIsRunning(a, spec.Project, s.SourceServiceName)
+		if created[*s] {
+			continue
+		}
+
+		for _, name := range []string{s.SourceServiceName, s.DestinationServiceName} {
+			if name == "" {
+				continue
+			}
+
+			r, err := checkServiceIsRunning(a, spec.Project, name)
 			if !(r && err == nil) {
 				return false, nil
 			}
@@ -186,6 +357,190 @@ func (h *genericServiceHandler) checkPreconditions(a *aiven.Client, object clien
 	return true, nil
 }
 
+// syncServiceIntegrations creates integrations from the spec, the existing ones are reused,
+// and removes integrations that were removed from the spec.
+// Integrations managed by the ServiceIntegration kind are never removed
+func (h *genericServiceHandler) syncServiceIntegrations(a *aiven.Client, o serviceAdapter) error {
+	spec := o.getServiceCommonSpec()
+	status := o.getServiceStatus()
+	serviceName := o.getObjectMeta().Name
+	if len(spec.ServiceIntegrations) == 0 && len(status.ServiceIntegrations) == 0 {
+		return nil
+	}
+
+	list, err := a.ServiceIntegrations.List(spec.Project, serviceName)
+	if err != nil {
+		return fmt.Errorf("failed to list service integrations: %w", err)
+	}
+
+	integrations := make([]v1alpha1.ServiceIntegrationItemStatus, 0, len(spec.ServiceIntegrations))
+	ids := make(map[string]bool)
+	for _, item := range spec.ServiceIntegrations {
+		source, destination := item.SourceServiceName, item.DestinationServiceName
+		if source == "" {
+			source = serviceName
+		} else {
+			destination = serviceName
+		}
+
+		var id string
+		for _, i := range list {
+			if i.IntegrationType == item.IntegrationType && fromAnyPointer(i.SourceService) == source && fromAnyPointer(i.DestinationService) == destination {
+				id = i.ServiceIntegrationID
+				break
+			}
+		}
+
+		if id == "" {
+			i, err := a.ServiceIntegrations.Create(spec.Project, aiven.CreateServiceIntegrationRequest{
+				IntegrationType:    item.IntegrationType,
+				SourceService:      &source,
+				DestinationService: &destination,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create %s service integration: %w", item.IntegrationType, err)
+			}
+			id = i.ServiceIntegrationID
+		}
+
+		ids[id] = true
+		integrations = append(integrations, v1alpha1.ServiceIntegrationItemStatus{ServiceIntegrationItem: *item, ID: id})
+	}
+
+	for _, i := range status.ServiceIntegrations {
+		if ids[i.ID] {
+			continue
+		}
+
+		managed, err := h.isServiceIntegrationResource(i.ID)
+		if err != nil {
+			return err
+		}
+
+		if managed {
+			continue
+		}
+
+		err = a.ServiceIntegrations.Delete(spec.Project, i.ID)
+		if err != nil && !aiven.IsNotFound(err) {
+			return fmt.Errorf("failed to delete %s service integration: %w", i.IntegrationType, err)
+		}
+	}
+
+	status.ServiceIntegrations = integrations
+	return nil
+}
+
+// isServiceIntegrationResource returns true if the integration is managed by a ServiceIntegration resource
+func (h *genericServiceHandler) isServiceIntegrationResource(id string) (bool, error) {
+	list := new(v1alpha1.ServiceIntegrationList)
+	err := h.k8s.List(h.ctx, list, client.MatchingFields{serviceIntegrationIDIndexKey: id})
+	if err != nil {
+		return false, err
+	}
+
+	for _, i := range list.Items {
+		if i.Status.ID == id {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// applyPowered powers the service on or off according to powered and powerSchedule fields.
+// Services without backups are never powered off, they would lose the data
+func (h *genericServiceHandler) applyPowered(a *aiven.Client, object client.Object, o serviceAdapter, s *aiven.Service) (*aiven.Service, error) {
+	spec := o.getServiceCommonSpec()
+	status := o.getServiceStatus()
+	powered, err := isServicePowered(spec, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	// Nothing to change, or the service is being rebuilt
+	if powered == s.Powered || !(s.State == serviceStateRunning || s.State == serviceStatePowerOff) {
+		return s, nil
+	}
+
+	if !powered && len(s.Backups) == 0 {
+		c := meta.FindStatusCondition(status.Conditions, conditionTypePowered)
+		if c == nil || c.Reason != eventPowerOffRefused {
+			h.rec.Event(object, corev1.EventTypeWarning, eventPowerOffRefused, "Service has no backups and can't be powered off")
+		}
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:    conditionTypePowered,
+			Status:  metav1.ConditionTrue,
+			Reason:  eventPowerOffRefused,
+			Message: "Service has no backups and can't be powered off",
+		})
+		return s, nil
+	}
+
+	// Keeps the current settings, TerminationProtection is validated to be off
+	s, err = a.Services.Update(spec.Project, o.getObjectMeta().Name, aiven.UpdateServiceRequest{
+		Powered:               powered,
+		ProjectVPCID:          s.ProjectVPCID,
+		TerminationProtection: s.TerminationProtection,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to power the service on or off: %w", err)
+	}
+
+	reason, message, conditionStatus := eventPoweredOn, "Service is powered on", metav1.ConditionTrue
+	if !powered {
+		reason, message, conditionStatus = eventPoweredOff, "Service is powered off", metav1.ConditionFalse
+	}
+
+	h.rec.Event(object, corev1.EventTypeNormal, reason, message)
+	meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:    conditionTypePowered,
+		Status:  conditionStatus,
+		Reason:  reason,
+		Message: message,
+	})
+	status.State = s.State
+	status.Powered = s.Powered
+	return s, nil
+}
+
+// isServicePowered returns the powered state the service must have at the given time
+func isServicePowered(spec *v1alpha1.ServiceCommonSpec, now time.Time) (bool, error) {
+	if spec.Powered != nil && !*spec.Powered {
+		return false, nil
+	}
+
+	if spec.PowerSchedule == nil {
+		return true, nil
+	}
+
+	off, on, loc, err := spec.PowerSchedule.Parse()
+	if err != nil {
+		return false, err
+	}
+
+	now = now.In(loc)
+	return !off.Prev(now).After(on.Prev(now)), nil
+}
+
+// nextPowerTransition returns the time powerSchedule changes the powered state, zero time if it doesn't
+func nextPowerTransition(spec *v1alpha1.ServiceCommonSpec, now time.Time) (time.Time, error) {
+	if spec.PowerSchedule == nil || (spec.Powered != nil && !*spec.Powered) {
+		return time.Time{}, nil
+	}
+
+	off, on, loc, err := spec.PowerSchedule.Parse()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	now = now.In(loc)
+	next := off.Next(now)
+	if n := on.Next(now); next.IsZero() || (!n.IsZero() && n.Before(next)) {
+		next = n
+	}
+	return next, nil
+}
+
 // serviceAdapterFabric returns serviceAdapter for specific service, like MySQL
 type serviceAdapterFabric func(*aiven.Client, client.Object) (serviceAdapter, error)
 
@@ -196,6 +551,13 @@ type serviceAdapter interface {
 	getServiceCommonSpec() *v1alpha1.ServiceCommonSpec
 	getServiceType() string
 	getDiskSpace() string
+	getAutoscaleDisk() *v1alpha1.AutoscaleDisk
 	getUserConfig() any
 	newSecret(*aiven.Service) (*corev1.Secret, error)
 }
+
+// serviceStatusAdapter is implemented by adapters which keep additional service data in the status,
+// it is updated every time the running service is checked
+type serviceStatusAdapter interface {
+	updateStatus(context.Context, *aiven.Service) error
+}
@@ -0,0 +1,359 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// fakeServiceIntegrationsAPI keeps integrations of a running PostgreSQL service
+type fakeServiceIntegrationsAPI struct {
+	*fakeAivenAPI
+	nextID       int
+	integrations []*aiven.ServiceIntegration
+	deleted      []string
+}
+
+func newFakeServiceIntegrationsAPI(t *testing.T, integrations ...*aiven.ServiceIntegration) *fakeServiceIntegrationsAPI {
+	const integrationPath = "/v1/project/my-project/integration"
+
+	f := &fakeServiceIntegrationsAPI{integrations: integrations}
+	f.fakeAivenAPI = newFakeAivenAPI(t, fakeRoutes{
+		"GET /v1/project/my-project/service/my-pg": fakeResponse(http.StatusOK, map[string]any{"service": map[string]any{"state": "RUNNING", "powered": true}}),
+		"GET /v1/project/my-project/service/my-pg/integration": func(*http.Request, []string) (int, any) {
+			return http.StatusOK, map[string]any{"service_integrations": f.integrations}
+		},
+		"POST " + integrationPath: func(r *http.Request, _ []string) (int, any) {
+			req := new(aiven.CreateServiceIntegrationRequest)
+			f.decode(r, req)
+			f.nextID++
+			i := &aiven.ServiceIntegration{
+				ServiceIntegrationID: fmt.Sprintf("integration-%d", f.nextID),
+				IntegrationType:      req.IntegrationType,
+				SourceService:        req.SourceService,
+				DestinationService:   req.DestinationService,
+			}
+			f.integrations = append(f.integrations, i)
+			return http.StatusOK, map[string]any{"service_integration": i}
+		},
+		"DELETE " + integrationPath + "/*": func(_ *http.Request, params []string) (int, any) {
+			id := params[0]
+			f.deleted = append(f.deleted, id)
+			for j, i := range f.integrations {
+				if i.ServiceIntegrationID == id {
+					f.integrations = append(f.integrations[:j], f.integrations[j+1:]...)
+					break
+				}
+			}
+			return http.StatusOK, map[string]any{}
+		},
+	})
+	return f
+}
+
+func TestGenericServiceHandlerServiceIntegrations(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+	k8s := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&v1alpha1.ServiceIntegration{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-metrics", Namespace: "default"},
+		Status:     v1alpha1.ServiceIntegrationStatus{ID: "integration-100"},
+	}).Build()
+
+	logs, pg, grafana := "my-opensearch", "my-pg", "my-grafana"
+	api := newFakeServiceIntegrationsAPI(t,
+		// Created with the ServiceIntegration kind
+		&aiven.ServiceIntegration{ServiceIntegrationID: "integration-100", IntegrationType: "dashboard", SourceService: &grafana, DestinationService: &pg},
+	)
+	avn := newFakeAivenClient(api)
+	h := newGenericServiceHandler(context.Background(), newPostgresSQLAdapter, k8s, k8s, record.NewFakeRecorder(10), ServiceTagsConfig{})
+	service := &v1alpha1.PostgreSQL{ObjectMeta: metav1.ObjectMeta{Name: "my-pg", Namespace: "default"}}
+	service.Spec.Project = "my-project"
+	service.Spec.ServiceIntegrations = []*v1alpha1.ServiceIntegrationItem{
+		{IntegrationType: "logs", DestinationServiceName: logs},
+		{IntegrationType: "dashboard", SourceServiceName: grafana},
+	}
+
+	// Creates the missing one, reuses the existing one
+	_, err := h.get(avn, service)
+	require.NoError(t, err)
+	assert.Len(t, api.integrations, 2)
+	assert.Equal(t, []v1alpha1.ServiceIntegrationItemStatus{
+		{ServiceIntegrationItem: *service.Spec.ServiceIntegrations[0], ID: "integration-1"},
+		{ServiceIntegrationItem: *service.Spec.ServiceIntegrations[1], ID: "integration-100"},
+	}, service.Status.ServiceIntegrations)
+	assert.Equal(t, "my-pg", *api.integrations[1].SourceService)
+	assert.Equal(t, "my-opensearch", *api.integrations[1].DestinationService)
+
+	// Nothing changed
+	_, err = h.get(avn, service)
+	require.NoError(t, err)
+	assert.Len(t, api.integrations, 2)
+
+	// Removed from the spec, keeps the one of the ServiceIntegration kind
+	service.Spec.ServiceIntegrations = nil
+	_, err = h.get(avn, service)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"integration-1"}, api.deleted)
+	assert.Empty(t, service.Status.ServiceIntegrations)
+}
+
+// fakePoweredServiceAPI keeps a single PostgreSQL service
+type fakePoweredServiceAPI struct {
+	*fakeAivenAPI
+	service map[string]any
+	updates []aiven.UpdateServiceRequest
+}
+
+func newFakePoweredServiceAPI(t *testing.T, service map[string]any) *fakePoweredServiceAPI {
+	const servicePath = "/v1/project/my-project/service/my-pg"
+
+	f := &fakePoweredServiceAPI{service: service}
+	f.fakeAivenAPI = newFakeAivenAPI(t, fakeRoutes{
+		"GET " + servicePath: func(*http.Request, []string) (int, any) {
+			return http.StatusOK, map[string]any{"service": f.service}
+		},
+		"PUT " + servicePath: func(r *http.Request, _ []string) (int, any) {
+			req := aiven.UpdateServiceRequest{}
+			f.decode(r, &req)
+			f.updates = append(f.updates, req)
+			f.service["powered"] = req.Powered
+			f.service["state"] = "POWEROFF"
+			if req.Powered {
+				f.service["state"] = "REBUILDING"
+			}
+			return http.StatusOK, map[string]any{"service": f.service}
+		},
+	})
+	return f
+}
+
+func TestGenericServiceHandlerPowered(t *testing.T) {
+	api := newFakePoweredServiceAPI(t, map[string]any{"state": "RUNNING", "powered": true, "project_vpc_id": "my-vpc"})
+	avn := newFakeAivenClient(api)
+	rec := record.NewFakeRecorder(10)
+	h := newGenericServiceHandler(context.Background(), newPostgresSQLAdapter, nil, nil, rec, ServiceTagsConfig{})
+	powered := false
+	service := &v1alpha1.PostgreSQL{ObjectMeta: metav1.ObjectMeta{Name: "my-pg", Namespace: "default"}}
+	service.Spec.Project = "my-project"
+	service.Spec.Powered = &powered
+
+	// No backups, refuses to power off
+	_, err := h.get(avn, service)
+	require.NoError(t, err)
+	assert.Empty(t, api.updates)
+	assert.True(t, service.Status.Powered)
+	assert.Equal(t, "Warning PowerOffRefused Service has no backups and can't be powered off", <-rec.Events)
+	c := meta.FindStatusCondition(service.Status.Conditions, conditionTypePowered)
+	require.NotNil(t, c)
+	assert.Equal(t, eventPowerOffRefused, c.Reason)
+
+	// Powers off
+	api.service["backups"] = []map[string]any{{"backup_name": "backup-1"}}
+	_, err = h.get(avn, service)
+	require.NoError(t, err)
+	require.Len(t, api.updates, 1)
+	assert.False(t, api.updates[0].Powered)
+	assert.Equal(t, "my-vpc", *api.updates[0].ProjectVPCID)
+	assert.Equal(t, "Normal PoweredOff Service is powered off", <-rec.Events)
+	assert.False(t, service.Status.Powered)
+	assert.Equal(t, "POWEROFF", service.Status.State)
+	assert.True(t, IsAlreadyRunning(service))
+
+	// Powers on
+	powered = true
+	_, err = h.get(avn, service)
+	require.NoError(t, err)
+	require.Len(t, api.updates, 2)
+	assert.True(t, api.updates[1].Powered)
+	assert.Equal(t, "Normal PoweredOn Service is powered on", <-rec.Events)
+	assert.Equal(t, "REBUILDING", service.Status.State)
+}
+
+func TestServicePowerSchedule(t *testing.T) {
+	spec := &v1alpha1.ServiceCommonSpec{
+		PowerSchedule: &v1alpha1.ServicePowerSchedule{
+			PowerOff: "0 20 * * 1-5",
+			PowerOn:  "0 8 * * 1-5",
+			TimeZone: "Europe/Helsinki",
+		},
+	}
+
+	cases := []struct {
+		now     time.Time
+		powered bool
+		next    time.Time
+	}{
+		{
+			// Friday, 10:00 in Helsinki
+			now:     time.Date(2023, 5, 12, 7, 0, 0, 0, time.UTC),
+			powered: true,
+			next:    time.Date(2023, 5, 12, 17, 0, 0, 0, time.UTC),
+		},
+		{
+			// Saturday
+			now:     time.Date(2023, 5, 13, 12, 0, 0, 0, time.UTC),
+			powered: false,
+			next:    time.Date(2023, 5, 15, 5, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, c := range cases {
+		powered, err := isServicePowered(spec, c.now)
+		require.NoError(t, err)
+		assert.Equal(t, c.powered, powered)
+		next, err := nextPowerTransition(spec, c.now)
+		require.NoError(t, err)
+		assert.True(t, c.next.Equal(next), next)
+	}
+
+	// Powered off, the schedule is not applied
+	powered := false
+	spec.Powered = &powered
+	next, err := nextPowerTransition(spec, time.Now())
+	require.NoError(t, err)
+	assert.True(t, next.IsZero())
+}
+
+// fakeServiceTagsAPI keeps tags of a running PostgreSQL service
+type fakeServiceTagsAPI struct {
+	*fakeAivenAPI
+	tags map[string]string
+	sets int
+}
+
+func newFakeServiceTagsAPI(t *testing.T, tags map[string]string) *fakeServiceTagsAPI {
+	const servicePath = "/v1/project/my-project/service/my-pg"
+
+	f := &fakeServiceTagsAPI{tags: tags}
+	f.fakeAivenAPI = newFakeAivenAPI(t, fakeRoutes{
+		"GET " + servicePath: fakeResponse(http.StatusOK, map[string]any{"service": map[string]any{"state": "RUNNING", "powered": true}}),
+		"GET " + servicePath + "/tags": func(*http.Request, []string) (int, any) {
+			return http.StatusOK, map[string]any{"tags": f.tags}
+		},
+		"PUT " + servicePath + "/tags": func(r *http.Request, _ []string) (int, any) {
+			req := aiven.ServiceTagsRequest{}
+			f.decode(r, &req)
+			f.sets++
+			f.tags = req.Tags
+			return http.StatusOK, map[string]any{"tags": f.tags}
+		},
+	})
+	return f
+}
+
+func TestGenericServiceHandlerTags(t *testing.T) {
+	api := newFakeServiceTagsAPI(t, map[string]string{"owner": "console"})
+	avn := newFakeAivenClient(api)
+	h := newGenericServiceHandler(context.Background(), newPostgresSQLAdapter, nil, nil, record.NewFakeRecorder(10), ServiceTagsConfig{
+		Labels:      ParseServiceTagsKeys("team, example.com/cost-center, app.kubernetes.io/part-of=app"),
+		Annotations: ParseServiceTagsKeys("example.com/billing.code"),
+		ClusterName: "prod",
+		Namespace:   true,
+	})
+	service := &v1alpha1.PostgreSQL{ObjectMeta: metav1.ObjectMeta{
+		Name:        "my-pg",
+		Namespace:   "default",
+		Labels:      map[string]string{"team": "data", "example.com/cost-center": "42", "app.kubernetes.io/part-of": "shop"},
+		Annotations: map[string]string{"example.com/billing.code": "b-1"},
+	}}
+	service.Spec.Project = "my-project"
+	service.Spec.Tags = map[string]string{"env": "prod", "team": "platform"}
+
+	// Spec tags override the mirrored ones, keeps tags added outside the operator
+	_, err := h.get(avn, service)
+	require.NoError(t, err)
+	expected := map[string]string{
+		"env":           "prod",
+		"team":          "platform",
+		"cost-center":   "42",
+		"app":           "shop",
+		"billing_code":  "b-1",
+		"k8s-cluster":   "prod",
+		"k8s-namespace": "default",
+	}
+	assert.Equal(t, expected, service.Status.Tags)
+	expected["owner"] = "console"
+	assert.Equal(t, expected, api.tags)
+	assert.Equal(t, 1, api.sets)
+
+	// Nothing changed
+	_, err = h.get(avn, service)
+	require.NoError(t, err)
+	assert.Equal(t, 1, api.sets)
+
+	// Changed outside the operator, restores
+	api.tags["env"] = "dev"
+	_, err = h.get(avn, service)
+	require.NoError(t, err)
+	assert.Equal(t, 2, api.sets)
+	assert.Equal(t, "prod", api.tags["env"])
+
+	// Removed from the spec and the labels
+	service.Spec.Tags = nil
+	service.Labels = nil
+	service.Annotations = nil
+	h = newGenericServiceHandler(context.Background(), newPostgresSQLAdapter, nil, nil, record.NewFakeRecorder(10), ServiceTagsConfig{})
+	_, err = h.get(avn, service)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"owner": "console"}, api.tags)
+	assert.Nil(t, service.Status.Tags)
+
+	// No tags to manage, doesn't call the API
+	_, err = h.get(avn, service)
+	require.NoError(t, err)
+	assert.Equal(t, 3, api.sets)
+}
+
+// newFakeServiceStatesAPI serves services of the project with the given states
+func newFakeServiceStatesAPI(t *testing.T, states map[string]string) *fakeAivenAPI {
+	return newFakeAivenAPI(t, fakeRoutes{
+		"GET /v1/project/my-project/service/*": func(r *http.Request, params []string) (int, any) {
+			state, ok := states[params[0]]
+			if !ok {
+				return fakeNotFound(r, params)
+			}
+			return http.StatusOK, map[string]any{"service": map[string]any{"state": state}}
+		},
+	})
+}
+
+func TestGenericServiceHandlerCheckPreconditions(t *testing.T) {
+	api := newFakeServiceStatesAPI(t, map[string]string{"my-grafana": "RUNNING", "my-opensearch": "POWEROFF"})
+	avn := newFakeAivenClient(api)
+	h := newGenericServiceHandler(context.Background(), newPostgresSQLAdapter, nil, nil, record.NewFakeRecorder(10), ServiceTagsConfig{})
+	service := &v1alpha1.PostgreSQL{ObjectMeta: metav1.ObjectMeta{Name: "my-pg", Namespace: "default"}}
+	service.Spec.Project = "my-project"
+	service.Spec.ServiceIntegrations = []*v1alpha1.ServiceIntegrationItem{
+		{IntegrationType: "dashboard", SourceServiceName: "my-grafana"},
+	}
+
+	ok, err := h.checkPreconditions(avn, service)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	// Waits for the new integration's service
+	logs := &v1alpha1.ServiceIntegrationItem{IntegrationType: "logs", DestinationServiceName: "my-opensearch"}
+	service.Spec.ServiceIntegrations = append(service.Spec.ServiceIntegrations, logs)
+	ok, err = h.checkPreconditions(avn, service)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	// The existing integration doesn't block updates
+	service.Status.ServiceIntegrations = []v1alpha1.ServiceIntegrationItemStatus{{ServiceIntegrationItem: *logs, ID: "integration-1"}}
+	ok, err = h.checkPreconditions(avn, service)
+	require.NoError(t, err)
+	assert.True(t, ok)
+}
@@ -26,7 +26,7 @@ type GrafanaReconciler struct {
 // +kubebuilder:rbac:groups=aiven.io,resources=grafanas/finalizers,verbs=update
 
 func (r *GrafanaReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	return r.reconcileInstance(ctx, req, newGenericServiceHandler(newGrafanaAdapter), &v1alpha1.Grafana{})
+	return r.reconcileService(ctx, req, newGrafanaAdapter, &v1alpha1.Grafana{})
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -86,3 +86,7 @@ func (a *grafanaAdapter) getServiceType() string {
 func (a *grafanaAdapter) getDiskSpace() string {
 	return a.Spec.DiskSpace
 }
+
+func (a *grafanaAdapter) getAutoscaleDisk() *v1alpha1.AutoscaleDisk {
+	return a.Spec.AutoscaleDisk
+}
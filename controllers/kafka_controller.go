@@ -25,7 +25,7 @@ type KafkaReconciler struct {
 //+kubebuilder:rbac:groups=aiven.io,resources=kafkas/finalizers,verbs=update
 
 func (r *KafkaReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	return r.reconcileInstance(ctx, req, newGenericServiceHandler(newKafkaAdapter), &v1alpha1.Kafka{})
+	return r.reconcileService(ctx, req, newKafkaAdapter, &v1alpha1.Kafka{})
 }
 
 func (r *KafkaReconciler) SetupWithManager(mgr ctrl.Manager) error {
@@ -97,3 +97,7 @@ func (a *kafkaAdapter) getServiceType() string {
 func (a *kafkaAdapter) getDiskSpace() string {
 	return a.Spec.DiskSpace
 }
+
+func (a *kafkaAdapter) getAutoscaleDisk() *v1alpha1.AutoscaleDisk {
+	return a.Spec.AutoscaleDisk
+}
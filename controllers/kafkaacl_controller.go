@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"sync"
+	"time"
 
 	"github.com/aiven/aiven-go-client"
 	corev1 "k8s.io/api/core/v1"
@@ -18,55 +20,102 @@ import (
 	"github.com/aiven/aiven-operator/api/v1alpha1"
 )
 
+// kafkaACLResyncInterval how often ACLs are checked for being removed out-of-band
+const kafkaACLResyncInterval = 5 * time.Minute
+
 // KafkaACLReconciler reconciles a KafkaACL object
 type KafkaACLReconciler struct {
 	Controller
+
+	cache *kafkaACLCache
 }
 
-type KafkaACLHandler struct{}
+type KafkaACLHandler struct {
+	ctx   context.Context
+	k8s   client.Client
+	cache *kafkaACLCache
+}
 
 // +kubebuilder:rbac:groups=aiven.io,resources=kafkaacls,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=aiven.io,resources=kafkaacls/status,verbs=get;list;watch;create;delete
 
 func (r *KafkaACLReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	return r.reconcileInstance(ctx, req, KafkaACLHandler{}, &v1alpha1.KafkaACL{})
+	result, err := r.reconcileInstance(ctx, req, KafkaACLHandler{ctx: ctx, k8s: r.Client, cache: r.cache}, &v1alpha1.KafkaACL{})
+
+	// Comes back to recreate the ACL if it is removed out-of-band
+	if err == nil && result.IsZero() {
+		result.RequeueAfter = kafkaACLResyncInterval
+	}
+	return result, err
 }
 
 func (r *KafkaACLReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.cache = newKafkaACLCache()
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.KafkaACL{}).
 		Complete(r)
 }
 
+// createOrUpdate creates a new ACL, if there is no ACL that matches the spec.
+// ACL can't be modified, so the old one is kept in status.previousId
+// and removed only when the new one is confirmed to avoid access loss.
 func (h KafkaACLHandler) createOrUpdate(avn *aiven.Client, i client.Object, refs []client.Object) error {
 	acl, err := h.convert(i)
 	if err != nil {
 		return err
 	}
 
-	// ACL can't be really modified
-	// Tries to delete it instead
-	_, err = h.delete(avn, i)
+	list, err := h.cache.list(avn, acl.Spec.Project, acl.Spec.ServiceName)
 	if err != nil {
 		return err
 	}
 
-	// Creates it from scratch
-	r, err := avn.KafkaACLs.Create(
-		acl.Spec.Project,
-		acl.Spec.ServiceName,
-		aiven.CreateKafkaACLRequest{
-			Permission: acl.Spec.Permission,
-			Topic:      acl.Spec.Topic,
-			Username:   acl.Spec.Username,
-		},
-	)
+	managed, err := h.managedIDs(acl)
 	if err != nil {
 		return err
 	}
 
+	// Reuses the current ACL, or the one created by a failed attempt.
+	// An ACL managed by another KafkaACL is not shared, deleting either resource would remove the access of the other
+	var id string
+	if current, ok := list[acl.Status.ID]; ok && kafkaACLMatches(acl, current) {
+		id = current.ID
+	} else if a := findKafkaACL(list, acl); a != nil {
+		if name, ok := managed[a.ID]; ok {
+			return fmt.Errorf("ACL %s with the same spec is already managed by KafkaACL %s", a.ID, name)
+		}
+		id = a.ID
+	} else {
+		r, err := avn.KafkaACLs.Create(
+			acl.Spec.Project,
+			acl.Spec.ServiceName,
+			aiven.CreateKafkaACLRequest{
+				Permission: acl.Spec.Permission,
+				Topic:      acl.Spec.Topic,
+				Username:   acl.Spec.Username,
+			},
+		)
+		h.cache.invalidate(acl.Spec.Project, acl.Spec.ServiceName)
+		if err != nil {
+			return err
+		}
+		id = r.ID
+	}
+
+	if _, ok := list[acl.Status.ID]; ok && acl.Status.ID != id {
+		if acl.Status.PreviousID == "" {
+			acl.Status.PreviousID = acl.Status.ID
+		} else {
+			// The current ACL has never been confirmed, the previous one still keeps the access
+			err = h.deleteByID(avn, acl, acl.Status.ID)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
 	// New created ACL id set
-	acl.Status.ID = r.ID
+	acl.Status.ID = id
 	meta.SetStatusCondition(&acl.Status.Conditions,
 		getInitializedCondition("CreatedOrUpdate",
 			"Instance was created or update on Aiven side"))
@@ -89,7 +138,11 @@ func (h KafkaACLHandler) delete(avn *aiven.Client, i client.Object) (bool, error
 
 	id, err := h.getID(avn, acl)
 	if err == nil {
-		err = avn.KafkaACLs.Delete(acl.Spec.Project, acl.Spec.ServiceName, id)
+		err = h.deleteByID(avn, acl, id)
+	}
+
+	if err == nil && acl.Status.PreviousID != "" {
+		err = h.deleteByID(avn, acl, acl.Status.PreviousID)
 	}
 
 	if err != nil && !aiven.IsNotFound(err) {
@@ -99,6 +152,16 @@ func (h KafkaACLHandler) delete(avn *aiven.Client, i client.Object) (bool, error
 	return true, nil
 }
 
+// deleteByID deletes ACL, ignores not found error
+func (h KafkaACLHandler) deleteByID(avn *aiven.Client, acl *v1alpha1.KafkaACL, id string) error {
+	err := avn.KafkaACLs.Delete(acl.Spec.Project, acl.Spec.ServiceName, id)
+	h.cache.invalidate(acl.Spec.Project, acl.Spec.ServiceName)
+	if err != nil && !aiven.IsNotFound(err) {
+		return fmt.Errorf("cannot delete Kafka ACL %q: %w", id, err)
+	}
+	return nil
+}
+
 // todo: remove in v1
 // getID returns ACL's ID in < v0.5.1 compatible mode
 func (h KafkaACLHandler) getID(avn *aiven.Client, acl *v1alpha1.KafkaACL) (string, error) {
@@ -110,19 +173,23 @@ func (h KafkaACLHandler) getID(avn *aiven.Client, acl *v1alpha1.KafkaACL) (strin
 	}
 
 	// For old ACLs only
-	list, err := avn.KafkaACLs.List(acl.Spec.Project, acl.Spec.ServiceName)
+	list, err := h.cache.list(avn, acl.Spec.Project, acl.Spec.ServiceName)
 	if err != nil {
 		return "", err
 	}
 
-	for _, a := range list {
-		if acl.Spec.Topic == a.Topic && acl.Spec.Username == a.Username && acl.Spec.Permission == a.Permission {
+	managed, err := h.managedIDs(acl)
+	if err != nil {
+		return "", err
+	}
+
+	if a := findKafkaACL(list, acl); a != nil {
+		if _, ok := managed[a.ID]; !ok {
 			return a.ID, nil
 		}
 	}
 
-	// Error should mimic client error to play well with aiven.IsNotFound(err)
-	return "", aiven.Error{Status: http.StatusNotFound, Message: fmt.Sprintf("Kafka ACL %q not found", acl.Name)}
+	return "", newKafkaACLNotFoundError(acl)
 }
 
 func (h KafkaACLHandler) get(avn *aiven.Client, i client.Object) (*corev1.Secret, error) {
@@ -132,15 +199,35 @@ func (h KafkaACLHandler) get(avn *aiven.Client, i client.Object) (*corev1.Secret
 	}
 
 	id, err := h.getID(avn, acl)
-	if err != nil {
+	if err != nil && !aiven.IsNotFound(err) {
 		return nil, err
 	}
 
-	_, err = avn.KafkaACLs.Get(acl.Spec.Project, acl.Spec.ServiceName, id)
+	list, err := h.cache.list(avn, acl.Spec.Project, acl.Spec.ServiceName)
 	if err != nil {
 		return nil, err
 	}
 
+	if _, ok := list[id]; !ok {
+		// Removed out-of-band, marks the generation as not processed, so it is created again
+		delete(acl.Annotations, processedGenerationAnnotation)
+		delete(acl.Annotations, instanceIsRunningAnnotation)
+		meta.SetStatusCondition(&acl.Status.Conditions,
+			getRunningCondition(metav1.ConditionFalse, "NotFound",
+				"Instance was not found on Aiven side, recreating"))
+		return nil, newKafkaACLNotFoundError(acl)
+	}
+
+	// The new ACL is confirmed, the previous one can be removed
+	if acl.Status.PreviousID != "" {
+		err = h.deleteByID(avn, acl, acl.Status.PreviousID)
+		if err != nil {
+			return nil, err
+		}
+		acl.Status.PreviousID = ""
+	}
+
+	acl.Status.ID = id
 	meta.SetStatusCondition(&acl.Status.Conditions,
 		getRunningCondition(metav1.ConditionTrue, "CheckRunning",
 			"Instance is running on Aiven side"))
@@ -170,3 +257,97 @@ func (h KafkaACLHandler) convert(i client.Object) (*v1alpha1.KafkaACL, error) {
 
 	return acl, nil
 }
+
+func kafkaACLMatches(acl *v1alpha1.KafkaACL, a *aiven.KafkaACL) bool {
+	return acl.Spec.Topic == a.Topic && acl.Spec.Username == a.Username && acl.Spec.Permission == a.Permission
+}
+
+// managedIDs returns ACL ids of the same service managed by other KafkaACL resources, mapped to their names
+func (h KafkaACLHandler) managedIDs(acl *v1alpha1.KafkaACL) (map[string]string, error) {
+	list := &v1alpha1.KafkaACLList{}
+	err := h.k8s.List(h.ctx, list)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[string]string)
+	for _, o := range list.Items {
+		if o.UID == acl.UID || o.Spec.Project != acl.Spec.Project || o.Spec.ServiceName != acl.Spec.ServiceName {
+			continue
+		}
+
+		for _, id := range []string{o.Status.ID, o.Status.PreviousID} {
+			if id != "" {
+				ids[id] = o.Namespace + "/" + o.Name
+			}
+		}
+	}
+	return ids, nil
+}
+
+// findKafkaACL returns ACL that matches the spec
+func findKafkaACL(list map[string]*aiven.KafkaACL, acl *v1alpha1.KafkaACL) *aiven.KafkaACL {
+	for _, a := range list {
+		if kafkaACLMatches(acl, a) {
+			return a
+		}
+	}
+	return nil
+}
+
+// newKafkaACLNotFoundError error should mimic client error to play well with aiven.IsNotFound(err)
+func newKafkaACLNotFoundError(acl *v1alpha1.KafkaACL) error {
+	return aiven.Error{Status: http.StatusNotFound, Message: fmt.Sprintf("Kafka ACL %q not found", acl.Name)}
+}
+
+// kafkaACLCacheTTL how long the service ACL list is cached
+const kafkaACLCacheTTL = 30 * time.Second
+
+// kafkaACLCache caches ACL lists by service.
+// There is no API to get a single ACL, so every lookup lists all the service ACLs.
+type kafkaACLCache struct {
+	mu       sync.Mutex
+	services map[string]*kafkaACLCacheItem
+}
+
+type kafkaACLCacheItem struct {
+	expiresAt time.Time
+	acls      map[string]*aiven.KafkaACL
+}
+
+func newKafkaACLCache() *kafkaACLCache {
+	return &kafkaACLCache{services: make(map[string]*kafkaACLCacheItem)}
+}
+
+// list returns service ACLs by ID
+func (c *kafkaACLCache) list(avn *aiven.Client, project, serviceName string) (map[string]*aiven.KafkaACL, error) {
+	key := project + "/" + serviceName
+	c.mu.Lock()
+	item, ok := c.services[key]
+	c.mu.Unlock()
+	if ok && time.Now().Before(item.expiresAt) {
+		return item.acls, nil
+	}
+
+	list, err := avn.KafkaACLs.List(project, serviceName)
+	if err != nil {
+		return nil, err
+	}
+
+	acls := make(map[string]*aiven.KafkaACL, len(list))
+	for _, a := range list {
+		acls[a.ID] = a
+	}
+
+	c.mu.Lock()
+	c.services[key] = &kafkaACLCacheItem{expiresAt: time.Now().Add(kafkaACLCacheTTL), acls: acls}
+	c.mu.Unlock()
+	return acls, nil
+}
+
+// invalidate must be called when service ACLs are changed
+func (c *kafkaACLCache) invalidate(project, serviceName string) {
+	c.mu.Lock()
+	delete(c.services, project+"/"+serviceName)
+	c.mu.Unlock()
+}
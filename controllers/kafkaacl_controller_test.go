@@ -0,0 +1,163 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// fakeKafkaACLAPI keeps ACLs of a single service
+type fakeKafkaACLAPI struct {
+	*fakeAivenAPI
+	nextID int
+	acls   map[string]*aiven.KafkaACL
+}
+
+func newFakeKafkaACLAPI(t *testing.T) *fakeKafkaACLAPI {
+	const servicePath = "/v1/project/my-project/service/my-kafka"
+
+	f := &fakeKafkaACLAPI{acls: make(map[string]*aiven.KafkaACL)}
+	f.fakeAivenAPI = newFakeAivenAPI(t, fakeRoutes{
+		"GET " + servicePath: func(*http.Request, []string) (int, any) {
+			return http.StatusOK, map[string]any{
+				"service": map[string]any{"state": "RUNNING", "acl": f.list()},
+			}
+		},
+		"POST " + servicePath + "/acl": func(r *http.Request, _ []string) (int, any) {
+			acl := new(aiven.KafkaACL)
+			f.decode(r, acl)
+			f.nextID++
+			acl.ID = fmt.Sprintf("acl-%d", f.nextID)
+			f.acls[acl.ID] = acl
+			return http.StatusOK, map[string]any{"acl": f.list()}
+		},
+		"DELETE " + servicePath + "/acl/*": func(r *http.Request, params []string) (int, any) {
+			if _, ok := f.acls[params[0]]; !ok {
+				return fakeNotFound(r, params)
+			}
+			delete(f.acls, params[0])
+			return http.StatusOK, map[string]any{}
+		},
+	})
+	return f
+}
+
+func (f *fakeKafkaACLAPI) list() []*aiven.KafkaACL {
+	list := make([]*aiven.KafkaACL, 0, len(f.acls))
+	for _, a := range f.acls {
+		list = append(list, a)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].ID < list[j].ID
+	})
+	return list
+}
+
+func (f *fakeKafkaACLAPI) ids() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	ids := make([]string, 0, len(f.acls))
+	for _, a := range f.list() {
+		ids = append(ids, a.ID)
+	}
+	return ids
+}
+
+func (f *fakeKafkaACLAPI) remove(id string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.acls, id)
+}
+
+func TestKafkaACLHandler(t *testing.T) {
+	api := newFakeKafkaACLAPI(t)
+	avn := newFakeAivenClient(api)
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+	h := KafkaACLHandler{ctx: context.Background(), k8s: fake.NewClientBuilder().WithScheme(scheme).Build(), cache: newKafkaACLCache()}
+	acl := &v1alpha1.KafkaACL{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-acl", Namespace: "default", UID: "my-acl-uid", Generation: 1},
+		Spec: v1alpha1.KafkaACLSpec{
+			Project:     "my-project",
+			ServiceName: "my-kafka",
+			Permission:  "read",
+			Topic:       "my-topic",
+			Username:    "my-user",
+		},
+	}
+
+	// Creates
+	require.NoError(t, h.createOrUpdate(avn, acl, nil))
+	assert.Equal(t, "acl-1", acl.Status.ID)
+	_, err := h.get(avn, acl)
+	require.NoError(t, err)
+	assert.True(t, IsAlreadyRunning(acl))
+
+	// Updates: the new ACL is created first, the old one is kept until confirmed
+	acl.Generation = 2
+	acl.Spec.Permission = "readwrite"
+	require.NoError(t, h.createOrUpdate(avn, acl, nil))
+	assert.Equal(t, "acl-2", acl.Status.ID)
+	assert.Equal(t, "acl-1", acl.Status.PreviousID)
+	assert.Equal(t, []string{"acl-1", "acl-2"}, api.ids())
+
+	_, err = h.get(avn, acl)
+	require.NoError(t, err)
+	assert.Empty(t, acl.Status.PreviousID)
+	assert.Equal(t, []string{"acl-2"}, api.ids())
+
+	// Nothing to update, ACL exists
+	acl.Generation = 3
+	require.NoError(t, h.createOrUpdate(avn, acl, nil))
+	assert.Equal(t, "acl-2", acl.Status.ID)
+	assert.Equal(t, []string{"acl-2"}, api.ids())
+
+	// Removed out-of-band, recreates
+	api.remove("acl-2")
+	h.cache.invalidate("my-project", "my-kafka")
+	_, err = h.get(avn, acl)
+	assert.True(t, aiven.IsNotFound(err))
+	assert.False(t, isAlreadyProcessed(acl))
+	assert.False(t, IsAlreadyRunning(acl))
+
+	require.NoError(t, h.createOrUpdate(avn, acl, nil))
+	assert.Equal(t, "acl-3", acl.Status.ID)
+	assert.Empty(t, acl.Status.PreviousID)
+	_, err = h.get(avn, acl)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"acl-3"}, api.ids())
+
+	// Another resource with the same spec doesn't share the ACL
+	require.NoError(t, h.k8s.Create(context.Background(), acl.DeepCopy()))
+	duplicate := &v1alpha1.KafkaACL{
+		ObjectMeta: metav1.ObjectMeta{Name: "duplicate", Namespace: "default", UID: "duplicate-uid", Generation: 1},
+		Spec:       acl.Spec,
+	}
+	err = h.createOrUpdate(avn, duplicate, nil)
+	assert.EqualError(t, err, "ACL acl-3 with the same spec is already managed by KafkaACL default/my-acl")
+	assert.Empty(t, duplicate.Status.ID)
+
+	// Doesn't delete the ACL of the other resource
+	deleted, err := h.delete(avn, duplicate)
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	assert.Equal(t, []string{"acl-3"}, api.ids())
+
+	// Deletes
+	deleted, err = h.delete(avn, acl)
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	assert.Empty(t, api.ids())
+}
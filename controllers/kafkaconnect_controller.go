@@ -5,6 +5,9 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
 
 	"github.com/aiven/aiven-go-client"
 	corev1 "k8s.io/api/core/v1"
@@ -25,7 +28,7 @@ type KafkaConnectReconciler struct {
 // +kubebuilder:rbac:groups=aiven.io,resources=kafkaconnects/finalizers,verbs=update
 
 func (r *KafkaConnectReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	return r.reconcileInstance(ctx, req, newGenericServiceHandler(newKafkaConnectAdapter), &v1alpha1.KafkaConnect{})
+	return r.reconcileService(ctx, req, newKafkaConnectAdapter, &v1alpha1.KafkaConnect{})
 }
 
 func (r *KafkaConnectReconciler) SetupWithManager(mgr ctrl.Manager) error {
@@ -34,16 +37,17 @@ func (r *KafkaConnectReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Complete(r)
 }
 
-func newKafkaConnectAdapter(_ *aiven.Client, object client.Object) (serviceAdapter, error) {
+func newKafkaConnectAdapter(avn *aiven.Client, object client.Object) (serviceAdapter, error) {
 	kafkaConnect, ok := object.(*v1alpha1.KafkaConnect)
 	if !ok {
 		return nil, fmt.Errorf("object is not of type v1alpha1.KafkaConnect")
 	}
-	return &kafkaConnectAdapter{kafkaConnect}, nil
+	return &kafkaConnectAdapter{avn: avn, KafkaConnect: kafkaConnect}, nil
 }
 
 // kafkaConnectAdapter handles an Aiven KafkaConnect service
 type kafkaConnectAdapter struct {
+	avn *aiven.Client
 	*v1alpha1.KafkaConnect
 }
 
@@ -52,7 +56,7 @@ func (a *kafkaConnectAdapter) getObjectMeta() *metav1.ObjectMeta {
 }
 
 func (a *kafkaConnectAdapter) getServiceStatus() *v1alpha1.ServiceStatus {
-	return &a.Status
+	return &a.Status.ServiceStatus
 }
 
 func (a *kafkaConnectAdapter) getServiceCommonSpec() *v1alpha1.ServiceCommonSpec {
@@ -67,6 +71,25 @@ func (a *kafkaConnectAdapter) newSecret(_ *aiven.Service) (*corev1.Secret, error
 	return nil, nil
 }
 
+// updateStatus sets the plugins, they are available once the service is running
+func (a *kafkaConnectAdapter) updateStatus(ctx context.Context, _ *aiven.Service) error {
+	plugins, err := getKafkaConnectPlugins(ctx, a.avn, a.Spec.Project, a.Name)
+	if err != nil {
+		return fmt.Errorf("unable to get connector plugins: %w", err)
+	}
+
+	a.Status.Plugins = make([]v1alpha1.KafkaConnectPlugin, 0, len(plugins))
+	for _, p := range plugins {
+		a.Status.Plugins = append(a.Status.Plugins, v1alpha1.KafkaConnectPlugin{
+			Class:   p.Class,
+			Title:   p.Title,
+			Type:    p.Type,
+			Version: p.Version,
+		})
+	}
+	return nil
+}
+
 func (a *kafkaConnectAdapter) getServiceType() string {
 	return "kafka_connect"
 }
@@ -74,3 +97,26 @@ func (a *kafkaConnectAdapter) getServiceType() string {
 func (a *kafkaConnectAdapter) getDiskSpace() string {
 	return ""
 }
+
+func (a *kafkaConnectAdapter) getAutoscaleDisk() *v1alpha1.AutoscaleDisk {
+	return nil
+}
+
+// getKafkaConnectPlugins returns connector plugins available in the service.
+// aiven.Client doesn't support the endpoint, so it is called directly
+func getKafkaConnectPlugins(ctx context.Context, avn *aiven.Client, project, serviceName string) ([]aiven.KafkaConnectorPlugin, error) {
+	path := fmt.Sprintf("/project/%s/service/%s/available-connectors", url.PathEscape(project), url.PathEscape(serviceName))
+	var rsp struct {
+		Plugins []aiven.KafkaConnectorPlugin `json:"plugins"`
+	}
+
+	err := doAivenRequest(ctx, avn, http.MethodGet, path, nil, &rsp)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(rsp.Plugins, func(i, j int) bool {
+		return rsp.Plugins[i].Class < rsp.Plugins[j].Class
+	})
+	return rsp.Plugins, nil
+}
@@ -0,0 +1,63 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// fakeKafkaConnectPluginsAPI keeps a running KafkaConnect service, plugins fail until they are set
+type fakeKafkaConnectPluginsAPI struct {
+	*fakeAivenAPI
+	plugins []map[string]any
+}
+
+func newFakeKafkaConnectPluginsAPI(t *testing.T) *fakeKafkaConnectPluginsAPI {
+	const servicePath = "/v1/project/my-project/service/my-connect"
+
+	f := new(fakeKafkaConnectPluginsAPI)
+	f.fakeAivenAPI = newFakeAivenAPI(t, fakeRoutes{
+		"GET " + servicePath: fakeResponse(http.StatusOK, map[string]any{"service": map[string]any{"state": "RUNNING", "powered": true}}),
+		"GET " + servicePath + "/available-connectors": func(*http.Request, []string) (int, any) {
+			if f.plugins == nil {
+				return http.StatusInternalServerError, map[string]any{"message": "internal error"}
+			}
+			return http.StatusOK, map[string]any{"plugins": f.plugins}
+		},
+	})
+	return f
+}
+
+func TestKafkaConnectPlugins(t *testing.T) {
+	api := newFakeKafkaConnectPluginsAPI(t)
+	avn := newFakeAivenClient(api)
+	rec := record.NewFakeRecorder(10)
+	h := newGenericServiceHandler(context.Background(), newKafkaConnectAdapter, nil, nil, rec, ServiceTagsConfig{})
+	connect := &v1alpha1.KafkaConnect{ObjectMeta: metav1.ObjectMeta{Name: "my-connect", Namespace: "default"}}
+	connect.Spec.Project = "my-project"
+
+	// Plugin errors don't fail the check
+	_, err := h.get(avn, connect)
+	require.NoError(t, err)
+	assert.True(t, IsAlreadyRunning(connect))
+	assert.Empty(t, connect.Status.Plugins)
+	assert.Contains(t, <-rec.Events, "Warning UnableToUpdateStatus unable to get connector plugins")
+
+	api.plugins = []map[string]any{
+		{"class": "io.debezium.connector.postgresql.PostgresConnector", "title": "Debezium-PostgreSQL", "type": "source", "version": "1.9.7"},
+		{"class": "io.aiven.connect.jdbc.JdbcSinkConnector", "title": "JDBC sink", "type": "sink", "version": "6.8.0"},
+	}
+	_, err = h.get(avn, connect)
+	require.NoError(t, err)
+	assert.Equal(t, []v1alpha1.KafkaConnectPlugin{
+		{Class: "io.aiven.connect.jdbc.JdbcSinkConnector", Title: "JDBC sink", Type: "sink", Version: "6.8.0"},
+		{Class: "io.debezium.connector.postgresql.PostgresConnector", Title: "Debezium-PostgreSQL", Type: "source", Version: "1.9.7"},
+	}, connect.Status.Plugins)
+}
@@ -5,17 +5,30 @@ package controllers
 import (
 	"bytes"
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
 	"strconv"
+	"strings"
 	"text/template"
+	"time"
 
 	"github.com/aiven/aiven-go-client"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"github.com/aiven/aiven-operator/api/v1alpha1"
 )
@@ -26,24 +39,127 @@ type KafkaConnectorReconciler struct {
 }
 
 type KafkaConnectorHandler struct {
+	ctx context.Context
 	k8s client.Client
+	rec record.EventRecorder
 }
 
+const (
+	kafkaConnectorStateRunning = "running"
+	kafkaConnectorStatePaused  = "paused"
+
+	// kafkaConnectorPollInterval how often tasks are checked when auto restart is enabled
+	kafkaConnectorPollInterval = time.Minute
+
+	conditionTypeAutoRestart = "AutoRestart"
+	conditionTypeConfigValid = "ConfigValid"
+
+	eventKafkaConnectorPaused           = "Paused"
+	eventKafkaConnectorResumed          = "Resumed"
+	eventKafkaConnectorRestarted        = "Restarted"
+	eventKafkaConnectorTasksRestarted   = "FailedTasksRestarted"
+	eventKafkaConnectorRestartsExceeded = "RestartAttemptsExceeded"
+)
+
 //+kubebuilder:rbac:groups=aiven.io,resources=kafkaconnectors,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=aiven.io,resources=kafkaconnectors/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=aiven.io,resources=kafkaconnectors/finalizers,verbs=update
+//+kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
 
 func (r *KafkaConnectorReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	return r.reconcileInstance(ctx, req, KafkaConnectorHandler{k8s: r.Client}, &v1alpha1.KafkaConnector{})
+	conn := &v1alpha1.KafkaConnector{}
+	result, err := r.reconcileInstance(ctx, req, KafkaConnectorHandler{ctx: ctx, k8s: r.Client, rec: r.Recorder}, conn)
+
+	// Comes back to detect and restart failed tasks
+	if err == nil && result.IsZero() {
+		result.RequeueAfter = kafkaConnectorRequeueAfter(conn, time.Now())
+	}
+	return result, err
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *KafkaConnectorReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	err := mgr.GetFieldIndexer().IndexField(
+		context.Background(), &v1alpha1.KafkaConnector{}, kafkaConnectorConfigFromIndexKey, kafkaConnectorConfigFromIndexFunc,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to add index for configFrom fields: %w", err)
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.KafkaConnector{}).
+		Watches(&source.Kind{Type: &corev1.Secret{}}, handler.EnqueueRequestsFromMapFunc(r.findConnectorsBy("Secret"))).
+		Watches(&source.Kind{Type: &corev1.ConfigMap{}}, handler.EnqueueRequestsFromMapFunc(r.findConnectorsBy("ConfigMap"))).
+		Watches(&source.Kind{Type: &v1alpha1.ServiceUser{}}, handler.EnqueueRequestsFromMapFunc(r.findConnectorsBy("ServiceUser"))).
+		Watches(&source.Kind{Type: &v1alpha1.KafkaTopic{}}, handler.EnqueueRequestsFromMapFunc(r.findConnectorsBy("KafkaTopic"))).
 		Complete(r)
 }
 
+// kafkaConnectorConfigFromIndexKey indexes resources used in the connector config, values are "Kind/name"
+const kafkaConnectorConfigFromIndexKey = "spec.configFrom"
+
+// fromSecretTemplateRe finds secrets used in userConfig templates
+var fromSecretTemplateRe = regexp.MustCompile(`fromSecret\s+"([^"]+)"`)
+
+func kafkaConnectorConfigFromIndexFunc(o client.Object) []string {
+	conn, ok := o.(*v1alpha1.KafkaConnector)
+	if !ok {
+		return nil
+	}
+
+	keys := make([]string, 0)
+	for _, c := range conn.Spec.ConfigFrom {
+		switch {
+		case c.SecretKeyRef != nil:
+			keys = append(keys, "Secret/"+c.SecretKeyRef.Name)
+		case c.ConfigMapKeyRef != nil:
+			keys = append(keys, "ConfigMap/"+c.ConfigMapKeyRef.Name)
+		case c.ServiceUserRef != nil:
+			keys = append(keys, "ServiceUser/"+c.ServiceUserRef.Name)
+		case c.KafkaTopicRef != nil:
+			keys = append(keys, "KafkaTopic/"+c.KafkaTopicRef.Name)
+		}
+	}
+
+	for _, v := range conn.Spec.UserConfig {
+		for _, m := range fromSecretTemplateRe.FindAllStringSubmatch(v, -1) {
+			keys = append(keys, "Secret/"+m[1])
+		}
+	}
+	return keys
+}
+
+// findConnectorsBy returns connectors which use the given object in their config
+func (r *KafkaConnectorReconciler) findConnectorsBy(kind string) handler.MapFunc {
+	return func(o client.Object) []reconcile.Request {
+		keys := []string{kind + "/" + o.GetName()}
+
+		// ServiceUser connection secret
+		for _, owner := range o.GetOwnerReferences() {
+			if owner.Kind == "ServiceUser" {
+				keys = append(keys, "ServiceUser/"+owner.Name)
+			}
+		}
+
+		requests := make([]reconcile.Request, 0)
+		for _, key := range keys {
+			list := &v1alpha1.KafkaConnectorList{}
+			err := r.List(context.Background(), list, client.InNamespace(o.GetNamespace()), client.MatchingFields{kafkaConnectorConfigFromIndexKey: key})
+			if err != nil {
+				r.Log.Error(err, "unable to list kafka connectors", "key", key)
+				continue
+			}
+
+			for _, item := range list.Items {
+				requests = append(requests, reconcile.Request{
+					NamespacedName: types.NamespacedName{Name: item.Name, Namespace: item.Namespace},
+				})
+			}
+		}
+		return requests
+	}
+}
+
 func (h KafkaConnectorHandler) createOrUpdate(avn *aiven.Client, o client.Object, refs []client.Object) error {
 	conn, err := h.convert(o)
 	if err != nil {
@@ -59,6 +175,19 @@ func (h KafkaConnectorHandler) createOrUpdate(avn *aiven.Client, o client.Object
 	if err != nil {
 		return fmt.Errorf("unable to build connector config: %w", err)
 	}
+	conn.Status.ConfigHash = kafkaConnectorConfigHash(conn, connCfg)
+
+	// Invalid config is reported in the condition, not created
+	valid, err := h.validateConfig(avn, conn, connCfg)
+	if err != nil {
+		return fmt.Errorf("unable to validate connector config: %w", err)
+	}
+
+	if !valid {
+		metav1.SetMetaDataAnnotation(&conn.ObjectMeta,
+			processedGenerationAnnotation, strconv.FormatInt(conn.GetGeneration(), formatIntBaseDecimal))
+		return nil
+	}
 
 	var reason string
 	if !exists {
@@ -73,7 +202,11 @@ func (h KafkaConnectorHandler) createOrUpdate(avn *aiven.Client, o client.Object
 			return err
 		}
 		reason = "Updated"
+	}
 
+	err = h.applyState(avn, conn)
+	if err != nil {
+		return err
 	}
 
 	meta.SetStatusCondition(&conn.Status.Conditions,
@@ -98,16 +231,7 @@ func (h KafkaConnectorHandler) buildConnectorConfig(conn *v1alpha1.KafkaConnecto
 	)
 	var (
 		templateFuncFromSecret = func(name, key string) (string, error) {
-			var secret corev1.Secret
-
-			if err := h.k8s.Get(context.Background(), types.NamespacedName{Namespace: conn.GetNamespace(), Name: name}, &secret); err != nil {
-				return "", fmt.Errorf("unable to fetch secret: '%w'", err)
-			}
-			v, ok := secret.Data[key]
-			if !ok {
-				return "", fmt.Errorf("no such key in secret '%s': '%s'", name, key)
-			}
-			return string(v), nil
+			return getSecretValue(context.Background(), h.k8s, conn.GetNamespace(), name, key)
 		}
 
 		funcMap = template.FuncMap{
@@ -131,9 +255,174 @@ func (h KafkaConnectorHandler) buildConnectorConfig(conn *v1alpha1.KafkaConnecto
 		}
 		m[k] = templateRes.String()
 	}
+
+	for _, c := range conn.Spec.ConfigFrom {
+		v, err := h.resolveConfigFrom(conn.Namespace, c)
+		if err != nil {
+			return nil, fmt.Errorf("unable to resolve configFrom for key '%s': %w", c.Key, err)
+		}
+		m[c.Key] = v
+	}
 	return aiven.KafkaConnectorConfig(m), nil
 }
 
+// kafkaConnectConfigField is a field of the connector plugin configuration schema
+type kafkaConnectConfigField struct {
+	Name         string `json:"name"`
+	Type         string `json:"type"`
+	Required     bool   `json:"required"`
+	DefaultValue string `json:"default_value"`
+}
+
+// validateConfig checks the connector class is available and validates the config against the plugin configuration schema.
+// Sets the ConfigValid condition
+func (h KafkaConnectorHandler) validateConfig(avn *aiven.Client, conn *v1alpha1.KafkaConnector, cfg aiven.KafkaConnectorConfig) (bool, error) {
+	plugins, err := getKafkaConnectPlugins(h.ctx, avn, conn.Spec.Project, conn.Spec.ServiceName)
+	if err != nil {
+		return false, fmt.Errorf("unable to get connector plugins: %w", err)
+	}
+
+	available := false
+	classes := make([]string, 0, len(plugins))
+	for _, p := range plugins {
+		classes = append(classes, p.Class)
+		available = available || p.Class == conn.Spec.ConnectorClass
+	}
+
+	errs := make([]string, 0)
+	if !available {
+		errs = append(errs, fmt.Sprintf("connector.class: %q is not available, available: %s", conn.Spec.ConnectorClass, strings.Join(classes, ", ")))
+	} else {
+		// aiven.Client doesn't support the configuration schema endpoint
+		path := fmt.Sprintf(
+			"/project/%s/service/%s/connector-plugins/%s/configuration",
+			url.PathEscape(conn.Spec.Project), url.PathEscape(conn.Spec.ServiceName), url.PathEscape(conn.Spec.ConnectorClass),
+		)
+		var rsp struct {
+			ConfigurationSchema []kafkaConnectConfigField `json:"configuration_schema"`
+		}
+
+		err = doAivenRequest(h.ctx, avn, http.MethodGet, path, nil, &rsp)
+		if err != nil {
+			return false, fmt.Errorf("unable to get connector configuration schema: %w", err)
+		}
+		errs = validateConnectorConfig(rsp.ConfigurationSchema, cfg)
+	}
+
+	if len(errs) > 0 {
+		meta.SetStatusCondition(&conn.Status.Conditions, metav1.Condition{
+			Type:    conditionTypeConfigValid,
+			Status:  metav1.ConditionFalse,
+			Reason:  "Invalid",
+			Message: strings.Join(errs, "; "),
+		})
+		meta.SetStatusCondition(&conn.Status.Conditions,
+			getRunningCondition(metav1.ConditionFalse, "Invalid", "Connector config is invalid"))
+		return false, nil
+	}
+
+	meta.SetStatusCondition(&conn.Status.Conditions, metav1.Condition{
+		Type:    conditionTypeConfigValid,
+		Status:  metav1.ConditionTrue,
+		Reason:  "Valid",
+		Message: "Connector config is valid",
+	})
+	return true, nil
+}
+
+// validateConnectorConfig returns field errors.
+// Errors must not contain the values, they might be secrets
+func validateConnectorConfig(schema []kafkaConnectConfigField, cfg aiven.KafkaConnectorConfig) []string {
+	errs := make([]string, 0)
+	for _, f := range schema {
+		v, ok := cfg[f.Name]
+		if !ok {
+			if f.Required && f.DefaultValue == "" {
+				errs = append(errs, fmt.Sprintf("%s: required field is missing", f.Name))
+			}
+			continue
+		}
+
+		var err error
+		switch f.Type {
+		case "INT", "SHORT", "LONG":
+			_, err = strconv.ParseInt(v, 10, 64)
+		case "DOUBLE":
+			_, err = strconv.ParseFloat(v, 64)
+		case "BOOLEAN":
+			_, err = strconv.ParseBool(v)
+		}
+
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: must be of type %s", f.Name, f.Type))
+		}
+	}
+	return errs
+}
+
+// resolveConfigFrom returns the value of the referenced resource.
+// Errors must not contain the values, they end up in events
+func (h KafkaConnectorHandler) resolveConfigFrom(namespace string, c v1alpha1.KafkaConnectorConfigFrom) (string, error) {
+	ctx := context.Background()
+	switch {
+	case c.SecretKeyRef != nil:
+		return getSecretValue(ctx, h.k8s, namespace, c.SecretKeyRef.Name, c.SecretKeyRef.Key)
+	case c.ConfigMapKeyRef != nil:
+		var cm corev1.ConfigMap
+		err := h.k8s.Get(ctx, types.NamespacedName{Namespace: namespace, Name: c.ConfigMapKeyRef.Name}, &cm)
+		if err != nil {
+			return "", fmt.Errorf("unable to fetch configmap: %w", err)
+		}
+		if v, ok := cm.Data[c.ConfigMapKeyRef.Key]; ok {
+			return v, nil
+		}
+		if v, ok := cm.BinaryData[c.ConfigMapKeyRef.Key]; ok {
+			return string(v), nil
+		}
+		return "", fmt.Errorf("no such key in configmap '%s': '%s'", c.ConfigMapKeyRef.Name, c.ConfigMapKeyRef.Key)
+	case c.ServiceUserRef != nil:
+		var user v1alpha1.ServiceUser
+		err := h.k8s.Get(ctx, types.NamespacedName{Namespace: namespace, Name: c.ServiceUserRef.Name}, &user)
+		if err != nil {
+			return "", fmt.Errorf("unable to fetch service user: %w", err)
+		}
+		name := user.Spec.ConnInfoSecretTarget.Name
+		if name == "" {
+			name = user.Name
+		}
+		return getSecretValue(ctx, h.k8s, namespace, name, c.ServiceUserRef.Key)
+	case c.KafkaTopicRef != nil:
+		var topic v1alpha1.KafkaTopic
+		err := h.k8s.Get(ctx, types.NamespacedName{Namespace: namespace, Name: c.KafkaTopicRef.Name}, &topic)
+		if err != nil {
+			return "", fmt.Errorf("unable to fetch kafka topic: %w", err)
+		}
+		return topic.GetTopicName(), nil
+	}
+	return "", fmt.Errorf("no source is set")
+}
+
+// kafkaConnectorConfigHash returns a salted hash of the config, so secrets can't be guessed from the status
+func kafkaConnectorConfigHash(conn *v1alpha1.KafkaConnector, cfg aiven.KafkaConnectorConfig) string {
+	return configHash(conn.UID, cfg)
+}
+
+// configHash returns a hash of the values salted with the object UID
+func configHash(uid types.UID, cfg map[string]string) string {
+	keys := make([]string, 0, len(cfg))
+	for k := range cfg {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	h := hmac.New(sha256.New, []byte(uid))
+	for _, k := range keys {
+		// Key and value lengths make the input unambiguous
+		_, _ = fmt.Fprintf(h, "%d:%s%d:%s", len(k), k, len(cfg[k]), cfg[k])
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
 func (h KafkaConnectorHandler) delete(avn *aiven.Client, o client.Object) (bool, error) {
 	conn, err := h.convert(o)
 	if err != nil {
@@ -160,6 +449,21 @@ func (h KafkaConnectorHandler) get(avn *aiven.Client, o client.Object) (*corev1.
 		return nil, err
 	}
 
+	// The restart is requested by the user, goes even if the config is invalid
+	_, restartRequested := conn.Annotations[v1alpha1.KafkaConnectorRestartAnnotation]
+	if restartRequested {
+		err = h.restart(avn, conn)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if meta.IsStatusConditionFalse(conn.Status.Conditions, conditionTypeConfigValid) {
+		// Settled until the spec or the configFrom values change, the Running condition is set by validateConfig
+		metav1.SetMetaDataAnnotation(&conn.ObjectMeta, instanceIsRunningAnnotation, "true")
+		return nil, nil
+	}
+
 	connAtAiven, err := avn.KafkaConnectors.GetByName(conn.Spec.Project, conn.Spec.ServiceName, conn.Name)
 	if err != nil {
 		return nil, err
@@ -197,15 +501,182 @@ func (h KafkaConnectorHandler) get(avn *aiven.Client, o client.Object) (*corev1.
 		}
 	}
 
-	if connStat.Status.State == "RUNNING" {
+	if !restartRequested {
+		err = h.autoRestart(avn, conn, connStat.Status)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	switch {
+	case connStat.Status.State == "RUNNING":
 		meta.SetStatusCondition(&conn.Status.Conditions,
 			getRunningCondition(metav1.ConditionTrue, "CheckRunning",
 				"Instance is running on Aiven side"))
 		metav1.SetMetaDataAnnotation(&conn.ObjectMeta, instanceIsRunningAnnotation, "true")
+	case connStat.Status.State == "PAUSED" && conn.Spec.State == kafkaConnectorStatePaused:
+		meta.SetStatusCondition(&conn.Status.Conditions,
+			getRunningCondition(metav1.ConditionTrue, "CheckPaused",
+				"Instance is paused on Aiven side"))
+		metav1.SetMetaDataAnnotation(&conn.ObjectMeta, instanceIsRunningAnnotation, "true")
 	}
 	return nil, nil
 }
 
+// applyState pauses or resumes the connector
+func (h KafkaConnectorHandler) applyState(avn *aiven.Client, conn *v1alpha1.KafkaConnector) error {
+	connStat, err := avn.KafkaConnectors.Status(conn.Spec.Project, conn.Spec.ServiceName, conn.Name)
+	if err != nil {
+		return err
+	}
+
+	paused := connStat.Status.State == "PAUSED"
+	switch {
+	case conn.Spec.State == kafkaConnectorStatePaused && !paused:
+		err = h.doConnectorRequest(avn, conn, "/pause")
+		if err != nil {
+			return fmt.Errorf("unable to pause kafka connector: %w", err)
+		}
+		h.rec.Event(conn, corev1.EventTypeNormal, eventKafkaConnectorPaused, "connector is paused")
+	case conn.Spec.State != kafkaConnectorStatePaused && paused:
+		err = h.doConnectorRequest(avn, conn, "/resume")
+		if err != nil {
+			return fmt.Errorf("unable to resume kafka connector: %w", err)
+		}
+		h.rec.Event(conn, corev1.EventTypeNormal, eventKafkaConnectorResumed, "connector is resumed")
+	}
+	return nil
+}
+
+// restart restarts the connector on the user's request
+func (h KafkaConnectorHandler) restart(avn *aiven.Client, conn *v1alpha1.KafkaConnector) error {
+	err := h.doConnectorRequest(avn, conn, "/restart")
+	if aiven.IsNotFound(err) {
+		// Nothing to restart, e.g. the connector is not created because of invalid config
+		delete(conn.Annotations, v1alpha1.KafkaConnectorRestartAnnotation)
+		h.rec.Event(conn, corev1.EventTypeWarning, eventKafkaConnectorRestarted, "connector is not restarted, it doesn't exist")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("unable to restart kafka connector: %w", err)
+	}
+
+	delete(conn.Annotations, v1alpha1.KafkaConnectorRestartAnnotation)
+	conn.Status.RestartAttempts = 0
+	conn.Status.LastRestartTime = &metav1.Time{Time: time.Now()}
+	h.rec.Event(conn, corev1.EventTypeNormal, eventKafkaConnectorRestarted, "connector is restarted on request")
+	return nil
+}
+
+// autoRestart restarts failed tasks according to the auto restart policy
+func (h KafkaConnectorHandler) autoRestart(avn *aiven.Client, conn *v1alpha1.KafkaConnector, status aiven.KafkaConnectorStatus) error {
+	if conn.Status.TasksStatus.Failed == 0 {
+		// Resets the counter when tasks are stable
+		if conn.Status.LastRestartTime == nil || conn.Spec.AutoRestart == nil ||
+			time.Since(conn.Status.LastRestartTime.Time) > conn.Spec.AutoRestart.MaxDelay.Duration {
+			conn.Status.RestartAttempts = 0
+			meta.RemoveStatusCondition(&conn.Status.Conditions, conditionTypeAutoRestart)
+		}
+		return nil
+	}
+
+	if conn.Spec.AutoRestart == nil || conn.Spec.State == kafkaConnectorStatePaused {
+		return nil
+	}
+
+	now := time.Now()
+	if conn.Status.RestartAttempts >= conn.Spec.AutoRestart.MaxAttempts {
+		if !meta.IsStatusConditionFalse(conn.Status.Conditions, conditionTypeAutoRestart) {
+			// Reports once
+			meta.SetStatusCondition(&conn.Status.Conditions, metav1.Condition{
+				Type:    conditionTypeAutoRestart,
+				Status:  metav1.ConditionFalse,
+				Reason:  eventKafkaConnectorRestartsExceeded,
+				Message: "Failed tasks are not restarted anymore",
+			})
+			h.rec.Eventf(conn, corev1.EventTypeWarning, eventKafkaConnectorRestartsExceeded,
+				"failed tasks are not restarted anymore, %d attempts made", conn.Spec.AutoRestart.MaxAttempts)
+		}
+		return nil
+	}
+
+	if _, ok := kafkaConnectorRestartDelay(conn, now); ok {
+		// Backoff delay hasn't passed yet
+		return nil
+	}
+
+	for _, t := range status.Tasks {
+		if t.State != "FAILED" {
+			continue
+		}
+
+		err := h.doConnectorRequest(avn, conn, fmt.Sprintf("/tasks/%d/restart", t.Id))
+		if err != nil {
+			return fmt.Errorf("unable to restart kafka connector task %d: %w", t.Id, err)
+		}
+	}
+
+	conn.Status.RestartAttempts++
+	conn.Status.LastRestartTime = &metav1.Time{Time: now}
+	h.rec.Eventf(conn, corev1.EventTypeNormal, eventKafkaConnectorTasksRestarted,
+		"%d failed tasks are restarted, attempt %d of %d",
+		conn.Status.TasksStatus.Failed, conn.Status.RestartAttempts, conn.Spec.AutoRestart.MaxAttempts)
+	return nil
+}
+
+// kafkaConnectorRequeueAfter returns when to check the connector again.
+// With auto restart, tasks are polled since they can fail at any time
+func kafkaConnectorRequeueAfter(conn *v1alpha1.KafkaConnector, now time.Time) time.Duration {
+	if conn.Spec.AutoRestart == nil || meta.IsStatusConditionFalse(conn.Status.Conditions, conditionTypeConfigValid) {
+		// Invalid config waits for the spec or the configFrom values to change
+		return 0
+	}
+
+	if delay, ok := kafkaConnectorRestartDelay(conn, now); ok && delay < kafkaConnectorPollInterval {
+		return delay
+	}
+	return kafkaConnectorPollInterval
+}
+
+// kafkaConnectorRestartDelay returns time left until the next auto restart of failed tasks
+func kafkaConnectorRestartDelay(conn *v1alpha1.KafkaConnector, now time.Time) (time.Duration, bool) {
+	policy := conn.Spec.AutoRestart
+	if policy == nil || conn.Status.TasksStatus.Failed == 0 || conn.Status.RestartAttempts >= policy.MaxAttempts {
+		return 0, false
+	}
+
+	if conn.Status.RestartAttempts == 0 || conn.Status.LastRestartTime == nil {
+		// The first attempt goes right away
+		return 0, false
+	}
+
+	// Doubles the delay with every attempt
+	delay := policy.InitialDelay.Duration
+	for i := 1; i < conn.Status.RestartAttempts; i++ {
+		delay *= 2
+		if delay >= policy.MaxDelay.Duration {
+			break
+		}
+	}
+
+	if delay > policy.MaxDelay.Duration {
+		delay = policy.MaxDelay.Duration
+	}
+
+	left := conn.Status.LastRestartTime.Add(delay).Sub(now)
+	return left, left > 0
+}
+
+// doConnectorRequest calls connector actions, like pause or restart, which aiven.Client doesn't support
+func (h KafkaConnectorHandler) doConnectorRequest(avn *aiven.Client, conn *v1alpha1.KafkaConnector, action string) error {
+	path := fmt.Sprintf(
+		"/project/%s/service/%s/connectors/%s%s",
+		url.PathEscape(conn.Spec.Project), url.PathEscape(conn.Spec.ServiceName), url.PathEscape(conn.Name), action,
+	)
+	return doAivenRequest(h.ctx, avn, http.MethodPost, path, nil, nil)
+}
+
 func (h KafkaConnectorHandler) checkPreconditions(avn *aiven.Client, o client.Object) (bool, error) {
 	conn, err := h.convert(o)
 	if err != nil {
@@ -215,6 +686,18 @@ func (h KafkaConnectorHandler) checkPreconditions(avn *aiven.Client, o client.Ob
 	meta.SetStatusCondition(&conn.Status.Conditions,
 		getInitializedCondition("Preconditions", "Checking preconditions"))
 
+	if isAlreadyProcessed(conn) {
+		// Referenced resources might have changed, then the config must be applied again
+		connCfg, err := h.buildConnectorConfig(conn)
+		if err != nil {
+			return false, fmt.Errorf("unable to build connector config: %w", err)
+		}
+
+		if kafkaConnectorConfigHash(conn, connCfg) != conn.Status.ConfigHash {
+			delete(conn.Annotations, processedGenerationAnnotation)
+		}
+	}
+
 	return checkServiceIsRunning(avn, conn.Spec.Project, conn.Spec.ServiceName)
 }
 
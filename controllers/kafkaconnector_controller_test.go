@@ -0,0 +1,320 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// fakeKafkaConnectAPI keeps a single connector of a running service
+type fakeKafkaConnectAPI struct {
+	*fakeAivenAPI
+	state   string
+	tasks   []map[string]any
+	actions []string
+}
+
+func newFakeKafkaConnectAPI(t *testing.T, tasks ...map[string]any) *fakeKafkaConnectAPI {
+	const servicePath = "/v1/project/my-project/service/my-kafka-connect"
+	const connectorPath = servicePath + "/connectors/my-connector"
+
+	f := &fakeKafkaConnectAPI{state: "RUNNING", tasks: tasks}
+	action := func(r *http.Request, _ []string) (int, any) {
+		action := strings.TrimPrefix(r.URL.Path, connectorPath+"/")
+		switch action {
+		case "pause":
+			f.state = "PAUSED"
+		case "resume":
+			f.state = "RUNNING"
+		}
+		f.actions = append(f.actions, action)
+		return http.StatusOK, map[string]any{}
+	}
+	f.fakeAivenAPI = newFakeAivenAPI(t, fakeRoutes{
+		"GET " + servicePath: fakeResponse(http.StatusOK, map[string]any{"service": map[string]any{"state": "RUNNING"}}),
+		"GET " + servicePath + "/connectors": fakeResponse(http.StatusOK, map[string]any{
+			"connectors": []map[string]any{{"name": "my-connector"}},
+		}),
+		"GET " + connectorPath + "/status": func(*http.Request, []string) (int, any) {
+			return http.StatusOK, map[string]any{
+				"status": map[string]any{"state": f.state, "tasks": f.tasks},
+			}
+		},
+		"POST " + connectorPath + "/*":               action,
+		"POST " + connectorPath + "/tasks/*/restart": action,
+	})
+	return f
+}
+
+func newTestKafkaConnector() *v1alpha1.KafkaConnector {
+	return &v1alpha1.KafkaConnector{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-connector", Namespace: "default"},
+		Spec: v1alpha1.KafkaConnectorSpec{
+			Project:     "my-project",
+			ServiceName: "my-kafka-connect",
+		},
+	}
+}
+
+func TestKafkaConnectorHandlerState(t *testing.T) {
+	api := newFakeKafkaConnectAPI(t)
+	avn := newFakeAivenClient(api)
+	h := KafkaConnectorHandler{ctx: context.Background(), rec: record.NewFakeRecorder(10)}
+	conn := newTestKafkaConnector()
+
+	// Pauses
+	conn.Spec.State = "paused"
+	require.NoError(t, h.applyState(avn, conn))
+	require.NoError(t, h.applyState(avn, conn))
+	assert.Equal(t, []string{"pause"}, api.actions)
+
+	_, err := h.get(avn, conn)
+	require.NoError(t, err)
+	assert.Equal(t, "PAUSED", conn.Status.State)
+	assert.True(t, IsAlreadyRunning(conn))
+
+	// Resumes
+	conn.Spec.State = "running"
+	require.NoError(t, h.applyState(avn, conn))
+	assert.Equal(t, []string{"pause", "resume"}, api.actions)
+
+	// Restarts on request, removes the annotation
+	conn.Annotations = map[string]string{v1alpha1.KafkaConnectorRestartAnnotation: "true"}
+	_, err = h.get(avn, conn)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"pause", "resume", "restart"}, api.actions)
+	assert.NotContains(t, conn.Annotations, v1alpha1.KafkaConnectorRestartAnnotation)
+	assert.NotNil(t, conn.Status.LastRestartTime)
+
+	// Restarts while the config is invalid
+	meta.SetStatusCondition(&conn.Status.Conditions, metav1.Condition{Type: "ConfigValid", Status: metav1.ConditionFalse, Reason: "Invalid"})
+	conn.Annotations = map[string]string{v1alpha1.KafkaConnectorRestartAnnotation: "true"}
+	_, err = h.get(avn, conn)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"pause", "resume", "restart", "restart"}, api.actions)
+	assert.NotContains(t, conn.Annotations, v1alpha1.KafkaConnectorRestartAnnotation)
+
+	// Invalid config is settled, not polled
+	assert.True(t, IsAlreadyRunning(conn))
+	conn.Spec.AutoRestart = &v1alpha1.KafkaConnectorAutoRestart{MaxAttempts: 5}
+	assert.Zero(t, kafkaConnectorRequeueAfter(conn, time.Now()))
+}
+
+func TestKafkaConnectorHandlerAutoRestart(t *testing.T) {
+	api := newFakeKafkaConnectAPI(t,
+		map[string]any{"id": 0, "state": "RUNNING"},
+		map[string]any{"id": 1, "state": "FAILED", "trace": "boom"},
+	)
+	avn := newFakeAivenClient(api)
+	rec := record.NewFakeRecorder(10)
+	h := KafkaConnectorHandler{ctx: context.Background(), rec: rec}
+	conn := newTestKafkaConnector()
+	conn.Spec.AutoRestart = &v1alpha1.KafkaConnectorAutoRestart{
+		MaxAttempts:  2,
+		InitialDelay: metav1.Duration{Duration: time.Minute},
+		MaxDelay:     metav1.Duration{Duration: time.Hour},
+	}
+
+	// Polls tasks while they are running
+	assert.Equal(t, time.Minute, kafkaConnectorRequeueAfter(conn, time.Now()))
+
+	// The first attempt goes right away
+	_, err := h.get(avn, conn)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"tasks/1/restart"}, api.actions)
+	assert.Equal(t, 1, conn.Status.RestartAttempts)
+	assert.Equal(t, "boom", conn.Status.TasksStatus.StackTrace)
+
+	// Waits for the backoff delay
+	_, err = h.get(avn, conn)
+	require.NoError(t, err)
+	assert.Len(t, api.actions, 1)
+	delay, ok := kafkaConnectorRestartDelay(conn, time.Now())
+	assert.True(t, ok)
+	assert.InDelta(t, time.Minute, delay, float64(time.Second))
+	assert.LessOrEqual(t, kafkaConnectorRequeueAfter(conn, time.Now()), time.Minute)
+
+	// The second attempt
+	conn.Status.LastRestartTime = &metav1.Time{Time: time.Now().Add(-time.Minute)}
+	_, err = h.get(avn, conn)
+	require.NoError(t, err)
+	assert.Len(t, api.actions, 2)
+	assert.Equal(t, 2, conn.Status.RestartAttempts)
+
+	// Gives up
+	_, ok = kafkaConnectorRestartDelay(conn, time.Now())
+	assert.False(t, ok)
+	conn.Status.LastRestartTime = &metav1.Time{Time: time.Now().Add(-time.Hour)}
+	_, err = h.get(avn, conn)
+	require.NoError(t, err)
+	assert.Len(t, api.actions, 2)
+	assert.Equal(t, 2, conn.Status.RestartAttempts)
+	assert.True(t, meta.IsStatusConditionFalse(conn.Status.Conditions, "AutoRestart"))
+
+	events := make([]string, 0)
+	for len(rec.Events) > 0 {
+		events = append(events, <-rec.Events)
+	}
+	assert.Equal(t, []string{
+		"Normal FailedTasksRestarted 1 failed tasks are restarted, attempt 1 of 2",
+		"Normal FailedTasksRestarted 1 failed tasks are restarted, attempt 2 of 2",
+		"Warning RestartAttemptsExceeded failed tasks are not restarted anymore, 2 attempts made",
+	}, events)
+
+	// Tasks recovered, resets the counter
+	api.tasks = api.tasks[:1]
+	_, err = h.get(avn, conn)
+	require.NoError(t, err)
+	assert.Zero(t, conn.Status.RestartAttempts)
+	assert.Nil(t, meta.FindStatusCondition(conn.Status.Conditions, "AutoRestart"))
+	assert.Equal(t, time.Minute, kafkaConnectorRequeueAfter(conn, time.Now()))
+
+	// No polling without the policy
+	conn.Spec.AutoRestart = nil
+	assert.Zero(t, kafkaConnectorRequeueAfter(conn, time.Now()))
+}
+
+func TestKafkaConnectorHandlerConfigFrom(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "pg-credentials", Namespace: "default"},
+		Data:       map[string][]byte{"password": []byte("secret-password")},
+	}
+	k8s := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		secret,
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "pg-settings", Namespace: "default"},
+			Data:       map[string]string{"host": "pg.example.com"},
+		},
+		&v1alpha1.ServiceUser{
+			ObjectMeta: metav1.ObjectMeta{Name: "connect-user", Namespace: "default"},
+			Spec: v1alpha1.ServiceUserSpec{
+				ConnInfoSecretTarget: v1alpha1.ConnInfoSecretTarget{Name: "connect-user-secret"},
+			},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "connect-user-secret", Namespace: "default"},
+			Data:       map[string][]byte{"USERNAME": []byte("connect-user")},
+		},
+		&v1alpha1.KafkaTopic{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-topic", Namespace: "default"},
+			Spec:       v1alpha1.KafkaTopicSpec{TopicName: "my.topic"},
+		},
+	).Build()
+
+	avn := newFakeAivenClient(newFakeKafkaConnectAPI(t))
+
+	h := KafkaConnectorHandler{ctx: context.Background(), k8s: k8s, rec: record.NewFakeRecorder(10)}
+	conn := newTestKafkaConnector()
+	conn.UID = "my-uid"
+	conn.Spec.ConnectorClass = "io.aiven.connect.jdbc.JdbcSinkConnector"
+	conn.Spec.UserConfig = map[string]string{"connection.url": `jdbc:postgresql://{{ fromSecret "pg-url" "url" }}`}
+	conn.Spec.ConfigFrom = []v1alpha1.KafkaConnectorConfigFrom{
+		{Key: "connection.password", SecretKeyRef: &v1alpha1.KafkaConnectorKeySelector{Name: "pg-credentials", Key: "password"}},
+		{Key: "connection.host", ConfigMapKeyRef: &v1alpha1.KafkaConnectorKeySelector{Name: "pg-settings", Key: "host"}},
+		{Key: "connection.user", ServiceUserRef: &v1alpha1.KafkaConnectorKeySelector{Name: "connect-user", Key: "USERNAME"}},
+		{Key: "topics", KafkaTopicRef: &v1alpha1.KafkaConnectorObjectReference{Name: "my-topic"}},
+	}
+
+	assert.ElementsMatch(t, []string{
+		"Secret/pg-credentials", "ConfigMap/pg-settings", "ServiceUser/connect-user", "KafkaTopic/my-topic", "Secret/pg-url",
+	}, kafkaConnectorConfigFromIndexFunc(conn))
+	assert.Len(t, conn.GetRefs(), 2)
+
+	// Resolves values, templates are still supported
+	conn.Spec.UserConfig = nil
+	cfg, err := h.buildConnectorConfig(conn)
+	require.NoError(t, err)
+	assert.Equal(t, "secret-password", cfg["connection.password"])
+	assert.Equal(t, "pg.example.com", cfg["connection.host"])
+	assert.Equal(t, "connect-user", cfg["connection.user"])
+	assert.Equal(t, "my.topic", cfg["topics"])
+
+	// The hash is stable and doesn't contain the secret
+	conn.Annotations = map[string]string{processedGenerationAnnotation: "0"}
+	conn.Status.ConfigHash = kafkaConnectorConfigHash(conn, cfg)
+	assert.NotContains(t, conn.Status.ConfigHash, "secret-password")
+	_, err = h.checkPreconditions(avn, conn)
+	require.NoError(t, err)
+	assert.True(t, isAlreadyProcessed(conn))
+
+	// Secret is rotated, the config must be applied again
+	secret.Data["password"] = []byte("new-password")
+	require.NoError(t, k8s.Update(context.Background(), secret))
+	_, err = h.checkPreconditions(avn, conn)
+	require.NoError(t, err)
+	assert.False(t, isAlreadyProcessed(conn))
+
+	// Errors don't contain values
+	conn.Spec.ConfigFrom[0].SecretKeyRef.Key = "missing"
+	_, err = h.buildConnectorConfig(conn)
+	assert.EqualError(t, err, `unable to resolve configFrom for key 'connection.password': no such key in secret 'pg-credentials': 'missing'`)
+}
+
+func TestKafkaConnectorHandlerValidateConfig(t *testing.T) {
+	const servicePath = "/v1/project/my-project/service/my-kafka-connect"
+	avn := newFakeAivenClient(newFakeAivenAPI(t, fakeRoutes{
+		"GET " + servicePath + "/available-connectors": fakeResponse(http.StatusOK, map[string]any{"plugins": []map[string]any{
+			{"class": "io.aiven.connect.jdbc.JdbcSinkConnector", "type": "sink", "version": "6.8.0"},
+			{"class": "io.aiven.connect.jdbc.JdbcSourceConnector", "type": "source", "version": "6.8.0"},
+		}}),
+		"GET " + servicePath + "/connector-plugins/io.aiven.connect.jdbc.JdbcSinkConnector/configuration": fakeResponse(http.StatusOK, map[string]any{"configuration_schema": []map[string]any{
+			{"name": "connection.url", "type": "STRING", "required": true},
+			{"name": "connection.password", "type": "PASSWORD", "required": true},
+			{"name": "batch.size", "type": "INT", "required": true, "default_value": "3000"},
+			{"name": "auto.create", "type": "BOOLEAN"},
+		}}),
+	}))
+
+	h := KafkaConnectorHandler{ctx: context.Background()}
+	conn := newTestKafkaConnector()
+
+	// Unknown class
+	conn.Spec.ConnectorClass = "io.aiven.connect.jdbc.JdbcSink"
+	valid, err := h.validateConfig(avn, conn, aiven.KafkaConnectorConfig{})
+	require.NoError(t, err)
+	assert.False(t, valid)
+	c := meta.FindStatusCondition(conn.Status.Conditions, "ConfigValid")
+	require.NotNil(t, c)
+	assert.Equal(t, `connector.class: "io.aiven.connect.jdbc.JdbcSink" is not available, available: io.aiven.connect.jdbc.JdbcSinkConnector, io.aiven.connect.jdbc.JdbcSourceConnector`, c.Message)
+
+	// Field errors don't contain values
+	conn.Spec.ConnectorClass = "io.aiven.connect.jdbc.JdbcSinkConnector"
+	valid, err = h.validateConfig(avn, conn, aiven.KafkaConnectorConfig{
+		"connection.url": "jdbc:postgresql://pg.example.com",
+		"batch.size":     "secret-value",
+	})
+	require.NoError(t, err)
+	assert.False(t, valid)
+	c = meta.FindStatusCondition(conn.Status.Conditions, "ConfigValid")
+	require.NotNil(t, c)
+	assert.Equal(t, "connection.password: required field is missing; batch.size: must be of type INT", c.Message)
+
+	// Valid
+	valid, err = h.validateConfig(avn, conn, aiven.KafkaConnectorConfig{
+		"connection.url":      "jdbc:postgresql://pg.example.com",
+		"connection.password": "secret",
+		"auto.create":         "true",
+	})
+	require.NoError(t, err)
+	assert.True(t, valid)
+	assert.True(t, meta.IsStatusConditionTrue(conn.Status.Conditions, "ConfigValid"))
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aiven/aiven-go-client"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// KafkaMirrorMakerReconciler reconciles a KafkaMirrorMaker object
+type KafkaMirrorMakerReconciler struct {
+	Controller
+}
+
+// +kubebuilder:rbac:groups=aiven.io,resources=kafkamirrormakers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=aiven.io,resources=kafkamirrormakers/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=aiven.io,resources=kafkamirrormakers/finalizers,verbs=update
+
+func (r *KafkaMirrorMakerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.reconcileService(ctx, req, newKafkaMirrorMakerAdapter, &v1alpha1.KafkaMirrorMaker{})
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *KafkaMirrorMakerReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.KafkaMirrorMaker{}).
+		Complete(r)
+}
+
+func newKafkaMirrorMakerAdapter(_ *aiven.Client, object client.Object) (serviceAdapter, error) {
+	mm, ok := object.(*v1alpha1.KafkaMirrorMaker)
+	if !ok {
+		return nil, fmt.Errorf("object is not of type v1alpha1.KafkaMirrorMaker")
+	}
+	return &kafkaMirrorMakerAdapter{mm}, nil
+}
+
+// kafkaMirrorMakerAdapter handles an Aiven Kafka MirrorMaker 2 service
+type kafkaMirrorMakerAdapter struct {
+	*v1alpha1.KafkaMirrorMaker
+}
+
+func (a *kafkaMirrorMakerAdapter) getObjectMeta() *metav1.ObjectMeta {
+	return &a.ObjectMeta
+}
+
+func (a *kafkaMirrorMakerAdapter) getServiceStatus() *v1alpha1.ServiceStatus {
+	return &a.Status
+}
+
+func (a *kafkaMirrorMakerAdapter) getServiceCommonSpec() *v1alpha1.ServiceCommonSpec {
+	return &a.Spec.ServiceCommonSpec
+}
+
+func (a *kafkaMirrorMakerAdapter) getUserConfig() any {
+	return &a.Spec.UserConfig
+}
+
+// newSecret the service has no connection info, replication flows are managed with KafkaReplicationFlow
+func (a *kafkaMirrorMakerAdapter) newSecret(_ *aiven.Service) (*corev1.Secret, error) {
+	return nil, nil
+}
+
+func (a *kafkaMirrorMakerAdapter) getServiceType() string {
+	return "kafka_mirrormaker"
+}
+
+func (a *kafkaMirrorMakerAdapter) getDiskSpace() string {
+	return ""
+}
+
+func (a *kafkaMirrorMakerAdapter) getAutoscaleDisk() *v1alpha1.AutoscaleDisk {
+	return nil
+}
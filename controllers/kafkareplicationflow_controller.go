@@ -0,0 +1,369 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+const (
+	// kafkaReplicationFlowResyncInterval how often the replication flow is checked
+	kafkaReplicationFlowResyncInterval = 5 * time.Minute
+
+	// kafkaMirrorMakerIntegrationType integration of a Kafka cluster with a MirrorMaker 2 service under an alias
+	kafkaMirrorMakerIntegrationType = "kafka_mirrormaker"
+)
+
+// KafkaReplicationFlowReconciler reconciles a KafkaReplicationFlow object
+type KafkaReplicationFlowReconciler struct {
+	Controller
+}
+
+//+kubebuilder:rbac:groups=aiven.io,resources=kafkareplicationflows,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=aiven.io,resources=kafkareplicationflows/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=aiven.io,resources=kafkareplicationflows/finalizers,verbs=update
+
+func (r *KafkaReplicationFlowReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	result, err := r.reconcileInstance(ctx, req, &kafkaReplicationFlowHandler{k8s: r.Client}, &v1alpha1.KafkaReplicationFlow{})
+
+	// Reverts changes made in the console
+	if err == nil && result.IsZero() {
+		result.RequeueAfter = kafkaReplicationFlowResyncInterval
+	}
+	return result, err
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *KafkaReplicationFlowReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.KafkaReplicationFlow{}).
+		Complete(r)
+}
+
+// kafkaReplicationFlowHandler manages the replication flow and the cluster alias integrations it needs
+type kafkaReplicationFlowHandler struct {
+	k8s client.Client
+}
+
+func (h *kafkaReplicationFlowHandler) createOrUpdate(avn *aiven.Client, obj client.Object, refs []client.Object) error {
+	flow, err := h.convert(obj)
+	if err != nil {
+		return err
+	}
+
+	source := v1alpha1.FindKafka(refs, flow.Spec.SourceKafkaRef.Kafka(flow.Namespace))
+	if source == nil {
+		return fmt.Errorf("source kafka %q is not ready", flow.Spec.SourceKafkaRef.Name)
+	}
+
+	target := v1alpha1.FindKafka(refs, flow.Spec.TargetKafkaRef.Kafka(flow.Namespace))
+	if target == nil {
+		return fmt.Errorf("target kafka %q is not ready", flow.Spec.TargetKafkaRef.Name)
+	}
+
+	flow.Status.SourceIntegrationID, err = h.ensureIntegration(avn, flow, source, flow.GetSourceClusterAlias())
+	if err != nil {
+		return err
+	}
+
+	flow.Status.TargetIntegrationID, err = h.ensureIntegration(avn, flow, target, flow.GetTargetClusterAlias())
+	if err != nil {
+		return err
+	}
+
+	reason := "Updated"
+	req := aiven.MirrorMakerReplicationFlowRequest{ReplicationFlow: h.newReplicationFlow(flow)}
+	_, err = avn.KafkaMirrorMakerReplicationFlow.Get(flow.Spec.Project, flow.Spec.ServiceName, req.SourceCluster, req.TargetCluster)
+	if aiven.IsNotFound(err) {
+		reason = "Created"
+		err = avn.KafkaMirrorMakerReplicationFlow.Create(flow.Spec.Project, flow.Spec.ServiceName, req)
+	} else if err == nil {
+		_, err = avn.KafkaMirrorMakerReplicationFlow.Update(flow.Spec.Project, flow.Spec.ServiceName, req.SourceCluster, req.TargetCluster, req)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	meta.SetStatusCondition(&flow.Status.Conditions,
+		getInitializedCondition(reason,
+			"Instance was created or update on Aiven side"))
+
+	meta.SetStatusCondition(&flow.Status.Conditions,
+		getRunningCondition(metav1.ConditionUnknown, reason,
+			"Instance was created or update on Aiven side, status remains unknown"))
+
+	metav1.SetMetaDataAnnotation(&flow.ObjectMeta,
+		processedGenerationAnnotation, strconv.FormatInt(flow.GetGeneration(), formatIntBaseDecimal))
+
+	return nil
+}
+
+// ensureIntegration returns the integration of the Kafka service with the MirrorMaker service, creates it if not exists.
+// The integration can be shared by several flows, or created by other means
+func (h *kafkaReplicationFlowHandler) ensureIntegration(avn *aiven.Client, flow *v1alpha1.KafkaReplicationFlow, kafka *v1alpha1.Kafka, alias string) (string, error) {
+	list, err := avn.ServiceIntegrations.List(flow.Spec.Project, flow.Spec.ServiceName)
+	if err != nil {
+		return "", err
+	}
+
+	for _, i := range list {
+		if i.IntegrationType != kafkaMirrorMakerIntegrationType || i.SourceService == nil || *i.SourceService != kafka.Name {
+			continue
+		}
+
+		// A cluster is known to MirrorMaker under one alias only
+		a, _ := i.UserConfig["cluster_alias"].(string)
+		if a != alias {
+			return "", fmt.Errorf("kafka %q is already integrated with cluster alias %q", kafka.Name, a)
+		}
+
+		// Keeps it for removal if another flow created it
+		managed, err := h.isManagedIntegration(flow, i.ServiceIntegrationID)
+		if err != nil {
+			return "", err
+		}
+
+		if managed {
+			h.addManagedIntegration(flow, i.ServiceIntegrationID)
+		}
+		return i.ServiceIntegrationID, nil
+	}
+
+	req := aiven.CreateServiceIntegrationRequest{
+		IntegrationType:    kafkaMirrorMakerIntegrationType,
+		SourceService:      &kafka.Name,
+		DestinationService: &flow.Spec.ServiceName,
+		UserConfig:         map[string]interface{}{"cluster_alias": alias},
+	}
+
+	if kafka.Spec.Project != flow.Spec.Project {
+		req.SourceProject = &kafka.Spec.Project
+		req.DestinationProject = &flow.Spec.Project
+	}
+
+	integration, err := avn.ServiceIntegrations.Create(flow.Spec.Project, req)
+	if err != nil {
+		return "", fmt.Errorf("unable to create cluster alias %q integration: %w", alias, err)
+	}
+
+	h.addManagedIntegration(flow, integration.ServiceIntegrationID)
+	return integration.ServiceIntegrationID, nil
+}
+
+func (h *kafkaReplicationFlowHandler) addManagedIntegration(flow *v1alpha1.KafkaReplicationFlow, id string) {
+	for _, v := range flow.Status.ManagedIntegrationIDs {
+		if v == id {
+			return
+		}
+	}
+	flow.Status.ManagedIntegrationIDs = append(flow.Status.ManagedIntegrationIDs, id)
+}
+
+// isManagedIntegration returns true if another flow has created the integration
+func (h *kafkaReplicationFlowHandler) isManagedIntegration(flow *v1alpha1.KafkaReplicationFlow, id string) (bool, error) {
+	flows, err := h.otherFlows(flow)
+	if err != nil {
+		return false, err
+	}
+
+	for _, f := range flows {
+		for _, v := range f.Status.ManagedIntegrationIDs {
+			if v == id {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// otherFlows returns flows of the same MirrorMaker service
+func (h *kafkaReplicationFlowHandler) otherFlows(flow *v1alpha1.KafkaReplicationFlow) ([]v1alpha1.KafkaReplicationFlow, error) {
+	list := &v1alpha1.KafkaReplicationFlowList{}
+	err := h.k8s.List(context.Background(), list)
+	if err != nil {
+		return nil, err
+	}
+
+	flows := make([]v1alpha1.KafkaReplicationFlow, 0)
+	for _, f := range list.Items {
+		if f.UID == flow.UID || f.Spec.Project != flow.Spec.Project || f.Spec.ServiceName != flow.Spec.ServiceName {
+			continue
+		}
+		flows = append(flows, f)
+	}
+	return flows, nil
+}
+
+func (h *kafkaReplicationFlowHandler) newReplicationFlow(flow *v1alpha1.KafkaReplicationFlow) aiven.ReplicationFlow {
+	// The API doesn't accept nulls
+	topics := flow.Spec.Topics
+	if topics == nil {
+		topics = make([]string, 0)
+	}
+
+	blacklist := flow.Spec.TopicsBlacklist
+	if blacklist == nil {
+		blacklist = make([]string, 0)
+	}
+
+	f := aiven.ReplicationFlow{
+		Enabled:                         flow.Spec.Enabled == nil || *flow.Spec.Enabled,
+		SourceCluster:                   flow.GetSourceClusterAlias(),
+		TargetCluster:                   flow.GetTargetClusterAlias(),
+		Topics:                          topics,
+		TopicsBlacklist:                 blacklist,
+		ReplicationPolicyClass:          flow.Spec.ReplicationPolicyClass,
+		SyncGroupOffsetsEnabled:         flow.Spec.SyncGroupOffsetsEnabled,
+		SyncGroupOffsetsIntervalSeconds: flow.Spec.SyncGroupOffsetsIntervalSeconds,
+		EmitHeartbeatsEnabled:           flow.Spec.EmitHeartbeatsEnabled,
+		OffsetSyncsTopicLocation:        flow.Spec.OffsetSyncsTopicLocation,
+	}
+
+	// Defaults for objects created before the webhook
+	if f.ReplicationPolicyClass == "" {
+		f.ReplicationPolicyClass = "org.apache.kafka.connect.mirror.DefaultReplicationPolicy"
+	}
+
+	if f.SyncGroupOffsetsIntervalSeconds == 0 {
+		f.SyncGroupOffsetsIntervalSeconds = 1
+	}
+
+	if f.OffsetSyncsTopicLocation == "" {
+		f.OffsetSyncsTopicLocation = "source"
+	}
+	return f
+}
+
+// flowMatchesSpec compares fields that can be changed in the console
+func (h *kafkaReplicationFlowHandler) flowMatchesSpec(current, desired aiven.ReplicationFlow) bool {
+	return current.Enabled == desired.Enabled &&
+		equalStringSets(current.Topics, desired.Topics) &&
+		equalStringSets(current.TopicsBlacklist, desired.TopicsBlacklist) &&
+		current.ReplicationPolicyClass == desired.ReplicationPolicyClass &&
+		current.SyncGroupOffsetsEnabled == desired.SyncGroupOffsetsEnabled &&
+		current.SyncGroupOffsetsIntervalSeconds == desired.SyncGroupOffsetsIntervalSeconds &&
+		current.EmitHeartbeatsEnabled == desired.EmitHeartbeatsEnabled
+}
+
+// equalStringSets returns true if the lists have the same items, the API may reorder topic patterns
+func equalStringSets(a, b []string) bool {
+	set := make(map[string]bool, len(a))
+	for _, v := range a {
+		set[v] = true
+	}
+
+	other := make(map[string]bool, len(b))
+	for _, v := range b {
+		if !set[v] {
+			return false
+		}
+		other[v] = true
+	}
+	return len(set) == len(other)
+}
+
+func (h *kafkaReplicationFlowHandler) delete(avn *aiven.Client, obj client.Object) (bool, error) {
+	flow, err := h.convert(obj)
+	if err != nil {
+		return false, err
+	}
+
+	err = avn.KafkaMirrorMakerReplicationFlow.Delete(flow.Spec.Project, flow.Spec.ServiceName, flow.GetSourceClusterAlias(), flow.GetTargetClusterAlias())
+	if err != nil && !aiven.IsNotFound(err) {
+		return false, fmt.Errorf("aiven client delete replication flow error: %w", err)
+	}
+
+	// Removes integrations no other flow uses
+	flows, err := h.otherFlows(flow)
+	if err != nil {
+		return false, err
+	}
+
+	used := make(map[string]bool)
+	for _, f := range flows {
+		if f.DeletionTimestamp.IsZero() {
+			used[f.Status.SourceIntegrationID] = true
+			used[f.Status.TargetIntegrationID] = true
+		}
+	}
+
+	for _, id := range flow.Status.ManagedIntegrationIDs {
+		if used[id] {
+			continue
+		}
+
+		err = avn.ServiceIntegrations.Delete(flow.Spec.Project, id)
+		if err != nil && !aiven.IsNotFound(err) {
+			return false, fmt.Errorf("unable to delete integration %q: %w", id, err)
+		}
+	}
+
+	return true, nil
+}
+
+func (h *kafkaReplicationFlowHandler) get(avn *aiven.Client, obj client.Object) (*corev1.Secret, error) {
+	flow, err := h.convert(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	desired := h.newReplicationFlow(flow)
+	r, err := avn.KafkaMirrorMakerReplicationFlow.Get(flow.Spec.Project, flow.Spec.ServiceName, desired.SourceCluster, desired.TargetCluster)
+	if aiven.IsNotFound(err) {
+		// Deleted out-of-band, creates it again
+		delete(flow.Annotations, processedGenerationAnnotation)
+		delete(flow.Annotations, instanceIsRunningAnnotation)
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !h.flowMatchesSpec(r.ReplicationFlow, desired) {
+		// Changed out-of-band, applies the spec again.
+		// The flow keeps replicating meanwhile, so it stays running
+		delete(flow.Annotations, processedGenerationAnnotation)
+	}
+
+	meta.SetStatusCondition(&flow.Status.Conditions,
+		getRunningCondition(metav1.ConditionTrue, "CheckRunning",
+			"Instance is running on Aiven side"))
+
+	metav1.SetMetaDataAnnotation(&flow.ObjectMeta, instanceIsRunningAnnotation, "true")
+	return nil, nil
+}
+
+func (h *kafkaReplicationFlowHandler) checkPreconditions(avn *aiven.Client, obj client.Object) (bool, error) {
+	flow, err := h.convert(obj)
+	if err != nil {
+		return false, err
+	}
+
+	meta.SetStatusCondition(&flow.Status.Conditions,
+		getInitializedCondition("Preconditions", "Checking preconditions"))
+
+	return checkServiceIsRunning(avn, flow.Spec.Project, flow.Spec.ServiceName)
+}
+
+func (h *kafkaReplicationFlowHandler) convert(i client.Object) (*v1alpha1.KafkaReplicationFlow, error) {
+	flow, ok := i.(*v1alpha1.KafkaReplicationFlow)
+	if !ok {
+		return nil, fmt.Errorf("cannot convert object to KafkaReplicationFlow")
+	}
+
+	return flow, nil
+}
@@ -0,0 +1,202 @@
+package controllers
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// fakeMirrorMakerAPI serves "my-mm" integrations and replication flows
+type fakeMirrorMakerAPI struct {
+	*fakeAivenAPI
+	integrations []*aiven.ServiceIntegration
+	flows        map[string]aiven.ReplicationFlow
+}
+
+func newFakeMirrorMakerAPI(t *testing.T) *fakeMirrorMakerAPI {
+	const (
+		integrationsPath = "/v1/project/my-project/integration"
+		flowsPath        = "/v1/project/my-project/service/my-mm/mirrormaker/replication-flows"
+	)
+
+	f := &fakeMirrorMakerAPI{flows: map[string]aiven.ReplicationFlow{}}
+	f.fakeAivenAPI = newFakeAivenAPI(t, fakeRoutes{
+		"GET /v1/project/my-project/service/my-mm/integration": func(*http.Request, []string) (int, any) {
+			return http.StatusOK, map[string]any{"service_integrations": f.integrations}
+		},
+		"POST " + integrationsPath: func(r *http.Request, _ []string) (int, any) {
+			i := new(aiven.ServiceIntegration)
+			f.decode(r, i)
+			i.ServiceIntegrationID = *i.SourceService + "-integration"
+			f.integrations = append(f.integrations, i)
+			f.call("create integration %s", i.ServiceIntegrationID)
+			return http.StatusOK, map[string]any{"service_integration": i}
+		},
+		"DELETE " + integrationsPath + "/*": func(_ *http.Request, params []string) (int, any) {
+			f.call("delete integration %s", params[0])
+			return http.StatusOK, map[string]any{}
+		},
+		"POST " + flowsPath: func(r *http.Request, _ []string) (int, any) {
+			flow := aiven.ReplicationFlow{}
+			f.decode(r, &flow)
+			key := flow.SourceCluster + "/" + flow.TargetCluster
+			f.flows[key] = flow
+			f.call("create flow %s", key)
+			return http.StatusOK, map[string]any{}
+		},
+		"GET " + flowsPath + "/*/*": f.flow(func(_ *http.Request, key string, flow aiven.ReplicationFlow) (int, any) {
+			return http.StatusOK, map[string]any{"replication_flow": flow}
+		}),
+		"PUT " + flowsPath + "/*/*": f.flow(func(r *http.Request, key string, flow aiven.ReplicationFlow) (int, any) {
+			f.decode(r, &flow)
+			f.flows[key] = flow
+			f.call("update flow %s", key)
+			return http.StatusOK, map[string]any{"replication_flow": flow}
+		}),
+		"DELETE " + flowsPath + "/*/*": f.flow(func(_ *http.Request, key string, flow aiven.ReplicationFlow) (int, any) {
+			delete(f.flows, key)
+			f.call("delete flow %s", key)
+			return http.StatusOK, map[string]any{"replication_flow": flow}
+		}),
+	})
+	f.strict = true
+	return f
+}
+
+// flow passes the "source/target" key and the flow to the handler, returns 404 for unknown flows
+func (f *fakeMirrorMakerAPI) flow(h func(r *http.Request, key string, flow aiven.ReplicationFlow) (int, any)) fakeHandler {
+	return func(r *http.Request, params []string) (int, any) {
+		key := strings.Join(params, "/")
+		flow, ok := f.flows[key]
+		if !ok {
+			return fakeNotFound(r, params)
+		}
+		return h(r, key, flow)
+	}
+}
+
+func newTestReplicationFlow(name, source, target string) *v1alpha1.KafkaReplicationFlow {
+	return &v1alpha1.KafkaReplicationFlow{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", UID: types.UID("uid-" + name), Generation: 1},
+		Spec: v1alpha1.KafkaReplicationFlowSpec{
+			Project:        "my-project",
+			ServiceName:    "my-mm",
+			SourceKafkaRef: v1alpha1.ResourceReference{Name: source},
+			TargetKafkaRef: v1alpha1.ResourceReference{Name: target},
+			Topics:         []string{"orders.*"},
+		},
+	}
+}
+
+func TestKafkaReplicationFlowHandler(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	api := newFakeMirrorMakerAPI(t)
+	avn := newFakeAivenClient(api)
+	refs := []client.Object{
+		&v1alpha1.Kafka{ObjectMeta: metav1.ObjectMeta{Name: "primary", Namespace: "default"}, Spec: v1alpha1.KafkaSpec{ServiceCommonSpec: v1alpha1.ServiceCommonSpec{Project: "my-project"}}},
+		&v1alpha1.Kafka{ObjectMeta: metav1.ObjectMeta{Name: "backup", Namespace: "default"}, Spec: v1alpha1.KafkaSpec{ServiceCommonSpec: v1alpha1.ServiceCommonSpec{Project: "my-project"}}},
+	}
+
+	// The first flow creates the cluster alias integrations
+	forward := newTestReplicationFlow("forward", "primary", "backup")
+	h := &kafkaReplicationFlowHandler{k8s: fake.NewClientBuilder().WithScheme(scheme).Build()}
+	require.NoError(t, h.createOrUpdate(avn, forward, refs))
+	assert.Equal(t, "primary-integration", forward.Status.SourceIntegrationID)
+	assert.Equal(t, "backup-integration", forward.Status.TargetIntegrationID)
+	assert.Equal(t, []string{"primary-integration", "backup-integration"}, forward.Status.ManagedIntegrationIDs)
+	assert.Equal(t, "org.apache.kafka.connect.mirror.DefaultReplicationPolicy", api.flows["primary/backup"].ReplicationPolicyClass)
+	assert.Equal(t, map[string]any{"cluster_alias": "primary"}, api.integrations[0].UserConfig)
+
+	_, err := h.get(avn, forward)
+	require.NoError(t, err)
+	assert.True(t, IsAlreadyRunning(forward))
+
+	// Changed out-of-band
+	flow := api.flows["primary/backup"]
+	flow.Topics = []string{".*"}
+	api.flows["primary/backup"] = flow
+	_, err = h.get(avn, forward)
+	require.NoError(t, err)
+	assert.False(t, isAlreadyProcessed(forward))
+	assert.True(t, IsAlreadyRunning(forward))
+	require.NoError(t, h.createOrUpdate(avn, forward, refs))
+	assert.Equal(t, []string{"orders.*"}, api.flows["primary/backup"].Topics)
+
+	// The order of topics doesn't matter
+	forward.Spec.Topics = []string{"orders.*", "payments.*"}
+	require.NoError(t, h.createOrUpdate(avn, forward, refs))
+	flow = api.flows["primary/backup"]
+	flow.Topics = []string{"payments.*", "orders.*"}
+	api.flows["primary/backup"] = flow
+	_, err = h.get(avn, forward)
+	require.NoError(t, err)
+	assert.True(t, isAlreadyProcessed(forward))
+
+	// The second flow shares the integrations
+	backward := newTestReplicationFlow("backward", "backup", "primary")
+	h = &kafkaReplicationFlowHandler{k8s: fake.NewClientBuilder().WithScheme(scheme).WithObjects(forward).Build()}
+	require.NoError(t, h.createOrUpdate(avn, backward, refs))
+	assert.Equal(t, "backup-integration", backward.Status.SourceIntegrationID)
+	assert.ElementsMatch(t, forward.Status.ManagedIntegrationIDs, backward.Status.ManagedIntegrationIDs)
+
+	// Integrations are kept while another flow uses them
+	h = &kafkaReplicationFlowHandler{k8s: fake.NewClientBuilder().WithScheme(scheme).WithObjects(backward).Build()}
+	deleted, err := h.delete(avn, forward)
+	require.NoError(t, err)
+	assert.True(t, deleted)
+
+	h = &kafkaReplicationFlowHandler{k8s: fake.NewClientBuilder().WithScheme(scheme).Build()}
+	deleted, err = h.delete(avn, backward)
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	assert.Equal(t, []string{
+		"create integration primary-integration",
+		"create integration backup-integration",
+		"create flow primary/backup",
+		"update flow primary/backup",
+		"update flow primary/backup",
+		"create flow backup/primary",
+		"delete flow primary/backup",
+		"delete flow backup/primary",
+		"delete integration backup-integration",
+		"delete integration primary-integration",
+	}, api.calls)
+}
+
+func TestKafkaReplicationFlowHandlerAliasConflict(t *testing.T) {
+	source := "primary"
+	api := newFakeMirrorMakerAPI(t)
+	api.integrations = []*aiven.ServiceIntegration{{
+		ServiceIntegrationID: "existing",
+		IntegrationType:      "kafka_mirrormaker",
+		SourceService:        &source,
+		UserConfig:           map[string]any{"cluster_alias": "main"},
+	}}
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+	h := &kafkaReplicationFlowHandler{k8s: fake.NewClientBuilder().WithScheme(scheme).Build()}
+	refs := []client.Object{
+		&v1alpha1.Kafka{ObjectMeta: metav1.ObjectMeta{Name: "primary", Namespace: "default"}},
+		&v1alpha1.Kafka{ObjectMeta: metav1.ObjectMeta{Name: "backup", Namespace: "default"}},
+	}
+
+	err := h.createOrUpdate(newFakeAivenClient(api), newTestReplicationFlow("forward", "primary", "backup"), refs)
+	assert.EqualError(t, err, `kafka "primary" is already integrated with cluster alias "main"`)
+	assert.Empty(t, api.calls)
+}
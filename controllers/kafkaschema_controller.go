@@ -4,31 +4,49 @@ package controllers
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/aiven/aiven-go-client"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/aiven/aiven-operator/api/v1alpha1"
 )
 
+const (
+	// conditionTypeCompatible shows whether the registry accepted the schema under the subject's compatibility level
+	conditionTypeCompatible = "Compatible"
+
+	eventKafkaSchemaIncompatible      = "Incompatible"
+	eventKafkaSchemaVersionReferenced = "VersionReferenced"
+)
+
 // KafkaSchemaReconciler reconciles a KafkaSchema object
 type KafkaSchemaReconciler struct {
 	Controller
 }
 
-type KafkaSchemaHandler struct{}
+type KafkaSchemaHandler struct {
+	ctx context.Context
+	rec record.EventRecorder
+}
 
 // +kubebuilder:rbac:groups=aiven.io,resources=kafkaschemas,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=aiven.io,resources=kafkaschemas/status,verbs=get;update;patch
 
 func (r *KafkaSchemaReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	return r.reconcileInstance(ctx, req, KafkaSchemaHandler{}, &v1alpha1.KafkaSchema{})
+	return r.reconcileInstance(ctx, req, KafkaSchemaHandler{ctx: ctx, rec: r.Recorder}, &v1alpha1.KafkaSchema{})
 }
 
 func (r *KafkaSchemaReconciler) SetupWithManager(mgr ctrl.Manager) error {
@@ -43,19 +61,62 @@ func (h KafkaSchemaHandler) createOrUpdate(avn *aiven.Client, i client.Object, r
 		return err
 	}
 
-	// createOrUpdate Kafka Schema Subject
-	_, err = avn.KafkaSubjectSchemas.Add(
-		schema.Spec.Project,
-		schema.Spec.ServiceName,
-		schema.Spec.SubjectName,
-		aiven.KafkaSchemaSubject{
-			Schema: schema.Spec.Schema,
+	references, err := h.getReferences(avn, schema, refs)
+	if err != nil {
+		return err
+	}
+
+	subject := kafkaSchemaSubject{
+		KafkaSchemaSubject: aiven.KafkaSchemaSubject{
+			Schema:     schema.Spec.Schema,
+			SchemaType: schema.Spec.SchemaType,
 		},
+		References: references,
+	}
+
+	// createOrUpdate Kafka Schema Subject
+	// aiven.KafkaSchemaSubject doesn't support references, so the endpoint is called directly.
+	// The registry checks compatibility against the versions the subject's compatibility level requires
+	added := new(aiven.KafkaSchemaSubjectResponse)
+	err = doAivenRequest(
+		h.ctx,
+		avn,
+		http.MethodPost,
+		kafkaSchemaSubjectPath(schema.Spec.Project, schema.Spec.ServiceName, schema.Spec.SubjectName)+"/versions",
+		subject,
+		added,
 	)
+
+	var e aiven.Error
+	if errors.As(err, &e) && e.Status == http.StatusConflict {
+		// The previous version remains, waits for the spec change
+		message := aivenErrorMessage(e)
+		meta.SetStatusCondition(&schema.Status.Conditions, metav1.Condition{
+			Type:    conditionTypeCompatible,
+			Status:  metav1.ConditionFalse,
+			Reason:  "Incompatible",
+			Message: message,
+		})
+
+		h.rec.Event(schema, corev1.EventTypeWarning, eventKafkaSchemaIncompatible, message)
+
+		metav1.SetMetaDataAnnotation(&schema.ObjectMeta,
+			processedGenerationAnnotation, strconv.FormatInt(schema.GetGeneration(), formatIntBaseDecimal))
+
+		return nil
+	}
+
 	if err != nil {
 		return fmt.Errorf("cannot add Kafka Schema Subject: %w", err)
 	}
 
+	meta.SetStatusCondition(&schema.Status.Conditions, metav1.Condition{
+		Type:    conditionTypeCompatible,
+		Status:  metav1.ConditionTrue,
+		Reason:  "Compatible",
+		Message: "Schema is accepted by the registry",
+	})
+
 	// set compatibility level if defined for a newly created Kafka Schema Subject
 	if schema.Spec.CompatibilityLevel != "" {
 		_, err := avn.KafkaSubjectSchemas.UpdateConfiguration(
@@ -69,13 +130,28 @@ func (h KafkaSchemaHandler) createOrUpdate(avn *aiven.Client, i client.Object, r
 		}
 	}
 
-	// get last version
-	version, err := h.getLastVersion(avn, schema)
+	versions, err := h.getVersions(avn, schema)
 	if err != nil {
-		return fmt.Errorf("cannot get Kafka Schema Subject version: %w", err)
+		return fmt.Errorf("cannot get Kafka Schema Subject versions: %w", err)
 	}
 
-	schema.Status.Version = version
+	// The registry doesn't create a new version if the schema is already registered,
+	// so the matching version is not necessarily the latest one
+	schema.Status.ID = added.Id
+	schema.Status.Version = 0
+	for _, v := range versions {
+		if v.ID == added.Id && v.Version > schema.Status.Version {
+			schema.Status.Version = v.Version
+		}
+	}
+
+	if schema.Spec.DeleteSupersededVersions != "" {
+		versions, err = h.deleteSupersededVersions(avn, schema, versions)
+		if err != nil {
+			return fmt.Errorf("cannot delete superseded Kafka Schema Subject versions: %w", err)
+		}
+	}
+	schema.Status.Versions = versions
 
 	meta.SetStatusCondition(&schema.Status.Conditions,
 		getInitializedCondition("Added",
@@ -111,6 +187,8 @@ func (h KafkaSchemaHandler) get(_ *aiven.Client, i client.Object) (*corev1.Secre
 		return nil, err
 	}
 
+	// An incompatible spec leaves the previous version registered, so the subject is running either way.
+	// The Compatible condition tells whether the spec is applied
 	meta.SetStatusCondition(&schema.Status.Conditions,
 		getRunningCondition(metav1.ConditionTrue, "CheckRunning",
 			"Instance is running on Aiven side"))
@@ -138,11 +216,126 @@ func (h KafkaSchemaHandler) convert(i client.Object) (*v1alpha1.KafkaSchema, err
 	return schema, nil
 }
 
-func (h KafkaSchemaHandler) getLastVersion(avn *aiven.Client, schema *v1alpha1.KafkaSchema) (int, error) {
-	ver, err := avn.KafkaSubjectSchemas.GetVersions(
-		schema.Spec.Project,
-		schema.Spec.ServiceName,
-		schema.Spec.SubjectName)
+// getVersions returns subject versions with schema IDs.
+// Versions are immutable, so only the ones missing in the status are fetched
+func (h KafkaSchemaHandler) getVersions(avn *aiven.Client, schema *v1alpha1.KafkaSchema) ([]v1alpha1.KafkaSchemaVersion, error) {
+	list, err := avn.KafkaSubjectSchemas.GetVersions(schema.Spec.Project, schema.Spec.ServiceName, schema.Spec.SubjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	known := make(map[int]v1alpha1.KafkaSchemaVersion, len(schema.Status.Versions))
+	for _, v := range schema.Status.Versions {
+		known[v.Version] = v
+	}
+
+	sort.Ints(list.Versions)
+	versions := make([]v1alpha1.KafkaSchemaVersion, 0, len(list.Versions))
+	for _, v := range list.Versions {
+		if k, ok := known[v]; ok {
+			versions = append(versions, k)
+			continue
+		}
+
+		r, err := avn.KafkaSubjectSchemas.Get(schema.Spec.Project, schema.Spec.ServiceName, schema.Spec.SubjectName, v)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, v1alpha1.KafkaSchemaVersion{Version: v, ID: r.Version.Id})
+	}
+	return versions, nil
+}
+
+// deleteSupersededVersions deletes versions older than the matching one, returns the rest.
+// Versions referenced by other subjects can't be deleted, they are kept and reported
+func (h KafkaSchemaHandler) deleteSupersededVersions(avn *aiven.Client, schema *v1alpha1.KafkaSchema, versions []v1alpha1.KafkaSchemaVersion) ([]v1alpha1.KafkaSchemaVersion, error) {
+	result := make([]v1alpha1.KafkaSchemaVersion, 0, len(versions))
+	referenced := make([]string, 0)
+	for _, v := range versions {
+		if v.Version >= schema.Status.Version {
+			result = append(result, v)
+			continue
+		}
+
+		err := avn.KafkaSubjectSchemas.Delete(schema.Spec.Project, schema.Spec.ServiceName, schema.Spec.SubjectName, v.Version)
+		if isKafkaSchemaReferencedError(err) {
+			v.Referenced = true
+			result = append(result, v)
+			referenced = append(referenced, strconv.Itoa(v.Version))
+			continue
+		}
+
+		if err != nil && !aiven.IsNotFound(err) {
+			return nil, err
+		}
+
+		// Hard deletion is possible for soft deleted versions only.
+		// aiven.KafkaSubjectSchemasHandler doesn't support it, so the endpoint is called directly
+		if schema.Spec.DeleteSupersededVersions == "hard" {
+			path := fmt.Sprintf("%s/versions/%d?permanent=true", kafkaSchemaSubjectPath(schema.Spec.Project, schema.Spec.ServiceName, schema.Spec.SubjectName), v.Version)
+			err = doAivenRequest(h.ctx, avn, http.MethodDelete, path, nil, nil)
+			if err != nil && !aiven.IsNotFound(err) {
+				return nil, err
+			}
+		}
+	}
+
+	if len(referenced) > 0 {
+		h.rec.Eventf(schema, corev1.EventTypeWarning, eventKafkaSchemaVersionReferenced,
+			"superseded versions %s are referenced by other subjects and are kept", strings.Join(referenced, ", "))
+	}
+	return result, nil
+}
+
+// isKafkaSchemaReferencedError the registry refuses to delete a version that other subjects reference
+func isKafkaSchemaReferencedError(err error) bool {
+	var e aiven.Error
+	return errors.As(err, &e) && e.Status == http.StatusUnprocessableEntity
+}
+
+// getReferences resolves references to subject versions
+func (h KafkaSchemaHandler) getReferences(avn *aiven.Client, schema *v1alpha1.KafkaSchema, refs []client.Object) ([]kafkaSchemaReference, error) {
+	result := make([]kafkaSchemaReference, 0, len(schema.Spec.References))
+	for _, r := range schema.Spec.References {
+		ref := kafkaSchemaReference{Name: r.Name, Subject: r.Subject, Version: r.Version}
+		if r.SchemaRef != nil {
+			s := findKafkaSchema(refs, r.SchemaRef.KafkaSchema(schema.Namespace).NamespacedName)
+			if s == nil {
+				return nil, fmt.Errorf("referenced KafkaSchema %q not found", r.SchemaRef.Name)
+			}
+
+			if s.Spec.Project != schema.Spec.Project || s.Spec.ServiceName != schema.Spec.ServiceName {
+				return nil, fmt.Errorf("referenced KafkaSchema %q must belong to the same service", r.SchemaRef.Name)
+			}
+
+			ref.Subject = s.Spec.SubjectName
+			ref.Version = s.Status.Version
+		}
+
+		if ref.Version == 0 {
+			v, err := getKafkaSchemaLastVersion(avn, schema.Spec.Project, schema.Spec.ServiceName, ref.Subject)
+			if err != nil {
+				return nil, fmt.Errorf("cannot get referenced subject %q version: %w", ref.Subject, err)
+			}
+			ref.Version = v
+		}
+		result = append(result, ref)
+	}
+	return result, nil
+}
+
+func findKafkaSchema(refs []client.Object, name types.NamespacedName) *v1alpha1.KafkaSchema {
+	for _, o := range refs {
+		s, ok := o.(*v1alpha1.KafkaSchema)
+		if ok && s.Name == name.Name && s.Namespace == name.Namespace {
+			return s
+		}
+	}
+	return nil
+}
+
+func getKafkaSchemaLastVersion(avn *aiven.Client, project, serviceName, subjectName string) (int, error) {
+	ver, err := avn.KafkaSubjectSchemas.GetVersions(project, serviceName, subjectName)
 	if err != nil {
 		return 0, err
 	}
@@ -156,3 +349,22 @@ func (h KafkaSchemaHandler) getLastVersion(avn *aiven.Client, schema *v1alpha1.K
 
 	return latestVersion, nil
 }
+
+func kafkaSchemaSubjectPath(project, serviceName, subjectName string) string {
+	return fmt.Sprintf(
+		"/project/%s/service/%s/kafka/schema/subjects/%s",
+		url.PathEscape(project), url.PathEscape(serviceName), url.PathEscape(subjectName),
+	)
+}
+
+// kafkaSchemaSubject extends aiven.KafkaSchemaSubject with references
+type kafkaSchemaSubject struct {
+	aiven.KafkaSchemaSubject
+	References []kafkaSchemaReference `json:"references,omitempty"`
+}
+
+type kafkaSchemaReference struct {
+	Name    string `json:"name"`
+	Subject string `json:"subject"`
+	Version int    `json:"version"`
+}
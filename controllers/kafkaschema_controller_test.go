@@ -0,0 +1,184 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestKafkaSchemaHandlerReferences(t *testing.T) {
+	const subjectsPath = "/v1/project/my-project/service/my-kafka/kafka/schema/subjects/"
+
+	var added *kafkaSchemaSubject
+	var api *fakeAivenAPI
+	api = newFakeAivenAPI(t, fakeRoutes{
+		"GET " + subjectsPath + "Customer/versions":   fakeResponse(http.StatusOK, map[string]any{"versions": []int{1}}),
+		"GET " + subjectsPath + "Customer/versions/1": fakeResponse(http.StatusOK, map[string]any{"version": map[string]any{"id": 10, "version": 1}}),
+		"GET " + subjectsPath + "Common/versions":     fakeResponse(http.StatusOK, map[string]any{"versions": []int{1, 4, 2}}),
+		"POST " + subjectsPath + "Customer/versions": func(r *http.Request, _ []string) (int, any) {
+			added = new(kafkaSchemaSubject)
+			api.decode(r, added)
+			return http.StatusOK, map[string]any{"id": 10}
+		},
+	})
+	avn := newFakeAivenClient(api)
+
+	address := &v1alpha1.KafkaSchema{
+		ObjectMeta: metav1.ObjectMeta{Name: "address", Namespace: "default"},
+		Spec: v1alpha1.KafkaSchemaSpec{
+			Project:     "my-project",
+			ServiceName: "my-kafka",
+			SubjectName: "Address",
+		},
+		Status: v1alpha1.KafkaSchemaStatus{Version: 3},
+	}
+
+	schema := &v1alpha1.KafkaSchema{
+		ObjectMeta: metav1.ObjectMeta{Name: "customer", Namespace: "default"},
+		Spec: v1alpha1.KafkaSchemaSpec{
+			Project:     "my-project",
+			ServiceName: "my-kafka",
+			SubjectName: "Customer",
+			SchemaType:  "PROTOBUF",
+			Schema:      `syntax = "proto3";`,
+			References: []v1alpha1.KafkaSchemaReference{
+				{Name: "address.proto", SchemaRef: &v1alpha1.ResourceReference{Name: "address"}},
+				{Name: "common.proto", Subject: "Common"},
+				{Name: "other.proto", Subject: "Other", Version: 2},
+			},
+		},
+	}
+
+	assert.Len(t, schema.GetRefs(), 1)
+	require.NoError(t, KafkaSchemaHandler{ctx: context.Background(), rec: record.NewFakeRecorder(10)}.createOrUpdate(avn, schema, []client.Object{address}))
+	require.NotNil(t, added)
+	assert.Equal(t, "PROTOBUF", added.SchemaType)
+	assert.Equal(t, []kafkaSchemaReference{
+		{Name: "address.proto", Subject: "Address", Version: 3},
+		{Name: "common.proto", Subject: "Common", Version: 4},
+		{Name: "other.proto", Subject: "Other", Version: 2},
+	}, added.References)
+	assert.Equal(t, 1, schema.Status.Version)
+	assert.Equal(t, 10, schema.Status.ID)
+
+	// Referenced schema must be in the same service
+	address.Spec.ServiceName = "other-kafka"
+	err := KafkaSchemaHandler{ctx: context.Background(), rec: record.NewFakeRecorder(10)}.createOrUpdate(avn, schema, []client.Object{address})
+	assert.EqualError(t, err, `referenced KafkaSchema "address" must belong to the same service`)
+}
+
+// fakeSchemaRegistry keeps versions of a single subject
+type fakeSchemaRegistry struct {
+	*fakeAivenAPI
+	compatible bool
+	versions   map[int]int // version: schema id
+	referenced map[int]bool
+	deleted    []string
+	gets       int
+}
+
+func newFakeSchemaRegistry(t *testing.T, versions map[int]int, referenced map[int]bool) *fakeSchemaRegistry {
+	const subjectPath = "/v1/project/my-project/service/my-kafka/kafka/schema/subjects/MySchema"
+
+	f := &fakeSchemaRegistry{versions: versions, referenced: referenced}
+	f.fakeAivenAPI = newFakeAivenAPI(t, fakeRoutes{
+		"GET " + subjectPath + "/versions": func(*http.Request, []string) (int, any) {
+			versions := make([]int, 0)
+			for v := range f.versions {
+				versions = append(versions, v)
+			}
+			return http.StatusOK, map[string]any{"versions": versions}
+		},
+		"GET " + subjectPath + "/versions/*": func(_ *http.Request, params []string) (int, any) {
+			v, err := strconv.Atoi(params[0])
+			require.NoError(f.t, err)
+			f.gets++
+			return http.StatusOK, map[string]any{"version": map[string]any{"id": f.versions[v], "version": v}}
+		},
+		"POST " + subjectPath + "/versions": func(*http.Request, []string) (int, any) {
+			if !f.compatible {
+				return http.StatusConflict, map[string]any{"message": "reader field 'foo' has no default value"}
+			}
+			v := len(f.versions) + 1
+			f.versions[v] = 100 + v
+			return http.StatusOK, map[string]any{"id": f.versions[v]}
+		},
+		"DELETE " + subjectPath + "/versions/*": func(r *http.Request, params []string) (int, any) {
+			v, err := strconv.Atoi(params[0])
+			require.NoError(f.t, err)
+			if f.referenced[v] {
+				return http.StatusUnprocessableEntity, map[string]any{"message": "One or more references exist to the schema"}
+			}
+			if r.URL.Query().Get("permanent") == "true" {
+				delete(f.versions, v)
+			}
+			f.deleted = append(f.deleted, params[0]+"?"+r.URL.Query().Get("permanent"))
+			return http.StatusOK, map[string]any{}
+		},
+	})
+	return f
+}
+
+func TestKafkaSchemaHandlerCompatibility(t *testing.T) {
+	registry := newFakeSchemaRegistry(t, map[int]int{1: 101, 2: 102}, map[int]bool{1: true})
+	avn := newFakeAivenClient(registry)
+	rec := record.NewFakeRecorder(10)
+	h := KafkaSchemaHandler{ctx: context.Background(), rec: rec}
+	schema := &v1alpha1.KafkaSchema{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-schema", Namespace: "default", Generation: 1},
+		Spec: v1alpha1.KafkaSchemaSpec{
+			Project:                  "my-project",
+			ServiceName:              "my-kafka",
+			SubjectName:              "MySchema",
+			Schema:                   `{"type": "string"}`,
+			DeleteSupersededVersions: "hard",
+		},
+	}
+
+	// Incompatible, reported in the condition and events, the previous version keeps running
+	require.NoError(t, h.createOrUpdate(avn, schema, nil))
+	assert.True(t, isAlreadyProcessed(schema))
+	c := meta.FindStatusCondition(schema.Status.Conditions, conditionTypeCompatible)
+	require.NotNil(t, c)
+	assert.Equal(t, metav1.ConditionFalse, c.Status)
+	assert.Equal(t, "reader field 'foo' has no default value", c.Message)
+	assert.Len(t, registry.versions, 2)
+	assert.Equal(t, "Warning Incompatible reader field 'foo' has no default value", <-rec.Events)
+
+	_, err := h.get(avn, schema)
+	require.NoError(t, err)
+	assert.True(t, IsAlreadyRunning(schema))
+	assert.True(t, meta.IsStatusConditionFalse(schema.Status.Conditions, conditionTypeCompatible))
+
+	// Compatible, deletes superseded versions, keeps referenced ones
+	registry.compatible = true
+	require.NoError(t, h.createOrUpdate(avn, schema, nil))
+	c = meta.FindStatusCondition(schema.Status.Conditions, conditionTypeCompatible)
+	require.NotNil(t, c)
+	assert.Equal(t, metav1.ConditionTrue, c.Status)
+	assert.Equal(t, 3, schema.Status.Version)
+	assert.Equal(t, 103, schema.Status.ID)
+	assert.Equal(t, []v1alpha1.KafkaSchemaVersion{{Version: 1, ID: 101, Referenced: true}, {Version: 3, ID: 103}}, schema.Status.Versions)
+	assert.Equal(t, []string{"2?", "2?true"}, registry.deleted)
+	assert.Equal(t, "Warning VersionReferenced superseded versions 1 are referenced by other subjects and are kept", <-rec.Events)
+
+	_, err = h.get(avn, schema)
+	require.NoError(t, err)
+	assert.True(t, IsAlreadyRunning(schema))
+
+	// Known versions are not fetched again
+	gets := registry.gets
+	schema.Generation = 2
+	require.NoError(t, h.createOrUpdate(avn, schema, nil))
+	assert.Equal(t, gets, registry.gets)
+}
@@ -25,7 +25,7 @@ type MySQLReconciler struct {
 //+kubebuilder:rbac:groups=aiven.io,resources=mysqls/finalizers,verbs=update
 
 func (r *MySQLReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	return r.reconcileInstance(ctx, req, newGenericServiceHandler(newMySQLAdapter), &v1alpha1.MySQL{})
+	return r.reconcileService(ctx, req, newMySQLAdapter, &v1alpha1.MySQL{})
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -87,3 +87,7 @@ func (a *mySQLAdapter) getServiceType() string {
 func (a *mySQLAdapter) getDiskSpace() string {
 	return a.Spec.DiskSpace
 }
+
+func (a *mySQLAdapter) getAutoscaleDisk() *v1alpha1.AutoscaleDisk {
+	return a.Spec.AutoscaleDisk
+}
@@ -27,7 +27,7 @@ type OpenSearchHandler struct{}
 //+kubebuilder:rbac:groups=aiven.io,resources=opensearches/finalizers,verbs=update
 
 func (r *OpenSearchReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	return r.reconcileInstance(ctx, req, newGenericServiceHandler(newOpenSearchAdapter), &v1alpha1.OpenSearch{})
+	return r.reconcileService(ctx, req, newOpenSearchAdapter, &v1alpha1.OpenSearch{})
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -85,3 +85,7 @@ func (a *opensearchAdapter) getServiceType() string {
 func (a *opensearchAdapter) getDiskSpace() string {
 	return a.Spec.DiskSpace
 }
+
+func (a *opensearchAdapter) getAutoscaleDisk() *v1alpha1.AutoscaleDisk {
+	return a.Spec.AutoscaleDisk
+}
@@ -25,7 +25,7 @@ type PostgreSQLReconciler struct {
 //+kubebuilder:rbac:groups=aiven.io,resources=postgresqls/finalizers,verbs=update
 
 func (r *PostgreSQLReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	return r.reconcileInstance(ctx, req, newGenericServiceHandler(newPostgresSQLAdapter), &v1alpha1.PostgreSQL{})
+	return r.reconcileService(ctx, req, newPostgresSQLAdapter, &v1alpha1.PostgreSQL{})
 }
 
 func (r *PostgreSQLReconciler) SetupWithManager(mgr ctrl.Manager) error {
@@ -85,3 +85,7 @@ func (a *postgresSQLAdapter) getServiceType() string {
 func (a *postgresSQLAdapter) getDiskSpace() string {
 	return a.Spec.DiskSpace
 }
+
+func (a *postgresSQLAdapter) getAutoscaleDisk() *v1alpha1.AutoscaleDisk {
+	return a.Spec.AutoscaleDisk
+}
@@ -12,8 +12,12 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"github.com/aiven/aiven-operator/api/v1alpha1"
 )
@@ -24,23 +28,63 @@ type ProjectReconciler struct {
 }
 
 // ProjectHandler handles an Aiven project
-type ProjectHandler struct{}
+type ProjectHandler struct {
+	k8s client.Client
+}
 
 // +kubebuilder:rbac:groups=aiven.io,resources=projects,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=aiven.io,resources=projects/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=aiven.io,resources=projects/finalizers,verbs=update
 
 func (r *ProjectReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	return r.reconcileInstance(ctx, req, ProjectHandler{}, &v1alpha1.Project{})
+	return r.reconcileInstance(ctx, req, ProjectHandler{k8s: r.Client}, &v1alpha1.Project{})
 }
 
 func (r *ProjectReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	err := mgr.GetFieldIndexer().IndexField(
+		context.Background(), &v1alpha1.Project{}, projectBillingGroupRefIndexKey, projectBillingGroupRefIndexFunc,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to add index for billingGroupRef field: %w", err)
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.Project{}).
 		Owns(&corev1.Secret{}).
+		Watches(&source.Kind{Type: &v1alpha1.BillingGroup{}}, handler.EnqueueRequestsFromMapFunc(r.findProjectsByBillingGroup)).
 		Complete(r)
 }
 
+// projectBillingGroupRefIndexKey indexes billing groups used in billingGroupRef
+const projectBillingGroupRefIndexKey = "spec.billingGroupRef"
+
+func projectBillingGroupRefIndexFunc(o client.Object) []string {
+	project, ok := o.(*v1alpha1.Project)
+	if !ok || project.Spec.BillingGroupRef == nil {
+		return nil
+	}
+	return []string{project.Spec.BillingGroupRef.BillingGroup(project.Namespace).NamespacedName.String()}
+}
+
+// findProjectsByBillingGroup returns projects which refer to the billing group
+func (r *ProjectReconciler) findProjectsByBillingGroup(group client.Object) []reconcile.Request {
+	key := types.NamespacedName{Name: group.GetName(), Namespace: group.GetNamespace()}.String()
+	list := &v1alpha1.ProjectList{}
+	err := r.List(context.Background(), list, client.MatchingFields{projectBillingGroupRefIndexKey: key})
+	if err != nil {
+		r.Log.Error(err, "unable to list projects", "billingGroup", key)
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(list.Items))
+	for _, item := range list.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: item.Name, Namespace: item.Namespace},
+		})
+	}
+	return requests
+}
+
 func (h ProjectHandler) getLongCardID(client *aiven.Client, cardID string) (*string, error) {
 	if cardID == "" {
 		return nil, nil
@@ -80,6 +124,15 @@ func (h ProjectHandler) createOrUpdate(avn *aiven.Client, i client.Object, refs
 		return fmt.Errorf("project does not exists: %w", err)
 	}
 
+	billingGroupID := project.Spec.BillingGroupID
+	if project.Spec.BillingGroupRef != nil {
+		group := v1alpha1.FindBillingGroup(refs)
+		if group == nil || group.Status.ID == "" {
+			return fmt.Errorf("billing group %q is not ready", project.Spec.BillingGroupRef.Name)
+		}
+		billingGroupID = group.Status.ID
+	}
+
 	cardID, err := h.getLongCardID(avn, project.Spec.CardID)
 	if err != nil {
 		return fmt.Errorf("cannot get long card id: %w", err)
@@ -102,7 +155,7 @@ func (h ProjectHandler) createOrUpdate(avn *aiven.Client, i client.Object, refs
 			Tags:             project.Spec.Tags,
 
 			// only set during creation
-			BillingGroupId:  project.Spec.BillingGroupID,
+			BillingGroupId:  billingGroupID,
 			CopyFromProject: project.Spec.CopyFromProject,
 		})
 		if err != nil {
@@ -127,6 +180,15 @@ func (h ProjectHandler) createOrUpdate(avn *aiven.Client, i client.Object, refs
 			return fmt.Errorf("failed to update project on aiven side: %w", err)
 		}
 
+		// Moves the project to another billing group
+		if billingGroupID != "" && billingGroupID != p.BillingGroupId {
+			err = avn.BillingGroup.AssignProjects(billingGroupID, []string{project.Name})
+			if err != nil {
+				return fmt.Errorf("failed to assign project to billing group %s: %w", billingGroupID, err)
+			}
+			p.BillingGroupId = billingGroupID
+		}
+
 		reason = "Updated"
 	}
 
@@ -135,6 +197,7 @@ func (h ProjectHandler) createOrUpdate(avn *aiven.Client, i client.Object, refs
 	project.Status.AvailableCredits = p.AvailableCredits
 	project.Status.Country = p.Country
 	project.Status.PaymentMethod = p.PaymentMethod
+	project.Status.BillingGroupID = p.BillingGroupId
 
 	meta.SetStatusCondition(&project.Status.Conditions,
 		getInitializedCondition(reason,
@@ -167,6 +230,18 @@ func (h ProjectHandler) get(avn *aiven.Client, i client.Object) (*corev1.Secret,
 
 	metav1.SetMetaDataAnnotation(&project.ObjectMeta, instanceIsRunningAnnotation, "true")
 
+	// Assigns the project again when the billing group has been replaced
+	if project.Spec.BillingGroupRef != nil {
+		group := &v1alpha1.BillingGroup{}
+		err = h.k8s.Get(context.Background(), project.Spec.BillingGroupRef.BillingGroup(project.Namespace).NamespacedName, group)
+		if client.IgnoreNotFound(err) != nil {
+			return nil, err
+		}
+		if err == nil && group.Status.ID != "" && group.Status.ID != project.Status.BillingGroupID {
+			delete(project.Annotations, processedGenerationAnnotation)
+		}
+	}
+
 	stringData := map[string]string{
 		"CA_CERT": cert,
 	}
@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/aiven/aiven-go-client"
 	corev1 "k8s.io/api/core/v1"
@@ -22,18 +23,27 @@ var isDependencyError = v1alpha1.ErrorSubstrChecker(
 	"VPC cannot be deleted while there are services migrating from it",
 )
 
+const (
+	conditionTypeSpecApplied = "SpecApplied"
+
+	projectVPCReplacePolicyRecreate = "Recreate"
+)
+
 // ProjectVPCReconciler reconciles a ProjectVPC object
 type ProjectVPCReconciler struct {
 	Controller
 }
 
-type ProjectVPCHandler struct{}
+type ProjectVPCHandler struct {
+	k8s client.Client
+}
 
 // +kubebuilder:rbac:groups=aiven.io,resources=projectvpcs,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=aiven.io,resources=projectvpcs/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=aiven.io,resources=vpcpeeringconnections,verbs=list
 
 func (r *ProjectVPCReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	return r.reconcileInstance(ctx, req, &ProjectVPCHandler{}, &v1alpha1.ProjectVPC{})
+	return r.reconcileInstance(ctx, req, &ProjectVPCHandler{k8s: r.Client}, &v1alpha1.ProjectVPC{})
 }
 
 func (r *ProjectVPCReconciler) SetupWithManager(mgr ctrl.Manager) error {
@@ -48,22 +58,79 @@ func (h *ProjectVPCHandler) createOrUpdate(avn *aiven.Client, i client.Object, r
 		return err
 	}
 
-	vpc, err := avn.VPCs.Create(projectVPC.Spec.Project, aiven.CreateVPCRequest{
-		CloudName:   projectVPC.Spec.CloudName,
-		NetworkCIDR: projectVPC.Spec.NetworkCidr,
-	})
-	if err != nil {
-		return err
+	var vpc *aiven.VPC
+	reason := "Updated"
+	if projectVPC.Status.ID == "" {
+		if projectVPC.Spec.ID != "" {
+			vpc, err = h.adopt(avn, projectVPC)
+			if err != nil {
+				return err
+			}
+			reason = "Adopted"
+		}
+	} else {
+		// Creates a new one if it's gone
+		vpc, err = avn.VPCs.Get(projectVPC.Spec.Project, projectVPC.Status.ID)
+		if aiven.IsNotFound(err) {
+			vpc, err = nil, nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if vpc != nil && !vpcMatchesSpec(vpc, projectVPC) {
+		if projectVPC.Spec.ReplacePolicy != projectVPCReplacePolicyRecreate {
+			// Keeps the VPC as is, the processed generation is set, so it doesn't come back until the spec is changed
+			meta.SetStatusCondition(&projectVPC.Status.Conditions, metav1.Condition{
+				Type:   conditionTypeSpecApplied,
+				Status: metav1.ConditionFalse,
+				Reason: "ReplaceRequired",
+				Message: fmt.Sprintf(
+					"VPC %s has cloudName %q and networkCidr %q, they can't be changed in place, set replacePolicy to %s to replace the VPC",
+					vpc.ProjectVPCID, vpc.CloudName, vpc.NetworkCIDR, projectVPCReplacePolicyRecreate,
+				),
+			})
+			metav1.SetMetaDataAnnotation(&projectVPC.ObjectMeta,
+				processedGenerationAnnotation, strconv.FormatInt(projectVPC.GetGeneration(), formatIntBaseDecimal))
+			return nil
+		}
+
+		deleted, err := h.deleteForReplace(avn, projectVPC, vpc)
+		if err != nil {
+			return err
+		}
+		if !deleted {
+			return fmt.Errorf("waiting for VPC %s to be deleted before creating a new one", vpc.ProjectVPCID)
+		}
+		vpc = nil
+	}
+
+	if vpc == nil {
+		vpc, err = avn.VPCs.Create(projectVPC.Spec.Project, aiven.CreateVPCRequest{
+			CloudName:   projectVPC.Spec.CloudName,
+			NetworkCIDR: projectVPC.Spec.NetworkCidr,
+		})
+		if err != nil {
+			return err
+		}
+		reason = "Created"
 	}
 
 	projectVPC.Status.ID = vpc.ProjectVPCID
+	meta.SetStatusCondition(&projectVPC.Status.Conditions, metav1.Condition{
+		Type:    conditionTypeSpecApplied,
+		Status:  metav1.ConditionTrue,
+		Reason:  reason,
+		Message: "VPC matches the spec",
+	})
 
 	meta.SetStatusCondition(&projectVPC.Status.Conditions,
-		getInitializedCondition("Created",
+		getInitializedCondition(reason,
 			"Instance was created or update on Aiven side"))
 
 	meta.SetStatusCondition(&projectVPC.Status.Conditions,
-		getRunningCondition(metav1.ConditionUnknown, "Created",
+		getRunningCondition(metav1.ConditionUnknown, reason,
 			"Instance was created or update on Aiven side, status remains unknown"))
 
 	metav1.SetMetaDataAnnotation(&projectVPC.ObjectMeta,
@@ -72,6 +139,67 @@ func (h *ProjectVPCHandler) createOrUpdate(avn *aiven.Client, i client.Object, r
 	return nil
 }
 
+// adopt returns the VPC given by ID, it must not be managed by another ProjectVPC
+func (h *ProjectVPCHandler) adopt(avn *aiven.Client, projectVPC *v1alpha1.ProjectVPC) (*aiven.VPC, error) {
+	list := &v1alpha1.ProjectVPCList{}
+	err := h.k8s.List(context.Background(), list)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, o := range list.Items {
+		if o.UID != projectVPC.UID && o.Spec.Project == projectVPC.Spec.Project && o.Status.ID == projectVPC.Spec.ID {
+			return nil, fmt.Errorf("VPC %s is already managed by ProjectVPC %s/%s", projectVPC.Spec.ID, o.Namespace, o.Name)
+		}
+	}
+
+	vpc, err := avn.VPCs.Get(projectVPC.Spec.Project, projectVPC.Spec.ID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get VPC %s: %w", projectVPC.Spec.ID, err)
+	}
+
+	if !vpcMatchesSpec(vpc, projectVPC) {
+		return nil, fmt.Errorf(
+			"VPC %s has cloudName %q and networkCidr %q, they must match the spec to adopt it",
+			vpc.ProjectVPCID, vpc.CloudName, vpc.NetworkCIDR,
+		)
+	}
+	return vpc, nil
+}
+
+// deleteForReplace deletes the given VPC, returns true when it is gone
+func (h *ProjectVPCHandler) deleteForReplace(avn *aiven.Client, projectVPC *v1alpha1.ProjectVPC, vpc *aiven.VPC) (bool, error) {
+	switch vpc.State {
+	case "DELETED":
+		return true, nil
+	case "DELETING":
+		return false, nil
+	}
+
+	names, err := h.peeringConnections(projectVPC)
+	if err != nil {
+		return false, err
+	}
+
+	if len(names) > 0 {
+		return false, fmt.Errorf("can't replace VPC %s, it has peering connections %s", vpc.ProjectVPCID, strings.Join(names, ", "))
+	}
+
+	err = avn.VPCs.Delete(projectVPC.Spec.Project, vpc.ProjectVPCID)
+	if aiven.IsNotFound(err) {
+		return true, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("can't replace VPC %s: %w", vpc.ProjectVPCID, err)
+	}
+	return false, nil
+}
+
+func vpcMatchesSpec(vpc *aiven.VPC, projectVPC *v1alpha1.ProjectVPC) bool {
+	return vpc.CloudName == projectVPC.Spec.CloudName && vpc.NetworkCIDR == projectVPC.Spec.NetworkCidr
+}
+
 func (h *ProjectVPCHandler) delete(avn *aiven.Client, i client.Object) (bool, error) {
 	projectVPC, err := h.convert(i)
 	if err != nil {
@@ -92,6 +220,16 @@ func (h *ProjectVPCHandler) delete(avn *aiven.Client, i client.Object) (bool, er
 		return true, nil
 	}
 
+	// Peering connections must be deleted first, otherwise they get orphaned
+	names, err := h.peeringConnections(projectVPC)
+	if err != nil {
+		return false, err
+	}
+
+	if len(names) > 0 {
+		return false, fmt.Errorf("%w: VPC has peering connections %s", v1alpha1.ErrDeleteDependencies, strings.Join(names, ", "))
+	}
+
 	err = avn.VPCs.Delete(projectVPC.Spec.Project, projectVPC.Status.ID)
 	if isDependencyError(err) {
 		return false, fmt.Errorf("%w: %s", v1alpha1.ErrDeleteDependencies, err)
@@ -127,6 +265,24 @@ func (h *ProjectVPCHandler) checkPreconditions(_ *aiven.Client, _ client.Object)
 	return true, nil
 }
 
+// peeringConnections returns VPCPeeringConnection names that refer to the given ProjectVPC
+func (h *ProjectVPCHandler) peeringConnections(projectVPC *v1alpha1.ProjectVPC) ([]string, error) {
+	list := &v1alpha1.VPCPeeringConnectionList{}
+	err := h.k8s.List(context.Background(), list)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0)
+	for _, c := range list.Items {
+		ref := c.Spec.ProjectVPCRef.ProjectVPC(c.Namespace)
+		if ref.NamespacedName.Name == projectVPC.Name && ref.NamespacedName.Namespace == projectVPC.Namespace {
+			names = append(names, c.Namespace+"/"+c.Name)
+		}
+	}
+	return names, nil
+}
+
 func (h *ProjectVPCHandler) convert(i client.Object) (*v1alpha1.ProjectVPC, error) {
 	vpc, ok := i.(*v1alpha1.ProjectVPC)
 	if !ok {
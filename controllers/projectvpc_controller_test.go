@@ -0,0 +1,150 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// fakeProjectVPCsAPI serves project VPCs, deleted VPCs are gone immediately
+type fakeProjectVPCsAPI struct {
+	*fakeAivenAPI
+	vpcs map[string]*aiven.VPC
+}
+
+func newFakeProjectVPCsAPI(t *testing.T, vpcs ...*aiven.VPC) *fakeProjectVPCsAPI {
+	const vpcsPath = "/v1/project/my-project/vpcs"
+
+	f := &fakeProjectVPCsAPI{vpcs: make(map[string]*aiven.VPC)}
+	for _, v := range vpcs {
+		f.vpcs[v.ProjectVPCID] = v
+	}
+	f.fakeAivenAPI = newFakeAivenAPI(t, fakeRoutes{
+		"GET " + vpcsPath: func(*http.Request, []string) (int, any) {
+			list := make([]*aiven.VPC, 0, len(f.vpcs))
+			for _, v := range f.vpcs {
+				list = append(list, v)
+			}
+			return http.StatusOK, map[string]any{"vpcs": list}
+		},
+		"POST " + vpcsPath: func(r *http.Request, _ []string) (int, any) {
+			req := aiven.CreateVPCRequest{}
+			f.decode(r, &req)
+			vpc := &aiven.VPC{
+				ProjectVPCID: fmt.Sprintf("vpc%d", len(f.calls)+1),
+				CloudName:    req.CloudName,
+				NetworkCIDR:  req.NetworkCIDR,
+				State:        "APPROVED",
+			}
+			f.vpcs[vpc.ProjectVPCID] = vpc
+			f.call("create %s", vpc.ProjectVPCID)
+			return http.StatusOK, vpc
+		},
+		"GET " + vpcsPath + "/*": f.vpc(func(_ *http.Request, params []string) (int, any) {
+			return http.StatusOK, f.vpcs[params[0]]
+		}),
+		"DELETE " + vpcsPath + "/*": f.vpc(func(_ *http.Request, params []string) (int, any) {
+			delete(f.vpcs, params[0])
+			f.call("delete %s", params[0])
+			return http.StatusOK, map[string]any{}
+		}),
+	})
+	return f
+}
+
+// vpc returns 404 for unknown VPCs
+func (f *fakeProjectVPCsAPI) vpc(h fakeHandler) fakeHandler {
+	return func(r *http.Request, params []string) (int, any) {
+		if f.vpcs[params[0]] == nil {
+			return fakeNotFound(r, params)
+		}
+		return h(r, params)
+	}
+}
+
+func TestProjectVPCHandler(t *testing.T) {
+	api := newFakeProjectVPCsAPI(t,
+		&aiven.VPC{ProjectVPCID: "console", CloudName: "aws-eu-west-1", NetworkCIDR: "10.0.0.0/24", State: "ACTIVE"},
+	)
+	avn := newFakeAivenClient(api)
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+	h := &ProjectVPCHandler{k8s: fake.NewClientBuilder().WithScheme(scheme).Build()}
+	vpc := &v1alpha1.ProjectVPC{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-vpc", Namespace: "default", UID: "uid-my-vpc", Generation: 1},
+		Spec:       v1alpha1.ProjectVPCSpec{Project: "my-project", CloudName: "aws-eu-west-1", NetworkCidr: "10.0.0.0/24", ID: "console"},
+	}
+
+	// Adopts the existing VPC
+	require.NoError(t, h.createOrUpdate(avn, vpc, nil))
+	assert.Equal(t, "console", vpc.Status.ID)
+	assert.Empty(t, api.calls)
+	assert.Equal(t, "Adopted", meta.FindStatusCondition(vpc.Status.Conditions, conditionTypeSpecApplied).Reason)
+
+	// Rejects the change
+	vpc.Generation = 2
+	vpc.Spec.NetworkCidr = "10.0.1.0/24"
+	require.NoError(t, h.createOrUpdate(avn, vpc, nil))
+	assert.Equal(t, "console", vpc.Status.ID)
+	assert.Empty(t, api.calls)
+	assert.True(t, isAlreadyProcessed(vpc))
+	applied := meta.FindStatusCondition(vpc.Status.Conditions, conditionTypeSpecApplied)
+	assert.Equal(t, metav1.ConditionFalse, applied.Status)
+	assert.Equal(t, `VPC console has cloudName "aws-eu-west-1" and networkCidr "10.0.0.0/24", they can't be changed in place, set replacePolicy to Recreate to replace the VPC`, applied.Message)
+
+	// Can't replace while peered
+	vpc.Generation = 3
+	vpc.Spec.ReplacePolicy = "Recreate"
+	peering := &v1alpha1.VPCPeeringConnection{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-peering", Namespace: "default"},
+		Spec:       v1alpha1.VPCPeeringConnectionSpec{ProjectVPCRef: v1alpha1.ResourceReference{Name: "my-vpc"}},
+	}
+	h.k8s = fake.NewClientBuilder().WithScheme(scheme).WithObjects(peering).Build()
+	assert.EqualError(t, h.createOrUpdate(avn, vpc, nil), "can't replace VPC console, it has peering connections default/my-peering")
+
+	// Recreates
+	h.k8s = fake.NewClientBuilder().WithScheme(scheme).Build()
+	assert.EqualError(t, h.createOrUpdate(avn, vpc, nil), "waiting for VPC console to be deleted before creating a new one")
+	require.NoError(t, h.createOrUpdate(avn, vpc, nil))
+	assert.Equal(t, []string{"delete console", "create vpc2"}, api.calls)
+	assert.Equal(t, "vpc2", vpc.Status.ID)
+	applied = meta.FindStatusCondition(vpc.Status.Conditions, conditionTypeSpecApplied)
+	assert.Equal(t, metav1.ConditionTrue, applied.Status)
+	assert.Equal(t, "Created", applied.Reason)
+
+	// Adopts by ID, the spec must match
+	other := &v1alpha1.ProjectVPC{
+		ObjectMeta: metav1.ObjectMeta{Name: "other-vpc", Namespace: "default", Generation: 1},
+		Spec:       v1alpha1.ProjectVPCSpec{Project: "my-project", CloudName: "aws-eu-west-1", NetworkCidr: "10.0.0.0/24", ID: "vpc2"},
+	}
+	assert.EqualError(t, h.createOrUpdate(avn, other, nil), `VPC vpc2 has cloudName "aws-eu-west-1" and networkCidr "10.0.1.0/24", they must match the spec to adopt it`)
+	other.Spec.NetworkCidr = "10.0.1.0/24"
+	require.NoError(t, h.createOrUpdate(avn, other, nil))
+	assert.Equal(t, "vpc2", other.Status.ID)
+
+	// Doesn't adopt a VPC managed by another resource
+	third := &v1alpha1.ProjectVPC{
+		ObjectMeta: metav1.ObjectMeta{Name: "third-vpc", Namespace: "default", UID: "uid-third-vpc", Generation: 1},
+		Spec:       v1alpha1.ProjectVPCSpec{Project: "my-project", CloudName: "aws-eu-west-1", NetworkCidr: "10.0.1.0/24", ID: "vpc2"},
+	}
+	h.k8s = fake.NewClientBuilder().WithScheme(scheme).WithObjects(vpc).Build()
+	assert.EqualError(t, h.createOrUpdate(avn, third, nil), "VPC vpc2 is already managed by ProjectVPC default/my-vpc")
+
+	// Doesn't adopt a matching VPC without the ID
+	third.Spec.ID = ""
+	require.NoError(t, h.createOrUpdate(avn, third, nil))
+	assert.Equal(t, "vpc3", third.Status.ID)
+	assert.Equal(t, "Created", meta.FindStatusCondition(third.Status.Conditions, conditionTypeSpecApplied).Reason)
+}
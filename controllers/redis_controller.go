@@ -27,7 +27,7 @@ type RedisHandler struct{}
 //+kubebuilder:rbac:groups=aiven.io,resources=redis/finalizers,verbs=update
 
 func (r *RedisReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	return r.reconcileInstance(ctx, req, newGenericServiceHandler(newRedisAdapter), &v1alpha1.Redis{})
+	return r.reconcileService(ctx, req, newRedisAdapter, &v1alpha1.Redis{})
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -86,3 +86,7 @@ func (a *redisAdapter) getServiceType() string {
 func (a *redisAdapter) getDiskSpace() string {
 	return a.Spec.DiskSpace
 }
+
+func (a *redisAdapter) getAutoscaleDisk() *v1alpha1.AutoscaleDisk {
+	return a.Spec.AutoscaleDisk
+}
@@ -0,0 +1,273 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+const (
+	// serviceCatalogRefreshInterval how often cached service plans are refreshed
+	serviceCatalogRefreshInterval = time.Hour
+
+	// serviceCatalogFetchTimeout the webhook must answer before the apiserver gives up (10s by default),
+	// the validation is skipped when the API doesn't respond in time
+	serviceCatalogFetchTimeout = 3 * time.Second
+
+	// serviceCatalogErrorTTL how long a failed fetch is cached, so an outage doesn't slow down every admission request
+	serviceCatalogErrorTTL = time.Minute
+)
+
+var (
+	_ v1alpha1.ServiceCatalog        = &ServiceCatalog{}
+	_ manager.Runnable               = &ServiceCatalog{}
+	_ manager.LeaderElectionRunnable = &ServiceCatalog{}
+)
+
+// ServiceCatalog caches service plans, clouds and disk space ranges per token and project,
+// so a token never gets plans fetched with another token.
+// Projects are fetched on the first request and then refreshed periodically,
+// the ones that are not requested within the refresh interval are dropped, so rotated tokens don't stay in memory.
+type ServiceCatalog struct {
+	// reader gets auth secrets, doesn't use cache
+	reader       client.Reader
+	log          logr.Logger
+	defaultToken string
+
+	// apiURL is Aiven API v1 URL, can be replaced with a fake API
+	apiURL     string
+	httpClient *http.Client
+	interval   time.Duration
+	timeout    time.Duration
+	errorTTL   time.Duration
+
+	mu       sync.RWMutex
+	projects map[serviceCatalogKey]*serviceCatalogProject
+}
+
+// serviceCatalogKey the token is hashed, so it doesn't show up in the map keys
+type serviceCatalogKey struct {
+	tokenHash string
+	project   string
+}
+
+func newServiceCatalogKey(token, project string) serviceCatalogKey {
+	h := sha256.Sum256([]byte(token))
+	return serviceCatalogKey{tokenHash: hex.EncodeToString(h[:]), project: project}
+}
+
+type serviceCatalogProject struct {
+	plans map[string][]v1alpha1.ServicePlan
+
+	// token is used to refresh the plans
+	token    string
+	lastUsed time.Time
+
+	// err is the last fetch error, cached until expires
+	err     error
+	expires time.Time
+}
+
+// NewServiceCatalog returns a catalog, which must be added to the manager to be refreshed
+func NewServiceCatalog(mgr ctrl.Manager, defaultToken string) *ServiceCatalog {
+	return newServiceCatalog(mgr.GetAPIReader(), ctrl.Log.WithName("service-catalog"), defaultToken, aivenAPIURL())
+}
+
+func newServiceCatalog(reader client.Reader, log logr.Logger, defaultToken, apiURL string) *ServiceCatalog {
+	return &ServiceCatalog{
+		reader:       reader,
+		log:          log,
+		defaultToken: defaultToken,
+		apiURL:       apiURL,
+		httpClient:   &http.Client{Timeout: time.Minute},
+		interval:     serviceCatalogRefreshInterval,
+		timeout:      serviceCatalogFetchTimeout,
+		errorTTL:     serviceCatalogErrorTTL,
+		projects:     make(map[serviceCatalogKey]*serviceCatalogProject),
+	}
+}
+
+// GetServicePlans implements v1alpha1.ServiceCatalog
+func (c *ServiceCatalog) GetServicePlans(ctx context.Context, namespace string, auth *v1alpha1.AuthSecretReference, project, serviceType string) ([]v1alpha1.ServicePlan, error) {
+	token, err := c.getToken(ctx, namespace, auth)
+	if err != nil {
+		return nil, err
+	}
+
+	key := newServiceCatalogKey(token, project)
+	c.mu.Lock()
+	p, ok := c.projects[key]
+	if ok && (p.err == nil || time.Now().Before(p.expires)) {
+		p.lastUsed = time.Now()
+		c.mu.Unlock()
+		return p.plans[serviceType], p.err
+	}
+	c.mu.Unlock()
+
+	// Runs within the admission request, the caller skips the validation on error
+	fetchCtx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	plans, err := c.fetch(fetchCtx, token, project)
+	c.mu.Lock()
+	if err != nil {
+		c.projects[key] = &serviceCatalogProject{err: err, expires: time.Now().Add(c.errorTTL)}
+	} else {
+		c.projects[key] = &serviceCatalogProject{plans: plans, token: token, lastUsed: time.Now()}
+	}
+	c.mu.Unlock()
+	return plans[serviceType], err
+}
+
+// Start implements manager.Runnable, refreshes cached projects until the context is done
+func (c *ServiceCatalog) Start(ctx context.Context) error {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			c.refresh(ctx)
+		}
+	}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable, webhooks run on all replicas
+func (c *ServiceCatalog) NeedLeaderElection() bool {
+	return false
+}
+
+func (c *ServiceCatalog) refresh(ctx context.Context) {
+	c.mu.Lock()
+	tokens := make(map[serviceCatalogKey]string, len(c.projects))
+	for key, p := range c.projects {
+		if p.err != nil || time.Since(p.lastUsed) > c.interval {
+			// Fetched again on the next request, if the token is still in use
+			delete(c.projects, key)
+			continue
+		}
+		tokens[key] = p.token
+	}
+	c.mu.Unlock()
+
+	for key, token := range tokens {
+		plans, err := c.fetch(ctx, token, key.project)
+		if err != nil {
+			// Keeps the stale data, plans rarely change
+			c.log.Info("unable to refresh service plans", "project", key.project, "error", err.Error())
+			continue
+		}
+
+		c.mu.Lock()
+		if p, ok := c.projects[key]; ok && p.err == nil {
+			p.plans = plans
+		}
+		c.mu.Unlock()
+	}
+}
+
+// getToken returns the token the same way the controllers do
+func (c *ServiceCatalog) getToken(ctx context.Context, namespace string, auth *v1alpha1.AuthSecretReference) (string, error) {
+	if c.defaultToken != "" {
+		return c.defaultToken, nil
+	}
+
+	if auth == nil {
+		return "", errNoTokenProvided
+	}
+
+	secret := new(corev1.Secret)
+	err := c.reader.Get(ctx, types.NamespacedName{Name: auth.Name, Namespace: namespace}, secret)
+	if err != nil {
+		return "", fmt.Errorf("cannot get secret %q: %w", auth.Name, err)
+	}
+	return string(secret.Data[auth.Key]), nil
+}
+
+type serviceTypesResponse struct {
+	Message      string `json:"message"`
+	ServiceTypes map[string]struct {
+		ServicePlans []struct {
+			ServicePlan     string              `json:"service_plan"`
+			DiskSpaceMB     int                 `json:"disk_space_mb"`
+			DiskSpaceCapMB  int                 `json:"disk_space_cap_mb"`
+			DiskSpaceStepMB int                 `json:"disk_space_step_mb"`
+			Regions         map[string]struct{} `json:"regions"`
+		} `json:"service_plans"`
+	} `json:"service_types"`
+}
+
+// fetch returns service plans by service type.
+// aiven.Client lists service plans without disk space ranges, and its requests can't be cancelled,
+// so the endpoint is called directly
+func (c *ServiceCatalog) fetch(ctx context.Context, token, project string) (map[string][]v1alpha1.ServicePlan, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.apiURL+"/project/"+url.PathEscape(project)+"/service-types", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", "aivenv1 "+token)
+	req.Header.Set("User-Agent", "k8s-operator/"+version)
+	rsp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer rsp.Body.Close()
+
+	b, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(serviceTypesResponse)
+	if rsp.StatusCode != http.StatusOK {
+		_ = json.Unmarshal(b, r)
+		return nil, fmt.Errorf("cannot get service types for project %q: %d %s", project, rsp.StatusCode, r.Message)
+	}
+
+	if err = json.Unmarshal(b, r); err != nil {
+		return nil, fmt.Errorf("cannot parse service types: %w", err)
+	}
+
+	result := make(map[string][]v1alpha1.ServicePlan, len(r.ServiceTypes))
+	for serviceType, t := range r.ServiceTypes {
+		plans := make([]v1alpha1.ServicePlan, 0, len(t.ServicePlans))
+		for _, p := range t.ServicePlans {
+			clouds := make([]string, 0, len(p.Regions))
+			for cloud := range p.Regions {
+				clouds = append(clouds, cloud)
+			}
+			sort.Strings(clouds)
+			plans = append(plans, v1alpha1.ServicePlan{
+				Name:            p.ServicePlan,
+				Clouds:          clouds,
+				DiskSpaceMB:     p.DiskSpaceMB,
+				DiskSpaceCapMB:  p.DiskSpaceCapMB,
+				DiskSpaceStepMB: p.DiskSpaceStepMB,
+			})
+		}
+		result[serviceType] = plans
+	}
+	return result, nil
+}
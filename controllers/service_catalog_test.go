@@ -0,0 +1,192 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+const fakeServiceTypes = `{
+  "service_types": {
+    "kafka": {
+      "service_plans": [
+        {
+          "service_plan": "business-4",
+          "disk_space_mb": 614400,
+          "disk_space_cap_mb": 1843200,
+          "disk_space_step_mb": 30720,
+          "regions": {"google-europe-west1": {}, "aws-eu-west-1": {}}
+        },
+        {
+          "service_plan": "startup-2",
+          "disk_space_mb": 92160,
+          "regions": {"google-europe-west1": {}}
+        }
+      ]
+    }
+  }
+}`
+
+func newFakeServiceTypesAPI(t *testing.T, calls *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(calls, 1)
+		if r.Header.Get("Authorization") != "aivenv1 secret-token" {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"message": "Invalid token"}`))
+			return
+		}
+
+		assert.Equal(t, "/v1/project/my-project/service-types", r.URL.Path)
+		_, _ = w.Write([]byte(fakeServiceTypes))
+	}))
+}
+
+func TestServiceCatalogGetServicePlans(t *testing.T) {
+	var calls int32
+	api := newFakeServiceTypesAPI(t, &calls)
+	defer api.Close()
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "aiven-token", Namespace: "default"},
+		Data:       map[string][]byte{"token": []byte("secret-token")},
+	}
+	reader := fake.NewClientBuilder().WithObjects(secret).Build()
+	catalog := newServiceCatalog(reader, logr.Discard(), "", api.URL+"/v1")
+	auth := &v1alpha1.AuthSecretReference{Name: "aiven-token", Key: "token"}
+
+	ctx := context.Background()
+	plans, err := catalog.GetServicePlans(ctx, "default", auth, "my-project", "kafka")
+	require.NoError(t, err)
+	expected := []v1alpha1.ServicePlan{
+		{
+			Name:            "business-4",
+			Clouds:          []string{"aws-eu-west-1", "google-europe-west1"},
+			DiskSpaceMB:     614400,
+			DiskSpaceCapMB:  1843200,
+			DiskSpaceStepMB: 30720,
+		},
+		{
+			Name:        "startup-2",
+			Clouds:      []string{"google-europe-west1"},
+			DiskSpaceMB: 92160,
+		},
+	}
+	assert.ElementsMatch(t, expected, plans)
+
+	// Cached
+	plans, err = catalog.GetServicePlans(ctx, "default", auth, "my-project", "pg")
+	require.NoError(t, err)
+	assert.Empty(t, plans)
+	assert.EqualValues(t, 1, atomic.LoadInt32(&calls))
+
+	// Refreshed with the same token
+	catalog.refresh(ctx)
+	assert.EqualValues(t, 2, atomic.LoadInt32(&calls))
+	plans, err = catalog.GetServicePlans(ctx, "default", auth, "my-project", "kafka")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, expected, plans)
+
+	// The token is not used as a key
+	for key := range catalog.projects {
+		assert.NotContains(t, key.tokenHash, "secret-token")
+	}
+
+	// Dropped if not used within the refresh interval
+	for _, p := range catalog.projects {
+		p.lastUsed = time.Now().Add(-2 * serviceCatalogRefreshInterval)
+	}
+	catalog.refresh(ctx)
+	assert.Empty(t, catalog.projects)
+	assert.EqualValues(t, 2, atomic.LoadInt32(&calls))
+}
+
+func TestServiceCatalogGetServicePlansErrors(t *testing.T) {
+	var calls int32
+	api := newFakeServiceTypesAPI(t, &calls)
+	defer api.Close()
+
+	ctx := context.Background()
+	reader := fake.NewClientBuilder().Build()
+
+	// No token
+	catalog := newServiceCatalog(reader, logr.Discard(), "", api.URL+"/v1")
+	_, err := catalog.GetServicePlans(ctx, "default", nil, "my-project", "kafka")
+	assert.ErrorIs(t, err, errNoTokenProvided)
+
+	// Errors are cached for a while
+	catalog = newServiceCatalog(reader, logr.Discard(), "invalid-token", api.URL+"/v1")
+	_, err = catalog.GetServicePlans(ctx, "default", nil, "my-project", "kafka")
+	assert.EqualError(t, err, `cannot get service types for project "my-project": 403 Invalid token`)
+	_, err = catalog.GetServicePlans(ctx, "default", nil, "my-project", "kafka")
+	assert.Error(t, err)
+	assert.EqualValues(t, 1, atomic.LoadInt32(&calls))
+
+	// Fetched again once expired
+	for _, p := range catalog.projects {
+		p.expires = time.Now()
+	}
+	_, err = catalog.GetServicePlans(ctx, "default", nil, "my-project", "kafka")
+	assert.Error(t, err)
+	assert.EqualValues(t, 2, atomic.LoadInt32(&calls))
+}
+
+func TestServiceCatalogGetServicePlansByToken(t *testing.T) {
+	var calls int32
+	api := newFakeServiceTypesAPI(t, &calls)
+	defer api.Close()
+
+	secrets := []client.Object{
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "aiven-token", Namespace: "default"},
+			Data:       map[string][]byte{"token": []byte("secret-token")},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "aiven-token", Namespace: "other"},
+			Data:       map[string][]byte{"token": []byte("other-token")},
+		},
+	}
+	reader := fake.NewClientBuilder().WithObjects(secrets...).Build()
+	catalog := newServiceCatalog(reader, logr.Discard(), "", api.URL+"/v1")
+	auth := &v1alpha1.AuthSecretReference{Name: "aiven-token", Key: "token"}
+
+	// Plans fetched with one token are not shared with another
+	ctx := context.Background()
+	plans, err := catalog.GetServicePlans(ctx, "default", auth, "my-project", "kafka")
+	require.NoError(t, err)
+	assert.Len(t, plans, 2)
+	_, err = catalog.GetServicePlans(ctx, "other", auth, "my-project", "kafka")
+	assert.EqualError(t, err, `cannot get service types for project "my-project": 403 Invalid token`)
+	assert.EqualValues(t, 2, atomic.LoadInt32(&calls))
+
+	// Failures are dropped on refresh
+	catalog.refresh(ctx)
+	assert.EqualValues(t, 3, atomic.LoadInt32(&calls))
+	assert.Len(t, catalog.projects, 1)
+}
+
+func TestServiceCatalogGetServicePlansTimeout(t *testing.T) {
+	done := make(chan struct{})
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-done
+	}))
+	defer api.Close()
+	defer close(done)
+
+	catalog := newServiceCatalog(fake.NewClientBuilder().Build(), logr.Discard(), "secret-token", api.URL+"/v1")
+	catalog.timeout = 10 * time.Millisecond
+	_, err := catalog.GetServicePlans(context.Background(), "default", nil, "my-project", "kafka")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
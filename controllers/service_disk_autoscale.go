@@ -0,0 +1,198 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+const (
+	// diskAutoscaleInterval how often the disk usage is checked
+	diskAutoscaleInterval = 5 * time.Minute
+	// diskAutoscaleCooldown default time between increases
+	diskAutoscaleCooldown = time.Hour
+	// diskAutoscaleThreshold default disk usage percentage
+	diskAutoscaleThreshold = 85
+	// diskAutoscaleMaxSteps how many steps are kept in the status
+	diskAutoscaleMaxSteps = 10
+
+	conditionTypeDiskAutoscale = "DiskAutoscale"
+	eventDiskAutoscaled        = "DiskAutoscaled"
+	eventDiskAutoscaleLimit    = "DiskAutoscaleLimitReached"
+)
+
+// serviceMetricsResponse has the metrics in Google Charts format:
+// the first column is the time, the rest are the service nodes
+type serviceMetricsResponse struct {
+	Metrics map[string]struct {
+		Data struct {
+			Rows [][]any `json:"rows"`
+		} `json:"data"`
+	} `json:"metrics"`
+}
+
+// getServiceDiskUsage returns the latest disk usage percentage of the fullest node.
+// aiven.Client doesn't support the metrics endpoint, so it is called directly
+func getServiceDiskUsage(ctx context.Context, a *aiven.Client, project, serviceName string) (float64, error) {
+	rsp := new(serviceMetricsResponse)
+	path := fmt.Sprintf("/project/%s/service/%s/metrics", url.PathEscape(project), url.PathEscape(serviceName))
+	err := doAivenRequest(ctx, a, http.MethodPost, path, map[string]string{"period": "hour"}, rsp)
+	if err != nil {
+		return 0, err
+	}
+
+	rows := rsp.Metrics["disk_usage"].Data.Rows
+	if len(rows) == 0 {
+		return 0, fmt.Errorf("no disk usage metrics")
+	}
+
+	usage := 0.0
+	for _, v := range rows[len(rows)-1][1:] {
+		if f, ok := v.(float64); ok && f > usage {
+			usage = f
+		}
+	}
+	return usage, nil
+}
+
+// autoscaleDisk increases the disk space when its usage reaches the threshold
+func (h *genericServiceHandler) autoscaleDisk(a *aiven.Client, object client.Object, o serviceAdapter, s *aiven.Service) error {
+	policy := o.getAutoscaleDisk()
+	if policy == nil {
+		return nil
+	}
+
+	status := o.getServiceStatus()
+	cooldown := diskAutoscaleCooldown
+	if policy.Cooldown != nil {
+		cooldown = policy.Cooldown.Duration
+	}
+
+	if n := len(status.DiskAutoscaleSteps); n > 0 && time.Since(status.DiskAutoscaleSteps[n-1].Time.Time) < cooldown {
+		return nil
+	}
+
+	spec := o.getServiceCommonSpec()
+	serviceName := o.getObjectMeta().Name
+	usage, err := getServiceDiskUsage(h.ctx, a, spec.Project, serviceName)
+	if err != nil {
+		return fmt.Errorf("failed to get disk usage: %w", err)
+	}
+
+	threshold := policy.Threshold
+	if threshold == 0 {
+		threshold = diskAutoscaleThreshold
+	}
+
+	if usage < float64(threshold) {
+		return nil
+	}
+
+	plan, err := a.ServiceTypes.GetPlan(spec.Project, o.getServiceType(), s.Plan)
+	if err != nil {
+		return fmt.Errorf("failed to get service plan: %w", err)
+	}
+
+	current := s.DiskSpaceMB
+	if current == 0 {
+		current = plan.DiskSpaceMB
+	}
+
+	next := nextDiskSpace(current, plan, v1alpha1.ConvertDiscSpace(policy.Step), v1alpha1.ConvertDiscSpace(policy.MaxDiskSpace))
+	if next <= current {
+		c := meta.FindStatusCondition(status.Conditions, conditionTypeDiskAutoscale)
+		message := fmt.Sprintf("Disk usage is %.0f%%, disk space %s can't be increased", usage, v1alpha1.FormatDiskSpace(current))
+		if c == nil || c.Reason != eventDiskAutoscaleLimit {
+			h.rec.Event(object, corev1.EventTypeWarning, eventDiskAutoscaleLimit, message)
+		}
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:    conditionTypeDiskAutoscale,
+			Status:  metav1.ConditionFalse,
+			Reason:  eventDiskAutoscaleLimit,
+			Message: message,
+		})
+		return nil
+	}
+
+	// Keeps the current settings, like applyPowered does
+	_, err = a.Services.Update(spec.Project, serviceName, aiven.UpdateServiceRequest{
+		Plan:                  s.Plan,
+		DiskSpaceMB:           next,
+		Powered:               s.Powered,
+		ProjectVPCID:          s.ProjectVPCID,
+		TerminationProtection: s.TerminationProtection,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to increase disk space: %w", err)
+	}
+
+	step := v1alpha1.DiskAutoscaleStep{
+		Time:          metav1.Now(),
+		UsagePercent:  int(usage),
+		FromDiskSpace: v1alpha1.FormatDiskSpace(current),
+		ToDiskSpace:   v1alpha1.FormatDiskSpace(next),
+	}
+	status.DiskAutoscaleSteps = append(status.DiskAutoscaleSteps, step)
+	if n := len(status.DiskAutoscaleSteps); n > diskAutoscaleMaxSteps {
+		status.DiskAutoscaleSteps = status.DiskAutoscaleSteps[n-diskAutoscaleMaxSteps:]
+	}
+
+	message := fmt.Sprintf("Disk usage is %d%%, disk space increased from %s to %s", step.UsagePercent, step.FromDiskSpace, step.ToDiskSpace)
+	h.rec.Event(object, corev1.EventTypeNormal, eventDiskAutoscaled, message)
+	meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:    conditionTypeDiskAutoscale,
+		Status:  metav1.ConditionTrue,
+		Reason:  eventDiskAutoscaled,
+		Message: message,
+	})
+	return nil
+}
+
+// nextDiskSpace returns the increased disk space within the plan limits and the maximum,
+// the current disk space if it can't be increased
+func nextDiskSpace(current int, plan *aiven.GetServicePlanResponse, step, maxMB int) int {
+	if plan.DiskSpaceCapMB == 0 {
+		return current
+	}
+
+	if maxMB == 0 || maxMB > plan.DiskSpaceCapMB {
+		maxMB = plan.DiskSpaceCapMB
+	}
+
+	planStep := plan.DiskSpaceStepMB
+	if step == 0 {
+		step = planStep
+	}
+
+	// Rounds up to the plan's step
+	if planStep > 0 && step%planStep != 0 {
+		step += planStep - step%planStep
+	}
+
+	next := current + step
+	if next > maxMB {
+		next = maxMB
+	}
+
+	// Valid sizes are the plan's default plus steps
+	if planStep > 0 {
+		next -= (next - plan.DiskSpaceMB) % planStep
+	}
+
+	if next < current {
+		return current
+	}
+	return next
+}
@@ -0,0 +1,156 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// fakeDiskUsageAPI keeps a running PostgreSQL service with the business-4 plan
+type fakeDiskUsageAPI struct {
+	*fakeAivenAPI
+	usage   float64
+	disk    int
+	updates []aiven.UpdateServiceRequest
+}
+
+func newFakeDiskUsageAPI(t *testing.T, usage float64, disk int) *fakeDiskUsageAPI {
+	const servicePath = "/v1/project/my-project/service/my-pg"
+
+	f := &fakeDiskUsageAPI{usage: usage, disk: disk}
+	f.fakeAivenAPI = newFakeAivenAPI(t, fakeRoutes{
+		"GET " + servicePath: func(*http.Request, []string) (int, any) {
+			return http.StatusOK, map[string]any{"service": map[string]any{
+				"state":         "RUNNING",
+				"powered":       true,
+				"plan":          "business-4",
+				"disk_space_mb": f.disk,
+			}}
+		},
+		"POST " + servicePath + "/metrics": func(*http.Request, []string) (int, any) {
+			return http.StatusOK, map[string]any{"metrics": map[string]any{
+				"disk_usage": map[string]any{"data": map[string]any{
+					"cols": []any{map[string]any{"label": "time"}, map[string]any{"label": "my-pg-1"}, map[string]any{"label": "my-pg-2"}},
+					"rows": []any{
+						[]any{"Date(2023,4,12,10,0,0)", 99.0, 99.0},
+						[]any{"Date(2023,4,12,10,1,0)", f.usage - 1, f.usage},
+					},
+				}},
+			}}
+		},
+		"GET /v1/project/my-project/service-types/pg/plans/business-4": fakeResponse(http.StatusOK, map[string]any{
+			"disk_space_mb":      614400,
+			"disk_space_cap_mb":  1843200,
+			"disk_space_step_mb": 30720,
+		}),
+		"PUT " + servicePath: func(r *http.Request, _ []string) (int, any) {
+			req := aiven.UpdateServiceRequest{}
+			f.decode(r, &req)
+			f.updates = append(f.updates, req)
+			f.disk = req.DiskSpaceMB
+			return http.StatusOK, map[string]any{"service": map[string]any{"state": "RUNNING"}}
+		},
+	})
+	return f
+}
+
+func TestGenericServiceHandlerAutoscaleDisk(t *testing.T) {
+	api := newFakeDiskUsageAPI(t, 80, 614400)
+	avn := newFakeAivenClient(api)
+	rec := record.NewFakeRecorder(10)
+	h := newGenericServiceHandler(context.Background(), newPostgresSQLAdapter, nil, nil, rec, ServiceTagsConfig{})
+	service := &v1alpha1.PostgreSQL{ObjectMeta: metav1.ObjectMeta{Name: "my-pg", Namespace: "default"}}
+	service.Spec.Project = "my-project"
+	service.Spec.AutoscaleDisk = &v1alpha1.AutoscaleDisk{
+		Step:         "40GiB",
+		MaxDiskSpace: "680GiB",
+		Cooldown:     &metav1.Duration{Duration: time.Hour},
+	}
+
+	// Below the default threshold
+	_, err := h.get(avn, service)
+	require.NoError(t, err)
+	assert.Empty(t, api.updates)
+
+	// The step is rounded up to the plan's step
+	api.usage = 90.5
+	_, err = h.get(avn, service)
+	require.NoError(t, err)
+	require.Len(t, api.updates, 1)
+	assert.Equal(t, 675840, api.updates[0].DiskSpaceMB)
+	assert.Equal(t, "business-4", api.updates[0].Plan)
+	assert.True(t, api.updates[0].Powered)
+	require.Len(t, service.Status.DiskAutoscaleSteps, 1)
+	assert.Equal(t, 90, service.Status.DiskAutoscaleSteps[0].UsagePercent)
+	assert.Equal(t, "600GiB", service.Status.DiskAutoscaleSteps[0].FromDiskSpace)
+	assert.Equal(t, "660GiB", service.Status.DiskAutoscaleSteps[0].ToDiskSpace)
+	assert.Equal(t, "Normal DiskAutoscaled Disk usage is 90%, disk space increased from 600GiB to 660GiB", <-rec.Events)
+
+	// Cooldown
+	_, err = h.get(avn, service)
+	require.NoError(t, err)
+	assert.Len(t, api.updates, 1)
+
+	// The maximum is reached, the next valid size is above it
+	service.Status.DiskAutoscaleSteps[0].Time = metav1.NewTime(time.Now().Add(-2 * time.Hour))
+	_, err = h.get(avn, service)
+	require.NoError(t, err)
+	assert.Len(t, api.updates, 1)
+	c := meta.FindStatusCondition(service.Status.Conditions, conditionTypeDiskAutoscale)
+	require.NotNil(t, c)
+	assert.Equal(t, eventDiskAutoscaleLimit, c.Reason)
+	assert.Equal(t, "Warning DiskAutoscaleLimitReached Disk usage is 90%, disk space 660GiB can't be increased", <-rec.Events)
+
+	// The warning is sent once
+	_, err = h.get(avn, service)
+	require.NoError(t, err)
+	assert.Empty(t, rec.Events)
+
+	// The policy is removed, the autoscaled disk space is kept
+	service.Spec.AutoscaleDisk = nil
+	require.NoError(t, h.createOrUpdate(avn, service, nil))
+	require.Len(t, api.updates, 2)
+	assert.Equal(t, 675840, api.updates[1].DiskSpaceMB)
+
+	// The spec may still increase it
+	service.Spec.DiskSpace = "700GiB"
+	require.NoError(t, h.createOrUpdate(avn, service, nil))
+	require.Len(t, api.updates, 3)
+	assert.Equal(t, 716800, api.updates[2].DiskSpaceMB)
+}
+
+func TestNextDiskSpace(t *testing.T) {
+	plan := &aiven.GetServicePlanResponse{DiskSpaceMB: 614400, DiskSpaceCapMB: 1843200, DiskSpaceStepMB: 30720}
+	cases := []struct {
+		name     string
+		current  int
+		step     int
+		max      int
+		expected int
+	}{
+		{name: "plan step", current: 614400, expected: 645120},
+		{name: "rounded up step", current: 614400, step: 40960, expected: 675840},
+		{name: "limited by max", current: 614400, step: 307200, max: 716800, expected: 706560},
+		{name: "limited by plan", current: 1843200, step: 30720, expected: 1843200},
+		{name: "max is below current", current: 706560, max: 650000, expected: 706560},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expected, nextDiskSpace(c.current, plan, c.step, c.max))
+		})
+	}
+
+	// Plan doesn't support custom disk space
+	assert.Equal(t, 0, nextDiskSpace(0, &aiven.GetServicePlanResponse{}, 1024, 0))
+}
@@ -0,0 +1,276 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+const (
+	// ipFilterFromInterval how often ipFilterFrom addresses are discovered
+	ipFilterFromInterval = 5 * time.Minute
+	// ipFilterFromDebounce default time the addresses must not change before they are applied
+	ipFilterFromDebounce = 5 * time.Minute
+
+	conditionTypeIPFilterFrom = "IPFilterFrom"
+	eventIPFilterFromApplied  = "IPFilterFromApplied"
+	eventIPFilterFromEmpty    = "IPFilterFromEmpty"
+)
+
+// +kubebuilder:rbac:groups="",resources=nodes;services;configmaps,verbs=get;list;watch
+
+// discoverIPFilter returns sorted unique CIDRs found by ipFilterFrom.
+// The reader is not cached, so only the referenced objects are read, and the operator doesn't watch all of them
+func discoverIPFilter(ctx context.Context, k8s client.Reader, namespace string, from *v1alpha1.IPFilterFrom) ([]string, error) {
+	addresses := make([]string, 0)
+	if from.NodeSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(from.NodeSelector)
+		if err != nil {
+			return nil, err
+		}
+
+		nodes := new(corev1.NodeList)
+		err = k8s.List(ctx, nodes, client.MatchingLabelsSelector{Selector: selector})
+		if err != nil {
+			return nil, fmt.Errorf("unable to list nodes: %w", err)
+		}
+
+		for _, n := range nodes.Items {
+			for _, a := range n.Status.Addresses {
+				if a.Type == corev1.NodeExternalIP {
+					addresses = append(addresses, a.Address)
+				}
+			}
+		}
+	}
+
+	for _, ref := range from.ServiceRefs {
+		key := types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}
+		if key.Namespace == "" {
+			key.Namespace = namespace
+		}
+
+		s := new(corev1.Service)
+		err := k8s.Get(ctx, key, s)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get Service %q: %w", ref.Name, err)
+		}
+
+		for _, i := range s.Status.LoadBalancer.Ingress {
+			if i.IP != "" {
+				addresses = append(addresses, i.IP)
+			}
+		}
+	}
+
+	for _, ref := range from.ConfigMapKeyRefs {
+		c := new(corev1.ConfigMap)
+		err := k8s.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: namespace}, c)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get ConfigMap %q: %w", ref.Name, err)
+		}
+
+		v, ok := c.Data[ref.Key]
+		if !ok {
+			return nil, fmt.Errorf("ConfigMap %q has no key %q", ref.Name, ref.Key)
+		}
+
+		addresses = append(addresses, strings.FieldsFunc(v, func(r rune) bool {
+			return r == ',' || r == ' ' || r == '\n' || r == '\t' || r == '\r'
+		})...)
+	}
+
+	unique := make(map[string]bool, len(addresses))
+	result := make([]string, 0, len(addresses))
+	for _, a := range addresses {
+		cidr, err := toCIDR(a)
+		if err != nil {
+			return nil, err
+		}
+
+		if !unique[cidr] {
+			unique[cidr] = true
+			result = append(result, cidr)
+		}
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+// toCIDR returns the network of a CIDR, or a single address network for an IP
+func toCIDR(s string) (string, error) {
+	if ip := net.ParseIP(s); ip != nil {
+		if ip.To4() != nil {
+			return ip.String() + "/32", nil
+		}
+		return ip.String() + "/128", nil
+	}
+
+	_, network, err := net.ParseCIDR(s)
+	if err != nil {
+		return "", fmt.Errorf("invalid ipFilterFrom address %q", s)
+	}
+	return network.String(), nil
+}
+
+// mergeIPFilter adds the networks to the user config ip_filter, the existing entries are kept
+func mergeIPFilter(userConfig map[string]any, networks []string) map[string]any {
+	if len(networks) == 0 {
+		return userConfig
+	}
+
+	if userConfig == nil {
+		userConfig = make(map[string]any)
+	}
+
+	filter, _ := userConfig["ip_filter"].([]any)
+	existing := make(map[string]bool, len(filter))
+	for _, f := range filter {
+		switch v := f.(type) {
+		case string:
+			existing[v] = true
+		case map[string]any:
+			if n, ok := v["network"].(string); ok {
+				existing[n] = true
+			}
+		}
+	}
+
+	for _, n := range networks {
+		if !existing[n] {
+			filter = append(filter, map[string]any{"network": n, "description": "ipFilterFrom"})
+		}
+	}
+	userConfig["ip_filter"] = filter
+	return userConfig
+}
+
+// ipFilterFrom discovers the addresses to apply.
+// If none are found, the last applied ones are returned, so the allowlist is never emptied
+func (h *genericServiceHandler) ipFilterFrom(object client.Object, o serviceAdapter) ([]string, error) {
+	from := o.getServiceCommonSpec().IPFilterFrom
+	status := o.getServiceStatus()
+	if status.IPFilterFrom == nil {
+		status.IPFilterFrom = new(v1alpha1.IPFilterFromStatus)
+	}
+
+	networks, err := discoverIPFilter(h.ctx, h.reader, object.GetNamespace(), from)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(networks) == 0 {
+		h.setIPFilterFromEmpty(object, status)
+		return status.IPFilterFrom.Applied, nil
+	}
+
+	message := fmt.Sprintf("%d addresses are added to ip_filter", len(networks))
+	if !equalStrings(status.IPFilterFrom.Applied, networks) {
+		h.rec.Event(object, corev1.EventTypeNormal, eventIPFilterFromApplied, message)
+	}
+
+	meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:    conditionTypeIPFilterFrom,
+		Status:  metav1.ConditionTrue,
+		Reason:  eventIPFilterFromApplied,
+		Message: message,
+	})
+	status.IPFilterFrom = &v1alpha1.IPFilterFromStatus{Applied: networks}
+	return networks, nil
+}
+
+// checkIPFilterFrom returns true if the discovered addresses have changed
+// and haven't changed since for the debounce period
+func (h *genericServiceHandler) checkIPFilterFrom(object client.Object, o serviceAdapter) (bool, error) {
+	from := o.getServiceCommonSpec().IPFilterFrom
+	status := o.getServiceStatus()
+	if status.IPFilterFrom == nil {
+		status.IPFilterFrom = new(v1alpha1.IPFilterFromStatus)
+	}
+
+	networks, err := discoverIPFilter(h.ctx, h.reader, object.GetNamespace(), from)
+	if err != nil {
+		return false, err
+	}
+
+	st := status.IPFilterFrom
+	switch {
+	case len(networks) == 0:
+		h.setIPFilterFromEmpty(object, status)
+		st.Pending, st.PendingSince = nil, nil
+		return false, nil
+	case equalStrings(networks, st.Applied):
+		st.Pending, st.PendingSince = nil, nil
+		return false, nil
+	case !equalStrings(networks, st.Pending) || st.PendingSince == nil:
+		now := metav1.Now()
+		st.Pending, st.PendingSince = networks, &now
+		return false, nil
+	}
+	return time.Since(st.PendingSince.Time) >= ipFilterFromDebounceOf(from), nil
+}
+
+func (h *genericServiceHandler) setIPFilterFromEmpty(object client.Object, status *v1alpha1.ServiceStatus) {
+	message := "No addresses are discovered, the last applied ones are kept"
+	c := meta.FindStatusCondition(status.Conditions, conditionTypeIPFilterFrom)
+	if c == nil || c.Reason != eventIPFilterFromEmpty {
+		h.rec.Event(object, corev1.EventTypeWarning, eventIPFilterFromEmpty, message)
+	}
+
+	meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:    conditionTypeIPFilterFrom,
+		Status:  metav1.ConditionFalse,
+		Reason:  eventIPFilterFromEmpty,
+		Message: message,
+	})
+}
+
+// nextIPFilterFromCheck returns the time until the next discovery
+func nextIPFilterFromCheck(spec *v1alpha1.ServiceCommonSpec, status *v1alpha1.ServiceStatus) time.Duration {
+	if status.IPFilterFrom == nil || status.IPFilterFrom.PendingSince == nil {
+		return ipFilterFromInterval
+	}
+
+	d := ipFilterFromDebounceOf(spec.IPFilterFrom) - time.Since(status.IPFilterFrom.PendingSince.Time)
+	if d < time.Second {
+		d = time.Second
+	}
+
+	if d > ipFilterFromInterval {
+		d = ipFilterFromInterval
+	}
+	return d
+}
+
+func ipFilterFromDebounceOf(from *v1alpha1.IPFilterFrom) time.Duration {
+	if from.Debounce != nil {
+		return from.Debounce.Duration
+	}
+	return ipFilterFromDebounce
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,158 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+	pguserconfig "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/service/pg"
+)
+
+func TestGenericServiceHandlerIPFilterFrom(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	node := func(name, pool, ip string) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"pool": pool}},
+			Status: corev1.NodeStatus{Addresses: []corev1.NodeAddress{
+				{Type: corev1.NodeInternalIP, Address: "10.0.0.1"},
+				{Type: corev1.NodeExternalIP, Address: ip},
+			}},
+		}
+	}
+	egress := node("node-1", "egress", "203.0.113.10")
+	k8s := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		egress,
+		node("node-2", "egress", "203.0.113.11"),
+		node("node-3", "default", "203.0.113.12"),
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "ingress", Namespace: "default"},
+			Status: corev1.ServiceStatus{LoadBalancer: corev1.LoadBalancerStatus{Ingress: []corev1.LoadBalancerIngress{
+				{IP: "198.51.100.5"}, {Hostname: "lb.example.com"},
+			}}},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "office", Namespace: "default"},
+			Data:       map[string]string{"cidrs": "192.0.2.0/24, 192.0.2.7\n203.0.113.11"},
+		},
+	).Build()
+
+	var updates []aiven.UpdateServiceRequest
+	var api *fakeAivenAPI
+	api = newFakeAivenAPI(t, fakeRoutes{
+		"GET /v1/project/my-project/service/my-pg": fakeResponse(http.StatusOK, map[string]any{"service": map[string]any{"state": "RUNNING", "powered": true}}),
+		"PUT /v1/project/my-project/service/my-pg": func(r *http.Request, _ []string) (int, any) {
+			req := aiven.UpdateServiceRequest{}
+			api.decode(r, &req)
+			updates = append(updates, req)
+			return http.StatusOK, map[string]any{"service": map[string]any{"state": "RUNNING"}}
+		},
+	})
+	avn := newFakeAivenClient(api)
+
+	rec := record.NewFakeRecorder(10)
+	h := newGenericServiceHandler(context.Background(), newPostgresSQLAdapter, k8s, k8s, rec, ServiceTagsConfig{})
+	service := &v1alpha1.PostgreSQL{ObjectMeta: metav1.ObjectMeta{Name: "my-pg", Namespace: "default", Generation: 1}}
+	service.Spec.Project = "my-project"
+	service.Spec.UserConfig = &pguserconfig.PgUserConfig{IpFilter: []*pguserconfig.IpFilter{{Network: "192.0.2.0/24"}}}
+	service.Spec.IPFilterFrom = &v1alpha1.IPFilterFrom{
+		NodeSelector:     &metav1.LabelSelector{MatchLabels: map[string]string{"pool": "egress"}},
+		ServiceRefs:      []v1alpha1.ResourceReference{{Name: "ingress"}},
+		ConfigMapKeyRefs: []v1alpha1.ConfigMapKeyReference{{Name: "office", Key: "cidrs"}},
+	}
+	ipFilter := func(req aiven.UpdateServiceRequest) []string {
+		networks := make([]string, 0)
+		for _, f := range req.UserConfig["ip_filter"].([]any) {
+			networks = append(networks, f.(map[string]any)["network"].(string))
+		}
+		return networks
+	}
+
+	// Merges the static and the discovered addresses
+	require.NoError(t, h.createOrUpdate(avn, service, nil))
+	require.Len(t, updates, 1)
+	assert.Equal(t, []string{"192.0.2.0/24", "192.0.2.7/32", "198.51.100.5/32", "203.0.113.10/32", "203.0.113.11/32"}, ipFilter(updates[0]))
+	assert.Equal(t, []string{"192.0.2.0/24", "192.0.2.7/32", "198.51.100.5/32", "203.0.113.10/32", "203.0.113.11/32"}, service.Status.IPFilterFrom.Applied)
+	assert.Equal(t, "Normal IPFilterFromApplied 5 addresses are added to ip_filter", <-rec.Events)
+
+	// Nothing changed
+	_, err := h.checkPreconditions(avn, service)
+	require.NoError(t, err)
+	assert.True(t, isAlreadyProcessed(service))
+	assert.Nil(t, service.Status.IPFilterFrom.Pending)
+
+	// The node is replaced, waits for the debounce period
+	egress.Status.Addresses[1].Address = "203.0.113.20"
+	require.NoError(t, k8s.Status().Update(context.Background(), egress))
+	_, err = h.checkPreconditions(avn, service)
+	require.NoError(t, err)
+	assert.True(t, isAlreadyProcessed(service))
+	assert.Contains(t, service.Status.IPFilterFrom.Pending, "203.0.113.20/32")
+	assert.Less(t, nextIPFilterFromCheck(&service.Spec.ServiceCommonSpec, &service.Status), ipFilterFromInterval)
+
+	_, err = h.checkPreconditions(avn, service)
+	require.NoError(t, err)
+	assert.True(t, isAlreadyProcessed(service))
+
+	// Not changed for the debounce period, goes for the update
+	since := metav1.NewTime(time.Now().Add(-ipFilterFromDebounce))
+	service.Status.IPFilterFrom.PendingSince = &since
+	_, err = h.checkPreconditions(avn, service)
+	require.NoError(t, err)
+	assert.False(t, isAlreadyProcessed(service))
+
+	require.NoError(t, h.createOrUpdate(avn, service, nil))
+	require.Len(t, updates, 2)
+	assert.Contains(t, ipFilter(updates[1]), "203.0.113.20/32")
+	assert.NotContains(t, ipFilter(updates[1]), "203.0.113.10/32")
+	assert.Nil(t, service.Status.IPFilterFrom.Pending)
+
+	// Nothing is discovered, keeps the last applied addresses
+	applied := service.Status.IPFilterFrom.Applied
+	service.Generation = 2
+	service.Spec.IPFilterFrom = &v1alpha1.IPFilterFrom{
+		NodeSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"pool": "removed"}},
+	}
+	_, err = h.checkPreconditions(avn, service)
+	require.NoError(t, err)
+	require.NoError(t, h.createOrUpdate(avn, service, nil))
+	require.Len(t, updates, 3)
+	assert.Equal(t, applied, ipFilter(updates[2]))
+	c := meta.FindStatusCondition(service.Status.Conditions, conditionTypeIPFilterFrom)
+	require.NotNil(t, c)
+	assert.Equal(t, metav1.ConditionFalse, c.Status)
+	assert.Equal(t, eventIPFilterFromEmpty, c.Reason)
+}
+
+func TestToCIDR(t *testing.T) {
+	cases := map[string]string{
+		"192.0.2.7":       "192.0.2.7/32",
+		"192.0.2.7/24":    "192.0.2.0/24",
+		"2001:db8::1":     "2001:db8::1/128",
+		"2001:db8::/32":   "2001:db8::/32",
+		"2001:db8::1/128": "2001:db8::1/128",
+	}
+	for in, expected := range cases {
+		actual, err := toCIDR(in)
+		require.NoError(t, err)
+		assert.Equal(t, expected, actual)
+	}
+
+	_, err := toCIDR("lb.example.com")
+	assert.EqualError(t, err, `invalid ipFilterFrom address "lb.example.com"`)
+}
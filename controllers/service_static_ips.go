@@ -0,0 +1,164 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"fmt"
+
+	"github.com/aiven/aiven-go-client"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+const (
+	staticIPStateAssigned = "assigned"
+
+	eventStaticIPsEnabled  = "StaticIPsEnabled"
+	eventStaticIPsDisabled = "StaticIPsDisabled"
+)
+
+// staticIPIDs returns the ids of the StaticIP resources from staticIPRefs
+func (h *genericServiceHandler) staticIPIDs(namespace string, refs []v1alpha1.ResourceReference) ([]string, error) {
+	ids := make([]string, 0, len(refs))
+	for i := range refs {
+		ip := new(v1alpha1.StaticIP)
+		err := h.k8s.Get(h.ctx, refs[i].StaticIP(namespace).NamespacedName, ip)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get StaticIP %q: %w", refs[i].Name, err)
+		}
+
+		if ip.Status.ID == "" {
+			return nil, fmt.Errorf("StaticIP %q is not created yet", refs[i].Name)
+		}
+		ids = append(ids, ip.Status.ID)
+	}
+	return ids, nil
+}
+
+// syncStaticIPs associates the static IPs from staticIPRefs and dissociates the removed ones.
+// The static_ips user config is disabled before the addresses in use are dissociated,
+// and is enabled once all static IPs are associated.
+// Returns false if it waits for the service to stop using the addresses
+func (h *genericServiceHandler) syncStaticIPs(a *aiven.Client, object client.Object, o serviceAdapter, s *aiven.Service) (bool, error) {
+	spec := o.getServiceCommonSpec()
+	status := o.getServiceStatus()
+	if len(spec.StaticIPRefs) == 0 && len(status.StaticIPs) == 0 {
+		return true, nil
+	}
+
+	ids, err := h.staticIPIDs(object.GetNamespace(), spec.StaticIPRefs)
+	if err != nil {
+		return false, err
+	}
+
+	list, err := a.StaticIPs.List(spec.Project)
+	if err != nil {
+		return false, fmt.Errorf("failed to list static IPs: %w", err)
+	}
+
+	serviceName := o.getObjectMeta().Name
+	associated := make(map[string]aiven.StaticIP)
+	for _, ip := range list.StaticIPs {
+		if ip.ServiceName == serviceName {
+			associated[ip.StaticIPAddressID] = ip
+		}
+	}
+
+	wanted := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		wanted[id] = true
+	}
+
+	enabled, _ := s.UserConfig["static_ips"].(bool)
+	removed := make([]aiven.StaticIP, 0)
+	for _, id := range status.StaticIPs {
+		if ip, ok := associated[id]; ok && !wanted[id] {
+			removed = append(removed, ip)
+		}
+	}
+
+	// Addresses in use can't be dissociated, disables static IPs first
+	for _, ip := range removed {
+		if ip.State != staticIPStateAssigned {
+			continue
+		}
+
+		if enabled {
+			err = h.setStaticIPs(a, o, s, false)
+			if err != nil {
+				return false, err
+			}
+			h.rec.Event(object, corev1.EventTypeNormal, eventStaticIPsDisabled, "static_ips is disabled to dissociate the removed static IPs")
+		}
+		return false, nil
+	}
+
+	for _, ip := range removed {
+		err = a.StaticIPs.Dissociate(spec.Project, ip.StaticIPAddressID)
+		if err != nil && !aiven.IsNotFound(err) {
+			return false, fmt.Errorf("failed to dissociate static IP %s: %w", ip.IPAddress, err)
+		}
+	}
+
+	for _, id := range ids {
+		if _, ok := associated[id]; ok {
+			continue
+		}
+
+		err = a.StaticIPs.Associate(spec.Project, id, aiven.AssociateStaticIPRequest{ServiceName: serviceName})
+		if err != nil {
+			return false, fmt.Errorf("failed to associate static IP %s: %w", id, err)
+		}
+	}
+
+	status.StaticIPs = ids
+	if len(ids) == 0 {
+		status.StaticIPs = nil
+	}
+
+	if len(ids) > 0 && !enabled {
+		err = h.setStaticIPs(a, o, s, true)
+		if err != nil {
+			return false, err
+		}
+		h.rec.Event(object, corev1.EventTypeNormal, eventStaticIPsEnabled, fmt.Sprintf("static_ips is enabled with %d static IPs", len(ids)))
+	}
+	return true, nil
+}
+
+// setStaticIPs toggles the static_ips user config, keeps the current settings
+func (h *genericServiceHandler) setStaticIPs(a *aiven.Client, o serviceAdapter, s *aiven.Service, enabled bool) error {
+	_, err := a.Services.Update(o.getServiceCommonSpec().Project, o.getObjectMeta().Name, aiven.UpdateServiceRequest{
+		Plan:                  s.Plan,
+		DiskSpaceMB:           s.DiskSpaceMB,
+		Powered:               s.Powered,
+		ProjectVPCID:          s.ProjectVPCID,
+		TerminationProtection: s.TerminationProtection,
+		UserConfig:            map[string]any{"static_ips": enabled},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set static_ips: %w", err)
+	}
+
+	if s.UserConfig == nil {
+		s.UserConfig = make(map[string]any)
+	}
+	s.UserConfig["static_ips"] = enabled
+	return nil
+}
+
+// keepStaticIPs keeps the current static_ips user config, as it is toggled by syncStaticIPs
+func keepStaticIPs(userConfig, current map[string]any) map[string]any {
+	if userConfig == nil {
+		userConfig = make(map[string]any)
+	}
+
+	if v, ok := current["static_ips"]; ok {
+		userConfig["static_ips"] = v
+	} else {
+		delete(userConfig, "static_ips")
+	}
+	return userConfig
+}
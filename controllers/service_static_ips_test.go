@@ -0,0 +1,168 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// fakeStaticIPsAPI keeps static IPs and a running PostgreSQL service.
+// Associated static IPs are in use while static_ips is enabled
+type fakeStaticIPsAPI struct {
+	*fakeAivenAPI
+	ips       map[string]*aiven.StaticIP
+	staticIPs bool
+	updates   []aiven.UpdateServiceRequest
+}
+
+func newFakeStaticIPsAPI(t *testing.T, ids ...string) *fakeStaticIPsAPI {
+	const (
+		servicePath   = "/v1/project/my-project/service/my-pg"
+		staticIPsPath = "/v1/project/my-project/static-ips"
+	)
+
+	f := &fakeStaticIPsAPI{ips: make(map[string]*aiven.StaticIP)}
+	for _, id := range ids {
+		f.ips[id] = &aiven.StaticIP{StaticIPAddressID: id, IPAddress: "203.0.113." + strings.TrimPrefix(id, "ip"), State: "created"}
+	}
+	f.fakeAivenAPI = newFakeAivenAPI(t, fakeRoutes{
+		"GET " + servicePath: func(*http.Request, []string) (int, any) {
+			return http.StatusOK, map[string]any{"service": map[string]any{
+				"state":       "RUNNING",
+				"powered":     true,
+				"user_config": map[string]any{"static_ips": f.staticIPs},
+			}}
+		},
+		"PUT " + servicePath: func(r *http.Request, _ []string) (int, any) {
+			req := aiven.UpdateServiceRequest{}
+			f.decode(r, &req)
+			f.updates = append(f.updates, req)
+			f.staticIPs = req.UserConfig["static_ips"].(bool)
+			f.call("static_ips=%t", f.staticIPs)
+			for _, ip := range f.ips {
+				if ip.ServiceName != "" {
+					ip.State = map[bool]string{true: "assigned", false: "available"}[f.staticIPs]
+				}
+			}
+			return http.StatusOK, map[string]any{"service": map[string]any{"state": "RUNNING"}}
+		},
+		"GET " + staticIPsPath: func(*http.Request, []string) (int, any) {
+			list := make([]*aiven.StaticIP, 0, len(f.ips))
+			for _, ip := range f.ips {
+				list = append(list, ip)
+			}
+			return http.StatusOK, map[string]any{"static_ips": list}
+		},
+		"POST " + staticIPsPath: func(*http.Request, []string) (int, any) {
+			f.ips["ip9"] = &aiven.StaticIP{StaticIPAddressID: "ip9", IPAddress: "203.0.113.9", State: "creating"}
+			return http.StatusOK, f.ips["ip9"]
+		},
+		"POST " + staticIPsPath + "/*/association": f.ip(func(ip *aiven.StaticIP) {
+			f.call("associate %s", ip.StaticIPAddressID)
+			ip.ServiceName, ip.State = "my-pg", "available"
+		}),
+		"DELETE " + staticIPsPath + "/*/association": f.ip(func(ip *aiven.StaticIP) {
+			require.NotEqual(f.t, "assigned", ip.State, "static IP in use can't be dissociated")
+			f.call("dissociate %s", ip.StaticIPAddressID)
+			ip.ServiceName, ip.State = "", "created"
+		}),
+		"DELETE " + staticIPsPath + "/*": f.ip(func(ip *aiven.StaticIP) {
+			f.call("delete %s", ip.StaticIPAddressID)
+			delete(f.ips, ip.StaticIPAddressID)
+		}),
+	})
+	return f
+}
+
+// ip changes the static IP with the given handler, returns 404 for unknown IPs
+func (f *fakeStaticIPsAPI) ip(change func(ip *aiven.StaticIP)) fakeHandler {
+	return func(r *http.Request, params []string) (int, any) {
+		ip, ok := f.ips[params[0]]
+		if !ok {
+			return fakeNotFound(r, params)
+		}
+		change(ip)
+		return http.StatusOK, map[string]any{}
+	}
+}
+
+func TestGenericServiceHandlerStaticIPs(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	staticIP := func(name, id string) *v1alpha1.StaticIP {
+		return &v1alpha1.StaticIP{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Status:     v1alpha1.StaticIPStatus{ID: id},
+		}
+	}
+	k8s := fake.NewClientBuilder().WithScheme(scheme).WithObjects(staticIP("ip-1", "ip1"), staticIP("ip-2", "ip2")).Build()
+	api := newFakeStaticIPsAPI(t, "ip1", "ip2")
+	avn := newFakeAivenClient(api)
+	rec := record.NewFakeRecorder(10)
+	h := newGenericServiceHandler(context.Background(), newPostgresSQLAdapter, k8s, k8s, rec, ServiceTagsConfig{})
+	service := &v1alpha1.PostgreSQL{ObjectMeta: metav1.ObjectMeta{Name: "my-pg", Namespace: "default"}}
+	service.Spec.Project = "my-project"
+	service.Spec.StaticIPRefs = []v1alpha1.ResourceReference{{Name: "ip-1"}, {Name: "ip-2"}}
+
+	// Associates, then enables
+	_, err := h.get(avn, service)
+	require.NoError(t, err)
+	assert.True(t, IsAlreadyRunning(service))
+	assert.Equal(t, []string{"associate ip1", "associate ip2", "static_ips=true"}, api.calls)
+	assert.Equal(t, []string{"ip1", "ip2"}, service.Status.StaticIPs)
+	assert.Equal(t, "Normal StaticIPsEnabled static_ips is enabled with 2 static IPs", <-rec.Events)
+
+	// Nothing changed
+	api.calls = nil
+	_, err = h.get(avn, service)
+	require.NoError(t, err)
+	assert.Empty(t, api.calls)
+
+	// The removed one is in use, disables and waits
+	service.Spec.StaticIPRefs = service.Spec.StaticIPRefs[:1]
+	_, err = h.get(avn, service)
+	require.NoError(t, err)
+	assert.False(t, IsAlreadyRunning(service))
+	assert.Equal(t, []string{"static_ips=false"}, api.calls)
+	assert.Equal(t, "Normal StaticIPsDisabled static_ips is disabled to dissociate the removed static IPs", <-rec.Events)
+
+	// Dissociates, then enables again
+	api.calls = nil
+	_, err = h.get(avn, service)
+	require.NoError(t, err)
+	assert.True(t, IsAlreadyRunning(service))
+	assert.Equal(t, []string{"dissociate ip2", "static_ips=true"}, api.calls)
+	assert.Equal(t, []string{"ip1"}, service.Status.StaticIPs)
+	assert.Equal(t, "Normal StaticIPsEnabled static_ips is enabled with 1 static IPs", <-rec.Events)
+
+	// All removed
+	api.calls = nil
+	service.Spec.StaticIPRefs = nil
+	_, err = h.get(avn, service)
+	require.NoError(t, err)
+	_, err = h.get(avn, service)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"static_ips=false", "dissociate ip1"}, api.calls)
+	assert.Nil(t, service.Status.StaticIPs)
+	assert.False(t, api.staticIPs)
+}
+
+func TestKeepStaticIPs(t *testing.T) {
+	userConfig := map[string]any{"static_ips": true, "pg_version": "15"}
+	assert.Equal(t, map[string]any{"pg_version": "15"}, keepStaticIPs(userConfig, nil))
+	assert.Equal(t, map[string]any{"static_ips": false}, keepStaticIPs(nil, map[string]any{"static_ips": false}))
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/aiven/aiven-go-client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	serviceTagCluster   = "k8s-cluster"
+	serviceTagNamespace = "k8s-namespace"
+)
+
+// ServiceTagsConfig mirrors Kubernetes metadata into the services tags, e.g. for cost allocation.
+// Tags set in the spec take precedence
+type ServiceTagsConfig struct {
+	// Labels keys to mirror. The tag key is the label name without the prefix,
+	// or is set explicitly with "label=tag", e.g. "example.com/team=team"
+	Labels []string
+	// Annotations keys to mirror, same format as Labels
+	Annotations []string
+	// ClusterName is added as the "k8s-cluster" tag when not empty
+	ClusterName string
+	// Namespace adds the object namespace as the "k8s-namespace" tag
+	Namespace bool
+}
+
+// ParseServiceTagsKeys parses comma separated list of keys, like "team,example.com/cost-center=cost-center"
+func ParseServiceTagsKeys(s string) []string {
+	keys := make([]string, 0)
+	for _, k := range strings.Split(s, ",") {
+		k = strings.TrimSpace(k)
+		if k != "" {
+			keys = append(keys, k)
+		}
+	}
+	return keys
+}
+
+// tags returns the service tags: the mirrored metadata merged with the spec tags
+func (c ServiceTagsConfig) tags(m *metav1.ObjectMeta, specTags map[string]string) map[string]string {
+	tags := make(map[string]string)
+	if c.ClusterName != "" {
+		tags[serviceTagCluster] = c.ClusterName
+	}
+
+	if c.Namespace && m.Namespace != "" {
+		tags[serviceTagNamespace] = m.Namespace
+	}
+
+	mirrorServiceTags(tags, c.Annotations, m.Annotations)
+	mirrorServiceTags(tags, c.Labels, m.Labels)
+	for k, v := range specTags {
+		tags[k] = v
+	}
+	return tags
+}
+
+func mirrorServiceTags(tags map[string]string, keys []string, values map[string]string) {
+	for _, k := range keys {
+		key, tag := k, ""
+		if i := strings.LastIndex(k, "="); i != -1 {
+			key, tag = k[:i], k[i+1:]
+		}
+
+		v, ok := values[key]
+		if !ok {
+			continue
+		}
+
+		if tag == "" {
+			tag = toServiceTagKey(key)
+		}
+		tags[tag] = v
+	}
+}
+
+// toServiceTagKey drops the key prefix, like "example.com/", and replaces characters tags don't support
+func toServiceTagKey(key string) string {
+	if i := strings.LastIndex(key, "/"); i != -1 {
+		key = key[i+1:]
+	}
+
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_', r == '-':
+			return r
+		}
+		return '_'
+	}, key)
+}
+
+// syncServiceTags sets the service tags and removes the ones it set before which are gone.
+// Tags added outside the operator are kept
+func (h *genericServiceHandler) syncServiceTags(a *aiven.Client, o serviceAdapter) error {
+	spec := o.getServiceCommonSpec()
+	status := o.getServiceStatus()
+	tags := h.tags.tags(o.getObjectMeta(), spec.Tags)
+	if len(tags) == 0 && len(status.Tags) == 0 {
+		return nil
+	}
+
+	serviceName := o.getObjectMeta().Name
+	current, err := a.ServiceTags.Get(spec.Project, serviceName)
+	if err != nil {
+		return fmt.Errorf("failed to get service tags: %w", err)
+	}
+
+	next := make(map[string]string, len(current.Tags)+len(tags))
+	for k, v := range current.Tags {
+		if _, ok := status.Tags[k]; !ok {
+			next[k] = v
+		}
+	}
+
+	for k, v := range tags {
+		next[k] = v
+	}
+
+	if len(next) != len(current.Tags) || (len(next) > 0 && !reflect.DeepEqual(next, current.Tags)) {
+		_, err = a.ServiceTags.Set(spec.Project, serviceName, aiven.ServiceTagsRequest{Tags: next})
+		if err != nil {
+			return fmt.Errorf("failed to set service tags: %w", err)
+		}
+	}
+
+	status.Tags = tags
+	if len(tags) == 0 {
+		status.Tags = nil
+	}
+	return nil
+}
@@ -33,11 +33,28 @@ func (r *ServiceIntegrationReconciler) Reconcile(ctx context.Context, req ctrl.R
 }
 
 func (r *ServiceIntegrationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	err := mgr.GetFieldIndexer().IndexField(context.Background(), &v1alpha1.ServiceIntegration{}, serviceIntegrationIDIndexKey, serviceIntegrationIDIndexFunc)
+	if err != nil {
+		return fmt.Errorf("unable to add index for integration id: %w", err)
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.ServiceIntegration{}).
 		Complete(r)
 }
 
+// serviceIntegrationIDIndexKey indexes integrations by their Aiven id,
+// services look up integrations managed by the ServiceIntegration kind
+const serviceIntegrationIDIndexKey = "status.id"
+
+func serviceIntegrationIDIndexFunc(o client.Object) []string {
+	si, ok := o.(*v1alpha1.ServiceIntegration)
+	if !ok || si.Status.ID == "" {
+		return nil
+	}
+	return []string{si.Status.ID}
+}
+
 func (h ServiceIntegrationHandler) createOrUpdate(avn *aiven.Client, i client.Object, refs []client.Object) error {
 	si, err := h.convert(i)
 	if err != nil {
@@ -57,14 +74,24 @@ func (h ServiceIntegrationHandler) createOrUpdate(avn *aiven.Client, i client.Ob
 			return err
 		}
 
+		sourceEndpointID, err := getEndpointID(refs, si.Spec.SourceEndpointID, si.Spec.SourceEndpointRef, si.Namespace)
+		if err != nil {
+			return err
+		}
+
+		destinationEndpointID, err := getEndpointID(refs, si.Spec.DestinationEndpointID, si.Spec.DestinationEndpointRef, si.Namespace)
+		if err != nil {
+			return err
+		}
+
 		integration, err = avn.ServiceIntegrations.Create(
 			si.Spec.Project,
 			aiven.CreateServiceIntegrationRequest{
-				DestinationEndpointID: anyOptional(si.Spec.DestinationEndpointID),
+				DestinationEndpointID: anyOptional(destinationEndpointID),
 				DestinationService:    anyOptional(si.Spec.DestinationServiceName),
 				DestinationProject:    anyOptional(si.Spec.DestinationProjectName),
 				IntegrationType:       si.Spec.IntegrationType,
-				SourceEndpointID:      anyOptional(si.Spec.SourceEndpointID),
+				SourceEndpointID:      anyOptional(sourceEndpointID),
 				SourceService:         anyOptional(si.Spec.SourceServiceName),
 				SourceProject:         anyOptional(si.Spec.SourceProjectName),
 				UserConfig:            userConfigMap,
@@ -151,7 +178,17 @@ func (h ServiceIntegrationHandler) checkPreconditions(avn *aiven.Client, i clien
 	meta.SetStatusCondition(&si.Status.Conditions,
 		getInitializedCondition("Preconditions", "Checking preconditions"))
 
-	// todo: validate SourceEndpointID, DestinationEndpointID when ServiceIntegrationEndpoint kind released
+	// Endpoints from references are ready at this point, validates the ones set by ID
+	for _, id := range []string{si.Spec.SourceEndpointID, si.Spec.DestinationEndpointID} {
+		if id == "" {
+			continue
+		}
+
+		_, err = avn.ServiceIntegrationEndpoints.Get(si.Spec.Project, id)
+		if err != nil {
+			return false, fmt.Errorf("cannot get service integration endpoint %q: %w", id, err)
+		}
+	}
 
 	if si.Spec.SourceServiceName != "" {
 		project := si.Spec.SourceProjectName
@@ -178,6 +215,22 @@ func (h ServiceIntegrationHandler) checkPreconditions(avn *aiven.Client, i clien
 	return true, nil
 }
 
+// getEndpointID returns the endpoint ID, or the ID of the referenced ServiceIntegrationEndpoint
+func getEndpointID(refs []client.Object, id string, ref *v1alpha1.ResourceReference, namespace string) (string, error) {
+	if ref == nil {
+		return id, nil
+	}
+
+	name := ref.ServiceIntegrationEndpoint(namespace).NamespacedName
+	for _, o := range refs {
+		e, ok := o.(*v1alpha1.ServiceIntegrationEndpoint)
+		if ok && e.Name == name.Name && e.Namespace == name.Namespace {
+			return e.Status.ID, nil
+		}
+	}
+	return "", fmt.Errorf("referenced ServiceIntegrationEndpoint %q not found", ref.Name)
+}
+
 func (h ServiceIntegrationHandler) convert(i client.Object) (*v1alpha1.ServiceIntegration, error) {
 	si, ok := i.(*v1alpha1.ServiceIntegration)
 	if !ok {
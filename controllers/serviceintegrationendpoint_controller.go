@@ -0,0 +1,301 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aiven/aiven-go-client"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// ServiceIntegrationEndpointReconciler reconciles a ServiceIntegrationEndpoint object
+type ServiceIntegrationEndpointReconciler struct {
+	Controller
+}
+
+//+kubebuilder:rbac:groups=aiven.io,resources=serviceintegrationendpoints,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=aiven.io,resources=serviceintegrationendpoints/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=aiven.io,resources=serviceintegrationendpoints/finalizers,verbs=update
+
+func (r *ServiceIntegrationEndpointReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.reconcileInstance(ctx, req, serviceIntegrationEndpointHandler{ctx: ctx, k8s: r.Client}, &v1alpha1.ServiceIntegrationEndpoint{})
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ServiceIntegrationEndpointReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	err := mgr.GetFieldIndexer().IndexField(
+		context.Background(), &v1alpha1.ServiceIntegrationEndpoint{}, serviceIntegrationEndpointConfigFromIndexKey, serviceIntegrationEndpointConfigFromIndexFunc,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to add index for configFrom fields: %w", err)
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.ServiceIntegrationEndpoint{}).
+		Watches(&source.Kind{Type: &corev1.Secret{}}, handler.EnqueueRequestsFromMapFunc(r.findEndpointsBySecret)).
+		Complete(r)
+}
+
+// serviceIntegrationEndpointConfigFromIndexKey indexes secrets used in configFrom
+const serviceIntegrationEndpointConfigFromIndexKey = "spec.configFrom.secretKeyRef.name"
+
+func serviceIntegrationEndpointConfigFromIndexFunc(o client.Object) []string {
+	endpoint, ok := o.(*v1alpha1.ServiceIntegrationEndpoint)
+	if !ok {
+		return nil
+	}
+
+	names := make([]string, 0, len(endpoint.Spec.ConfigFrom))
+	for _, c := range endpoint.Spec.ConfigFrom {
+		names = append(names, c.SecretKeyRef.Name)
+	}
+	return names
+}
+
+// findEndpointsBySecret returns endpoints which use the secret in configFrom
+func (r *ServiceIntegrationEndpointReconciler) findEndpointsBySecret(secret client.Object) []reconcile.Request {
+	list := &v1alpha1.ServiceIntegrationEndpointList{}
+	err := r.List(context.Background(), list, client.InNamespace(secret.GetNamespace()), client.MatchingFields{serviceIntegrationEndpointConfigFromIndexKey: secret.GetName()})
+	if err != nil {
+		r.Log.Error(err, "unable to list service integration endpoints", "secret", secret.GetName())
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(list.Items))
+	for _, item := range list.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: item.Name, Namespace: item.Namespace},
+		})
+	}
+	return requests
+}
+
+type serviceIntegrationEndpointHandler struct {
+	ctx context.Context
+	k8s client.Client
+}
+
+func (h serviceIntegrationEndpointHandler) createOrUpdate(avn *aiven.Client, obj client.Object, _ []client.Object) error {
+	endpoint, err := h.convert(obj)
+	if err != nil {
+		return err
+	}
+
+	userConfig, err := endpoint.GetUserConfig()
+	if err != nil {
+		return err
+	}
+
+	secrets, err := h.resolveConfigFrom(endpoint)
+	if err != nil {
+		return err
+	}
+
+	var reason string
+	if endpoint.Status.ID == "" {
+		userConfigMap, err := h.buildUserConfig(userConfig, secrets, []string{"create", "update"})
+		if err != nil {
+			return err
+		}
+
+		e, err := avn.ServiceIntegrationEndpoints.Create(endpoint.Spec.Project, aiven.CreateServiceIntegrationEndpointRequest{
+			EndpointName: endpoint.Spec.EndpointName,
+			EndpointType: endpoint.Spec.EndpointType,
+			UserConfig:   userConfigMap,
+		})
+		if err != nil {
+			return fmt.Errorf("cannot create service integration endpoint: %w", err)
+		}
+
+		endpoint.Status.ID = e.EndpointID
+		reason = "Created"
+	} else {
+		userConfigMap, err := h.buildUserConfig(userConfig, secrets, []string{"update"})
+		if err != nil {
+			return err
+		}
+
+		_, err = avn.ServiceIntegrationEndpoints.Update(endpoint.Spec.Project, endpoint.Status.ID, aiven.UpdateServiceIntegrationEndpointRequest{
+			UserConfig: userConfigMap,
+		})
+		if err != nil && !strings.Contains(err.Error(), "user config not changed") {
+			return fmt.Errorf("cannot update service integration endpoint: %w", err)
+		}
+		reason = "Updated"
+	}
+
+	endpoint.Status.ConfigHash = configHash(endpoint.UID, secrets)
+
+	meta.SetStatusCondition(&endpoint.Status.Conditions,
+		getInitializedCondition(reason,
+			"Instance was created or update on Aiven side"))
+
+	meta.SetStatusCondition(&endpoint.Status.Conditions,
+		getRunningCondition(metav1.ConditionUnknown, reason,
+			"Instance was created or update on Aiven side, status remains unknown"))
+
+	metav1.SetMetaDataAnnotation(&endpoint.ObjectMeta,
+		processedGenerationAnnotation, strconv.FormatInt(endpoint.GetGeneration(), formatIntBaseDecimal))
+
+	return nil
+}
+
+// buildUserConfig returns the user config with the values from configFrom
+func (h serviceIntegrationEndpointHandler) buildUserConfig(userConfig any, secrets map[string]string, groups []string) (map[string]any, error) {
+	m, err := UserConfigurationToAPIV2(userConfig, groups)
+	if err != nil {
+		return nil, err
+	}
+
+	if m == nil {
+		m = make(map[string]any)
+	}
+
+	for k, v := range secrets {
+		m[k] = v
+	}
+	return m, nil
+}
+
+// resolveConfigFrom returns the configFrom values.
+// Errors must not contain the values, they end up in events
+func (h serviceIntegrationEndpointHandler) resolveConfigFrom(endpoint *v1alpha1.ServiceIntegrationEndpoint) (map[string]string, error) {
+	values := make(map[string]string, len(endpoint.Spec.ConfigFrom))
+	for _, c := range endpoint.Spec.ConfigFrom {
+		v, err := getSecretValue(h.ctx, h.k8s, endpoint.Namespace, c.SecretKeyRef.Name, c.SecretKeyRef.Key)
+		if err != nil {
+			return nil, fmt.Errorf("unable to resolve configFrom for key '%s': %w", c.Key, err)
+		}
+		values[c.Key] = v
+	}
+	return values, nil
+}
+
+func (h serviceIntegrationEndpointHandler) delete(avn *aiven.Client, obj client.Object) (bool, error) {
+	endpoint, err := h.convert(obj)
+	if err != nil {
+		return false, err
+	}
+
+	if endpoint.Status.ID == "" {
+		return true, nil
+	}
+
+	// Integrations must be deleted first, otherwise they get orphaned
+	names, err := h.integrations(endpoint)
+	if err != nil {
+		return false, err
+	}
+
+	if len(names) > 0 {
+		return false, fmt.Errorf("%w: endpoint is used by service integrations %s", v1alpha1.ErrDeleteDependencies, strings.Join(names, ", "))
+	}
+
+	err = avn.ServiceIntegrationEndpoints.Delete(endpoint.Spec.Project, endpoint.Status.ID)
+	if err != nil && !aiven.IsNotFound(err) {
+		return false, fmt.Errorf("aiven client delete service integration endpoint error: %w", err)
+	}
+
+	return true, nil
+}
+
+// integrations returns ServiceIntegrations which use the endpoint by ID or by reference
+func (h serviceIntegrationEndpointHandler) integrations(endpoint *v1alpha1.ServiceIntegrationEndpoint) ([]string, error) {
+	list := &v1alpha1.ServiceIntegrationList{}
+	err := h.k8s.List(h.ctx, list)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list service integrations: %w", err)
+	}
+
+	key := types.NamespacedName{Name: endpoint.Name, Namespace: endpoint.Namespace}
+	names := make([]string, 0)
+	for _, item := range list.Items {
+		used := item.Spec.Project == endpoint.Spec.Project &&
+			(item.Spec.SourceEndpointID == endpoint.Status.ID || item.Spec.DestinationEndpointID == endpoint.Status.ID)
+		for _, ref := range item.GetRefs() {
+			if ref.GroupVersionKind.Kind == "ServiceIntegrationEndpoint" && ref.NamespacedName == key {
+				used = true
+			}
+		}
+
+		if used {
+			names = append(names, item.Namespace+"/"+item.Name)
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func (h serviceIntegrationEndpointHandler) get(avn *aiven.Client, obj client.Object) (*corev1.Secret, error) {
+	endpoint, err := h.convert(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = avn.ServiceIntegrationEndpoints.Get(endpoint.Spec.Project, endpoint.Status.ID)
+	if aiven.IsNotFound(err) {
+		// Deleted out-of-band, creates it again
+		endpoint.Status.ID = ""
+		delete(endpoint.Annotations, processedGenerationAnnotation)
+		delete(endpoint.Annotations, instanceIsRunningAnnotation)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	meta.SetStatusCondition(&endpoint.Status.Conditions,
+		getRunningCondition(metav1.ConditionTrue, "CheckRunning",
+			"Instance is running on Aiven side"))
+
+	metav1.SetMetaDataAnnotation(&endpoint.ObjectMeta, instanceIsRunningAnnotation, "true")
+
+	return nil, nil
+}
+
+func (h serviceIntegrationEndpointHandler) checkPreconditions(_ *aiven.Client, obj client.Object) (bool, error) {
+	endpoint, err := h.convert(obj)
+	if err != nil {
+		return false, err
+	}
+
+	meta.SetStatusCondition(&endpoint.Status.Conditions,
+		getInitializedCondition("Preconditions", "Checking preconditions"))
+
+	// The secrets might have changed, then the config must be applied again
+	if isAlreadyProcessed(endpoint) && len(endpoint.Spec.ConfigFrom) > 0 {
+		secrets, err := h.resolveConfigFrom(endpoint)
+		if err != nil {
+			return false, err
+		}
+
+		if configHash(endpoint.UID, secrets) != endpoint.Status.ConfigHash {
+			delete(endpoint.Annotations, processedGenerationAnnotation)
+		}
+	}
+	return true, nil
+}
+
+func (h serviceIntegrationEndpointHandler) convert(i client.Object) (*v1alpha1.ServiceIntegrationEndpoint, error) {
+	endpoint, ok := i.(*v1alpha1.ServiceIntegrationEndpoint)
+	if !ok {
+		return nil, fmt.Errorf("cannot convert object to ServiceIntegrationEndpoint")
+	}
+
+	return endpoint, nil
+}
@@ -0,0 +1,185 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+	datadogendpoint "github.com/aiven/aiven-operator/api/v1alpha1/userconfig/integrationendpoint/datadog"
+)
+
+// fakeIntegrationEndpointAPI keeps a single endpoint and records integrations
+type fakeIntegrationEndpointAPI struct {
+	*fakeAivenAPI
+	userConfig   map[string]any
+	exists       bool
+	integrations []aiven.CreateServiceIntegrationRequest
+}
+
+func newFakeIntegrationEndpointAPI(t *testing.T) *fakeIntegrationEndpointAPI {
+	const endpointsPath = "/v1/project/my-project/integration_endpoint"
+
+	f := new(fakeIntegrationEndpointAPI)
+	f.fakeAivenAPI = newFakeAivenAPI(t, fakeRoutes{
+		"POST " + endpointsPath: func(r *http.Request, _ []string) (int, any) {
+			req := aiven.CreateServiceIntegrationEndpointRequest{}
+			f.decode(r, &req)
+			f.userConfig, f.exists = req.UserConfig, true
+			return http.StatusOK, map[string]any{"service_integration_endpoint": map[string]any{"endpoint_id": "my-endpoint-id"}}
+		},
+		"GET " + endpointsPath: func(*http.Request, []string) (int, any) {
+			list := make([]map[string]any, 0)
+			if f.exists {
+				list = append(list, map[string]any{"endpoint_id": "my-endpoint-id"})
+			}
+			return http.StatusOK, map[string]any{"service_integration_endpoints": list}
+		},
+		"GET " + endpointsPath + "/my-endpoint-id": f.endpoint(func(*http.Request) {}),
+		"PUT " + endpointsPath + "/my-endpoint-id": f.endpoint(func(r *http.Request) {
+			req := aiven.UpdateServiceIntegrationEndpointRequest{}
+			f.decode(r, &req)
+			f.userConfig = req.UserConfig
+		}),
+		"DELETE " + endpointsPath + "/my-endpoint-id": f.endpoint(func(*http.Request) {
+			f.exists = false
+		}),
+		"POST /v1/project/my-project/integration": func(r *http.Request, _ []string) (int, any) {
+			req := aiven.CreateServiceIntegrationRequest{}
+			f.decode(r, &req)
+			f.integrations = append(f.integrations, req)
+			return http.StatusOK, map[string]any{"service_integration": map[string]any{"service_integration_id": "my-integration-id"}}
+		},
+	})
+	return f
+}
+
+// endpoint changes the endpoint with the given handler, returns 404 when it doesn't exist
+func (f *fakeIntegrationEndpointAPI) endpoint(change func(r *http.Request)) fakeHandler {
+	return func(r *http.Request, params []string) (int, any) {
+		if !f.exists {
+			return fakeNotFound(r, params)
+		}
+		change(r)
+		return http.StatusOK, map[string]any{"service_integration_endpoint": map[string]any{"endpoint_id": "my-endpoint-id"}}
+	}
+}
+
+func TestServiceIntegrationEndpointHandler(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "datadog", Namespace: "default"},
+		Data:       map[string][]byte{"api-key": []byte("0123456789abcdef0123456789abcdef")},
+	}
+	k8s := fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build()
+	api := newFakeIntegrationEndpointAPI(t)
+	avn := newFakeAivenClient(api)
+	h := serviceIntegrationEndpointHandler{ctx: context.Background(), k8s: k8s}
+
+	site := "datadoghq.eu"
+	endpoint := &v1alpha1.ServiceIntegrationEndpoint{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-datadog", Namespace: "default", UID: "uid", Generation: 1},
+		Spec: v1alpha1.ServiceIntegrationEndpointSpec{
+			Project:           "my-project",
+			EndpointName:      "my-datadog",
+			EndpointType:      "datadog",
+			DatadogUserConfig: &datadogendpoint.DatadogUserConfig{Site: &site},
+		},
+	}
+
+	// The URL may be set with configFrom
+	elasticsearch := &v1alpha1.ServiceIntegrationEndpoint{Spec: v1alpha1.ServiceIntegrationEndpointSpec{EndpointType: "external_elasticsearch_logs"}}
+	assert.EqualError(t, elasticsearch.ValidateCreate(), `endpoint type "external_elasticsearch_logs" requires "url", set it in the user config or with configFrom`)
+	elasticsearch.Spec.ConfigFrom = []v1alpha1.ServiceIntegrationEndpointConfigFrom{
+		{Key: "url", SecretKeyRef: v1alpha1.ServiceIntegrationEndpointKeySelector{Name: "elasticsearch", Key: "url"}},
+	}
+	require.NoError(t, elasticsearch.ValidateCreate())
+
+	// The API key is required
+	assert.EqualError(t, endpoint.ValidateCreate(), `endpoint type "datadog" requires "datadog_api_key", set it in the user config or with configFrom`)
+	endpoint.Spec.ConfigFrom = []v1alpha1.ServiceIntegrationEndpointConfigFrom{
+		{Key: "datadog_api_key", SecretKeyRef: v1alpha1.ServiceIntegrationEndpointKeySelector{Name: "datadog", Key: "api-key"}},
+	}
+	require.NoError(t, endpoint.ValidateCreate())
+
+	// Creates with the secret value
+	require.NoError(t, h.createOrUpdate(avn, endpoint, nil))
+	assert.Equal(t, "my-endpoint-id", endpoint.Status.ID)
+	assert.Equal(t, map[string]any{"site": "datadoghq.eu", "datadog_api_key": "0123456789abcdef0123456789abcdef"}, api.userConfig)
+	assert.NotContains(t, endpoint.Status.ConfigHash, "0123456789abcdef")
+	_, err := h.get(avn, endpoint)
+	require.NoError(t, err)
+	assert.True(t, IsAlreadyRunning(endpoint))
+
+	// Nothing changed
+	_, err = h.checkPreconditions(avn, endpoint)
+	require.NoError(t, err)
+	assert.True(t, isAlreadyProcessed(endpoint))
+
+	// The secret changed, goes for the update
+	secret.Data["api-key"] = []byte("fedcba9876543210fedcba9876543210")
+	require.NoError(t, k8s.Update(context.Background(), secret))
+	_, err = h.checkPreconditions(avn, endpoint)
+	require.NoError(t, err)
+	assert.False(t, isAlreadyProcessed(endpoint))
+
+	require.NoError(t, h.createOrUpdate(avn, endpoint, nil))
+	assert.Equal(t, "fedcba9876543210fedcba9876543210", api.userConfig["datadog_api_key"])
+
+	// The integration uses the referenced endpoint ID
+	integration := &v1alpha1.ServiceIntegration{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-integration", Namespace: "default"},
+		Spec: v1alpha1.ServiceIntegrationSpec{
+			Project:                "my-project",
+			IntegrationType:        "datadog",
+			SourceServiceName:      "my-kafka",
+			DestinationEndpointRef: &v1alpha1.ResourceReference{Name: "my-datadog"},
+		},
+	}
+	require.NoError(t, integration.ValidateCreate())
+	assert.Len(t, integration.GetRefs(), 1)
+	require.NoError(t, ServiceIntegrationHandler{}.createOrUpdate(avn, integration, []client.Object{endpoint}))
+	require.Len(t, api.integrations, 1)
+	assert.Equal(t, "my-endpoint-id", *api.integrations[0].DestinationEndpointID)
+
+	integration.Spec.DestinationEndpointID = "my-endpoint-id"
+	assert.EqualError(t, integration.ValidateCreate(), "please set destinationEndpointId or destinationEndpointRef, not both")
+
+	// Deleted out-of-band, comes back
+	api.exists = false
+	_, err = h.get(avn, endpoint)
+	assert.True(t, aiven.IsNotFound(err))
+	assert.Empty(t, endpoint.Status.ID)
+	assert.False(t, isAlreadyProcessed(endpoint))
+
+	require.NoError(t, h.createOrUpdate(avn, endpoint, nil))
+
+	// Can't be deleted while the integration uses it
+	integration.Spec.DestinationEndpointID = ""
+	require.NoError(t, k8s.Create(context.Background(), integration))
+	deleted, err := h.delete(avn, endpoint)
+	assert.False(t, deleted)
+	assert.True(t, errors.Is(err, v1alpha1.ErrDeleteDependencies))
+	assert.EqualError(t, err, "object has dependencies and cannot be deleted: endpoint is used by service integrations default/my-integration")
+	assert.True(t, api.exists)
+
+	require.NoError(t, k8s.Delete(context.Background(), integration))
+	deleted, err = h.delete(avn, endpoint)
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	assert.False(t, api.exists)
+}
@@ -5,6 +5,8 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/url"
 	"strconv"
 
 	"github.com/aiven/aiven-go-client"
@@ -13,6 +15,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"github.com/aiven/aiven-operator/api/v1alpha1"
 )
@@ -22,19 +26,30 @@ type ServiceUserReconciler struct {
 	Controller
 }
 
-type ServiceUserHandler struct{}
+type ServiceUserHandler struct {
+	ctx context.Context
+	k8s client.Client
+}
 
 // +kubebuilder:rbac:groups=aiven.io,resources=serviceusers,verbs=update;get;list;watch;create;delete
 // +kubebuilder:rbac:groups=aiven.io,resources=serviceusers/status,verbs=get;update
 // +kubebuilder:rbac:groups=aiven.io,resources=serviceusers/finalizers,verbs=update
 
 func (r *ServiceUserReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	return r.reconcileInstance(ctx, req, ServiceUserHandler{}, &v1alpha1.ServiceUser{})
+	return r.reconcileInstance(ctx, req, ServiceUserHandler{ctx: ctx, k8s: r.Client}, &v1alpha1.ServiceUser{})
 }
 
 func (r *ServiceUserReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	err := mgr.GetFieldIndexer().IndexField(context.Background(), &v1alpha1.ServiceUser{}, passwordSecretRefIndexKey, passwordSecretRefIndexFunc)
+	if err != nil {
+		return fmt.Errorf("unable to add index for password secret ref: %w", err)
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.ServiceUser{}).
+		Watches(&source.Kind{Type: &corev1.Secret{}}, handler.EnqueueRequestsFromMapFunc(
+			findObjectsByPasswordSecret(r.Client, func() client.ObjectList { return &v1alpha1.ServiceUserList{} }),
+		)).
 		Complete(r)
 }
 
@@ -46,15 +61,14 @@ func (h ServiceUserHandler) createOrUpdate(avn *aiven.Client, i client.Object, r
 
 	u, err := avn.ServiceUsers.Create(user.Spec.Project, user.Spec.ServiceName,
 		aiven.CreateServiceUserRequest{
-			Username: user.Name,
-			AccessControl: &aiven.AccessControl{
-				RedisACLCategories: []string{},
-				RedisACLCommands:   []string{},
-				RedisACLChannels:   []string{},
-				RedisACLKeys:       []string{},
-			},
+			Username:       user.Name,
+			Authentication: optionalStringPointer(user.Spec.Authentication),
+			AccessControl:  toAivenAccessControl(user.Spec.AccessControl),
 		})
-	if err != nil && !aiven.IsAlreadyExists(err) {
+	if aiven.IsAlreadyExists(err) {
+		u, err = h.update(avn, user)
+	}
+	if err != nil {
 		return fmt.Errorf("cannot createOrUpdate service user on aiven side: %w", err)
 	}
 
@@ -76,6 +90,41 @@ func (h ServiceUserHandler) createOrUpdate(avn *aiven.Client, i client.Object, r
 	return nil
 }
 
+// update applies the authentication method and access control to the existing user
+func (h ServiceUserHandler) update(avn *aiven.Client, user *v1alpha1.ServiceUser) (*aiven.ServiceUser, error) {
+	current, err := getServiceUser(h.ctx, avn, user.Spec.Project, user.Spec.ServiceName, user.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	u := &current.ServiceUser
+
+	// Changing the method resets the password, so it goes only when it differs
+	if user.Spec.Authentication != "" && user.Spec.Authentication != current.Authentication {
+		u, err = avn.ServiceUsers.Update(user.Spec.Project, user.Spec.ServiceName, user.Name,
+			aiven.ModifyServiceUserRequest{
+				Operation:      anyOptional(aiven.UpdateOperationResetCredentials),
+				Authentication: &user.Spec.Authentication,
+			})
+		if err != nil {
+			return nil, fmt.Errorf("cannot update authentication: %w", err)
+		}
+	}
+
+	// Removed access control is reset to the rules of a new user
+	if user.Spec.AccessControl != nil || user.Status.AccessControl != nil {
+		u, err = avn.ServiceUsers.Update(user.Spec.Project, user.Spec.ServiceName, user.Name,
+			aiven.ModifyServiceUserRequest{
+				Operation:     anyOptional(aiven.UpdateOperationSetAccessControl),
+				AccessControl: toAivenAccessControl(user.Spec.AccessControl),
+			})
+		if err != nil {
+			return nil, fmt.Errorf("cannot update access control: %w", err)
+		}
+	}
+	return u, nil
+}
+
 func (h ServiceUserHandler) delete(avn *aiven.Client, i client.Object) (bool, error) {
 	user, err := h.convert(i)
 	if err != nil {
@@ -96,10 +145,31 @@ func (h ServiceUserHandler) get(avn *aiven.Client, i client.Object) (*corev1.Sec
 		return nil, err
 	}
 
-	u, err := avn.ServiceUsers.Get(user.Spec.Project, user.Spec.ServiceName, user.Name)
+	u, err := getServiceUser(h.ctx, avn, user.Spec.Project, user.Spec.ServiceName, user.Name)
 	if err != nil {
 		return nil, err
 	}
+	password, err := getPasswordFromSecret(h.k8s, user)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sets the password on create and when the secret changes
+	if password != "" && password != u.Password {
+		updated, err := avn.ServiceUsers.Update(user.Spec.Project, user.Spec.ServiceName, user.Name,
+			aiven.ModifyServiceUserRequest{
+				Operation:      anyOptional(aiven.UpdateOperationResetCredentials),
+				Authentication: optionalStringPointer(user.Spec.Authentication),
+				NewPassword:    &password,
+			})
+		if err != nil {
+			return nil, fmt.Errorf("cannot set password: %w", err)
+		}
+		u.ServiceUser = *updated
+	}
+
+	user.Status.Authentication = u.Authentication
+	user.Status.AccessControl = fromAivenAccessControl(u.AccessControl)
 
 	s, err := avn.Services.Get(user.Spec.Project, user.Spec.ServiceName)
 	if err != nil {
@@ -152,3 +222,67 @@ func (h ServiceUserHandler) convert(i client.Object) (*v1alpha1.ServiceUser, err
 
 	return db, nil
 }
+
+// serviceUser extends aiven.ServiceUser with the authentication method
+type serviceUser struct {
+	aiven.ServiceUser
+	Authentication string `json:"authentication"`
+}
+
+// getServiceUser gets the user directly: aiven.ServiceUsersHandler.Get reads the whole service
+// and its users don't have the authentication method
+func getServiceUser(ctx context.Context, avn *aiven.Client, project, serviceName, userName string) (*serviceUser, error) {
+	path := fmt.Sprintf(
+		"/project/%s/service/%s/user/%s",
+		url.PathEscape(project), url.PathEscape(serviceName), url.PathEscape(userName),
+	)
+	var rsp struct {
+		User *serviceUser `json:"user"`
+	}
+
+	err := doAivenRequest(ctx, avn, http.MethodGet, path, nil, &rsp)
+	if err != nil {
+		return nil, err
+	}
+
+	if rsp.User == nil {
+		return nil, aiven.Error{Message: fmt.Sprintf("service user %q not found", userName), Status: http.StatusNotFound}
+	}
+	return rsp.User, nil
+}
+
+// toAivenAccessControl returns empty rules if nothing is set
+func toAivenAccessControl(ac *v1alpha1.ServiceUserAccessControl) *aiven.AccessControl {
+	if ac == nil {
+		ac = new(v1alpha1.ServiceUserAccessControl)
+	}
+
+	// Empty lists must be sent, nil values are omitted
+	orEmpty := func(v []string) []string {
+		if v == nil {
+			return []string{}
+		}
+		return v
+	}
+
+	return &aiven.AccessControl{
+		RedisACLCategories: orEmpty(ac.RedisACLCategories),
+		RedisACLCommands:   orEmpty(ac.RedisACLCommands),
+		RedisACLKeys:       orEmpty(ac.RedisACLKeys),
+		RedisACLChannels:   orEmpty(ac.RedisACLChannels),
+	}
+}
+
+// fromAivenAccessControl returns nil for services without access control and users without rules
+func fromAivenAccessControl(ac aiven.AccessControl) *v1alpha1.ServiceUserAccessControl {
+	if len(ac.RedisACLCategories) == 0 && len(ac.RedisACLCommands) == 0 && len(ac.RedisACLKeys) == 0 && len(ac.RedisACLChannels) == 0 {
+		return nil
+	}
+
+	return &v1alpha1.ServiceUserAccessControl{
+		RedisACLCategories: ac.RedisACLCategories,
+		RedisACLCommands:   ac.RedisACLCommands,
+		RedisACLKeys:       ac.RedisACLKeys,
+		RedisACLChannels:   ac.RedisACLChannels,
+	}
+}
@@ -0,0 +1,190 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// fakeServiceUserAPI keeps a single user of a Redis service
+type fakeServiceUserAPI struct {
+	*fakeAivenAPI
+	user    map[string]any
+	updates []aiven.ModifyServiceUserRequest
+}
+
+func newFakeServiceUserAPI(t *testing.T, user map[string]any) *fakeServiceUserAPI {
+	const usersPath = "/v1/project/my-project/service/my-redis/user"
+
+	f := &fakeServiceUserAPI{user: user}
+	f.fakeAivenAPI = newFakeAivenAPI(t, fakeRoutes{
+		"GET /v1/project/my-project/service/my-redis": fakeResponse(http.StatusOK, map[string]any{"service": map[string]any{
+			"state":              "RUNNING",
+			"service_uri_params": map[string]any{"host": "my-redis.aivencloud.com", "port": "12691"},
+		}}),
+		"GET /v1/project/my-project/kms/ca": fakeResponse(http.StatusOK, map[string]any{"certificate": "ca-cert"}),
+		"POST " + usersPath: func(r *http.Request, _ []string) (int, any) {
+			if f.user != nil {
+				return http.StatusConflict, map[string]any{"message": "already exists"}
+			}
+			req := new(aiven.CreateServiceUserRequest)
+			f.decode(r, req)
+			f.user = map[string]any{
+				"username":       req.Username,
+				"type":           "normal",
+				"authentication": fromAnyPointer(req.Authentication),
+				"access_control": req.AccessControl,
+			}
+			return http.StatusOK, map[string]any{"user": f.user}
+		},
+		"GET " + usersPath + "/my-user": func(r *http.Request, params []string) (int, any) {
+			if f.user == nil {
+				return fakeNotFound(r, params)
+			}
+			return http.StatusOK, map[string]any{"user": f.user}
+		},
+		"PUT " + usersPath + "/my-user": func(r *http.Request, _ []string) (int, any) {
+			req := aiven.ModifyServiceUserRequest{}
+			f.decode(r, &req)
+			f.updates = append(f.updates, req)
+			if req.Authentication != nil {
+				f.user["authentication"] = *req.Authentication
+			}
+			if req.NewPassword != nil {
+				f.user["password"] = *req.NewPassword
+			}
+			if req.AccessControl != nil {
+				f.user["access_control"] = req.AccessControl
+			}
+			return http.StatusOK, map[string]any{"service": map[string]any{"users": []any{f.user}}}
+		},
+	})
+	return f
+}
+
+func TestServiceUserHandlerAccessControl(t *testing.T) {
+	api := newFakeServiceUserAPI(t, nil)
+	avn := newFakeAivenClient(api)
+	user := &v1alpha1.ServiceUser{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-user", Generation: 1},
+		Spec: v1alpha1.ServiceUserSpec{
+			Project:        "my-project",
+			ServiceName:    "my-redis",
+			Authentication: "caching_sha2_password",
+			AccessControl: &v1alpha1.ServiceUserAccessControl{
+				RedisACLCategories: []string{"+@all", "-@dangerous"},
+				RedisACLKeys:       []string{"cache:*"},
+			},
+		},
+	}
+
+	// Creates with the authentication and access control
+	require.NoError(t, ServiceUserHandler{ctx: context.Background()}.createOrUpdate(avn, user, nil))
+	assert.Equal(t, "caching_sha2_password", api.user["authentication"])
+	assert.Equal(t, &aiven.AccessControl{
+		RedisACLCategories: []string{"+@all", "-@dangerous"},
+		RedisACLCommands:   []string{},
+		RedisACLKeys:       []string{"cache:*"},
+		RedisACLChannels:   []string{},
+	}, api.user["access_control"])
+	assert.Equal(t, "normal", user.Status.Type)
+
+	// Status shows the effective access control
+	_, err := ServiceUserHandler{ctx: context.Background()}.get(avn, user)
+	require.NoError(t, err)
+	assert.Equal(t, "caching_sha2_password", user.Status.Authentication)
+	assert.Equal(t, &v1alpha1.ServiceUserAccessControl{
+		RedisACLCategories: []string{"+@all", "-@dangerous"},
+		RedisACLCommands:   []string{},
+		RedisACLKeys:       []string{"cache:*"},
+		RedisACLChannels:   []string{},
+	}, user.Status.AccessControl)
+
+	// Updates in place, the authentication is not changed
+	user.Generation = 2
+	user.Spec.AccessControl.RedisACLCommands = []string{"-flushall"}
+	require.NoError(t, ServiceUserHandler{ctx: context.Background()}.createOrUpdate(avn, user, nil))
+	require.Len(t, api.updates, 1)
+	assert.Equal(t, aiven.UpdateOperationSetAccessControl, *api.updates[0].Operation)
+	assert.Equal(t, []string{"-flushall"}, api.updates[0].AccessControl.RedisACLCommands)
+
+	// Authentication method changed, access control removed
+	user.Generation = 3
+	user.Spec.Authentication = "mysql_native_password"
+	user.Spec.AccessControl = nil
+	require.NoError(t, ServiceUserHandler{ctx: context.Background()}.createOrUpdate(avn, user, nil))
+	require.Len(t, api.updates, 3)
+	assert.Equal(t, aiven.UpdateOperationResetCredentials, *api.updates[1].Operation)
+	assert.Equal(t, "mysql_native_password", *api.updates[1].Authentication)
+	assert.Equal(t, aiven.UpdateOperationSetAccessControl, *api.updates[2].Operation)
+	assert.Equal(t, &aiven.AccessControl{
+		RedisACLCategories: []string{},
+		RedisACLCommands:   []string{},
+		RedisACLKeys:       []string{},
+		RedisACLChannels:   []string{},
+	}, api.updates[2].AccessControl)
+	assert.True(t, isAlreadyProcessed(user))
+
+	// The effective access control is cleared
+	_, err = ServiceUserHandler{ctx: context.Background()}.get(avn, user)
+	require.NoError(t, err)
+	assert.Nil(t, user.Status.AccessControl)
+
+	// Nothing to reset
+	user.Generation = 4
+	require.NoError(t, ServiceUserHandler{ctx: context.Background()}.createOrUpdate(avn, user, nil))
+	assert.Len(t, api.updates, 3)
+}
+
+func TestServiceUserHandlerPasswordSecretRef(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "legacy-password", Namespace: "default"},
+		Data:       map[string][]byte{"password": []byte("vendor-password")},
+	}
+	k8s := fake.NewClientBuilder().WithObjects(secret).Build()
+	api := newFakeServiceUserAPI(t, map[string]any{"username": "my-user", "password": "generated"})
+	avn := newFakeAivenClient(api)
+	h := ServiceUserHandler{ctx: context.Background(), k8s: k8s}
+	user := &v1alpha1.ServiceUser{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-user", Namespace: "default"},
+		Spec: v1alpha1.ServiceUserSpec{
+			Project:           "my-project",
+			ServiceName:       "my-redis",
+			PasswordSecretRef: &v1alpha1.PasswordSecretReference{Name: "legacy-password", Key: "password"},
+		},
+	}
+
+	// Sets the password, the secret has the standard format
+	s, err := h.get(avn, user)
+	require.NoError(t, err)
+	require.Len(t, api.updates, 1)
+	assert.Equal(t, "vendor-password", *api.updates[0].NewPassword)
+	assert.Equal(t, "vendor-password", s.StringData["PASSWORD"])
+	assert.Equal(t, "my-user", s.StringData["USERNAME"])
+	assert.Equal(t, "my-user", s.Name)
+
+	// Nothing changed
+	_, err = h.get(avn, user)
+	require.NoError(t, err)
+	assert.Len(t, api.updates, 1)
+
+	// The secret is changed
+	secret.Data["password"] = []byte("new-password")
+	require.NoError(t, k8s.Update(context.Background(), secret))
+	s, err = h.get(avn, user)
+	require.NoError(t, err)
+	assert.Len(t, api.updates, 2)
+	assert.Equal(t, "new-password", s.StringData["PASSWORD"])
+
+	// Indexed by the secret
+	assert.Equal(t, []string{"legacy-password"}, passwordSecretRefIndexFunc(user))
+}
@@ -7,7 +7,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
-func SetupControllers(mgr ctrl.Manager, defaultToken string) error {
+func SetupControllers(mgr ctrl.Manager, defaultToken string, serviceTags ServiceTagsConfig) error {
 	if err := (&SecretFinalizerGCController{
 		Client: mgr.GetClient(),
 		Log:    ctrl.Log.WithName("controllers").WithName("SecretFinalizerGCController"),
@@ -22,7 +22,7 @@ func SetupControllers(mgr ctrl.Manager, defaultToken string) error {
 	}
 
 	if err := (&PostgreSQLReconciler{
-		Controller: newController(mgr, "PostgreSQL", defaultToken),
+		Controller: newServiceController(mgr, "PostgreSQL", defaultToken, serviceTags),
 	}).SetupWithManager(mgr); err != nil {
 		return fmt.Errorf("controller PostgreSQL: %w", err)
 	}
@@ -40,7 +40,7 @@ func SetupControllers(mgr ctrl.Manager, defaultToken string) error {
 	}
 
 	if err := (&KafkaReconciler{
-		Controller: newController(mgr, "Kafka", defaultToken),
+		Controller: newServiceController(mgr, "Kafka", defaultToken, serviceTags),
 	}).SetupWithManager(mgr); err != nil {
 		return fmt.Errorf("controller Kafka: %w", err)
 	}
@@ -64,7 +64,7 @@ func SetupControllers(mgr ctrl.Manager, defaultToken string) error {
 	}
 
 	if err := (&KafkaConnectReconciler{
-		Controller: newController(mgr, "KafkaConnect", defaultToken),
+		Controller: newServiceController(mgr, "KafkaConnect", defaultToken, serviceTags),
 	}).SetupWithManager(mgr); err != nil {
 		return fmt.Errorf("controller KafkaConnect: %w", err)
 	}
@@ -93,19 +93,19 @@ func SetupControllers(mgr ctrl.Manager, defaultToken string) error {
 	}
 
 	if err := (&RedisReconciler{
-		Controller: newController(mgr, "Redis", defaultToken),
+		Controller: newServiceController(mgr, "Redis", defaultToken, serviceTags),
 	}).SetupWithManager(mgr); err != nil {
 		return fmt.Errorf("controller Redis: %w", err)
 	}
 
 	if err := (&OpenSearchReconciler{
-		Controller: newController(mgr, "OpenSearch", defaultToken),
+		Controller: newServiceController(mgr, "OpenSearch", defaultToken, serviceTags),
 	}).SetupWithManager(mgr); err != nil {
 		return fmt.Errorf("controller OpenSearch: %w", err)
 	}
 
 	if err := (&ClickhouseReconciler{
-		Controller: newController(mgr, "Clickhouse", defaultToken),
+		Controller: newServiceController(mgr, "Clickhouse", defaultToken, serviceTags),
 	}).SetupWithManager(mgr); err != nil {
 		return fmt.Errorf("controller Clickhouse: %w", err)
 	}
@@ -117,23 +117,107 @@ func SetupControllers(mgr ctrl.Manager, defaultToken string) error {
 	}
 
 	if err := (&MySQLReconciler{
-		Controller: newController(mgr, "MySQL", defaultToken),
+		Controller: newServiceController(mgr, "MySQL", defaultToken, serviceTags),
 	}).SetupWithManager(mgr); err != nil {
 		return fmt.Errorf("controller MySQL: %w", err)
 	}
 
 	if err := (&CassandraReconciler{
-		Controller: newController(mgr, "Cassandra", defaultToken),
+		Controller: newServiceController(mgr, "Cassandra", defaultToken, serviceTags),
 	}).SetupWithManager(mgr); err != nil {
 		return fmt.Errorf("controller Cassandra: %w", err)
 	}
 
 	if err := (&GrafanaReconciler{
-		Controller: newController(mgr, "Grafana", defaultToken),
+		Controller: newServiceController(mgr, "Grafana", defaultToken, serviceTags),
 	}).SetupWithManager(mgr); err != nil {
 		return fmt.Errorf("controller Grafana: %w", err)
 	}
 
+	if err := (&ClickhouseRoleReconciler{
+		Controller: newController(mgr, "ClickhouseRole", defaultToken),
+	}).SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("controller ClickhouseRole: %w", err)
+	}
+
+	if err := (&ClickhouseGrantReconciler{
+		Controller: newController(mgr, "ClickhouseGrant", defaultToken),
+	}).SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("controller ClickhouseGrant: %w", err)
+	}
+
+	if err := (&ClickhouseDatabaseReconciler{
+		Controller: newController(mgr, "ClickhouseDatabase", defaultToken),
+	}).SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("controller ClickhouseDatabase: %w", err)
+	}
+
+	if err := (&StaticIPReconciler{
+		Controller: newController(mgr, "StaticIP", defaultToken),
+	}).SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("controller StaticIP: %w", err)
+	}
+
+	if err := (&ServiceIntegrationEndpointReconciler{
+		Controller: newController(mgr, "ServiceIntegrationEndpoint", defaultToken),
+	}).SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("controller ServiceIntegrationEndpoint: %w", err)
+	}
+
+	if err := (&VPCPeeringConnectionReconciler{
+		Controller: newController(mgr, "VPCPeeringConnection", defaultToken),
+	}).SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("controller VPCPeeringConnection: %w", err)
+	}
+
+	if err := (&AccountTeamReconciler{
+		Controller: newController(mgr, "AccountTeam", defaultToken),
+	}).SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("controller AccountTeam: %w", err)
+	}
+
+	if err := (&AccountTeamMemberReconciler{
+		Controller: newController(mgr, "AccountTeamMember", defaultToken),
+	}).SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("controller AccountTeamMember: %w", err)
+	}
+
+	if err := (&AccountTeamProjectReconciler{
+		Controller: newController(mgr, "AccountTeamProject", defaultToken),
+	}).SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("controller AccountTeamProject: %w", err)
+	}
+
+	if err := (&BillingGroupReconciler{
+		Controller: newController(mgr, "BillingGroup", defaultToken),
+	}).SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("controller BillingGroup: %w", err)
+	}
+
+	if err := (&FlinkReconciler{
+		Controller: newServiceController(mgr, "Flink", defaultToken, serviceTags),
+	}).SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("controller Flink: %w", err)
+	}
+
+	if err := (&FlinkApplicationReconciler{
+		Controller: newController(mgr, "FlinkApplication", defaultToken),
+	}).SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("controller FlinkApplication: %w", err)
+	}
+
+	if err := (&KafkaMirrorMakerReconciler{
+		Controller: newServiceController(mgr, "KafkaMirrorMaker", defaultToken, serviceTags),
+	}).SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("controller KafkaMirrorMaker: %w", err)
+	}
+
+	if err := (&KafkaReplicationFlowReconciler{
+		Controller: newController(mgr, "KafkaReplicationFlow", defaultToken),
+	}).SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("controller KafkaReplicationFlow: %w", err)
+	}
+
 	//+kubebuilder:scaffold:builder
 	return nil
 }
@@ -147,3 +231,10 @@ func newController(mgr ctrl.Manager, name, defaultToken string) Controller {
 		DefaultToken: defaultToken,
 	}
 }
+
+func newServiceController(mgr ctrl.Manager, name, defaultToken string, serviceTags ServiceTagsConfig) Controller {
+	c := newController(mgr, name, defaultToken)
+	c.ServiceTags = serviceTags
+	c.APIReader = mgr.GetAPIReader()
+	return c
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// staticIPResyncInterval how often the static IP association is checked
+const staticIPResyncInterval = 5 * time.Minute
+
+// StaticIPReconciler reconciles a StaticIP object
+type StaticIPReconciler struct {
+	Controller
+}
+
+//+kubebuilder:rbac:groups=aiven.io,resources=staticips,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=aiven.io,resources=staticips/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=aiven.io,resources=staticips/finalizers,verbs=update
+
+func (r *StaticIPReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	result, err := r.reconcileInstance(ctx, req, staticIPHandler{}, &v1alpha1.StaticIP{})
+
+	// Services associate and dissociate the static IP, comes back to update the status
+	if err == nil && result.IsZero() {
+		result.RequeueAfter = staticIPResyncInterval
+	}
+	return result, err
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *StaticIPReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.StaticIP{}).
+		Complete(r)
+}
+
+type staticIPHandler struct{}
+
+func (h staticIPHandler) createOrUpdate(avn *aiven.Client, obj client.Object, _ []client.Object) error {
+	ip, err := h.convert(obj)
+	if err != nil {
+		return err
+	}
+
+	// The spec is immutable, creates once
+	reason := "Updated"
+	if ip.Status.ID == "" {
+		reason = "Created"
+		r, err := avn.StaticIPs.Create(ip.Spec.Project, aiven.CreateStaticIPRequest{CloudName: ip.Spec.CloudName})
+		if err != nil {
+			return err
+		}
+
+		ip.Status.ID = r.StaticIPAddressID
+		ip.Status.IPAddress = r.IPAddress
+		ip.Status.State = r.State
+	}
+
+	meta.SetStatusCondition(&ip.Status.Conditions,
+		getInitializedCondition(reason,
+			"Instance was created or update on Aiven side"))
+
+	meta.SetStatusCondition(&ip.Status.Conditions,
+		getRunningCondition(metav1.ConditionUnknown, reason,
+			"Instance was created or update on Aiven side, status remains unknown"))
+
+	metav1.SetMetaDataAnnotation(&ip.ObjectMeta,
+		processedGenerationAnnotation, strconv.FormatInt(ip.GetGeneration(), formatIntBaseDecimal))
+
+	return nil
+}
+
+func (h staticIPHandler) delete(avn *aiven.Client, obj client.Object) (bool, error) {
+	ip, err := h.convert(obj)
+	if err != nil {
+		return false, err
+	}
+
+	if ip.Status.ID == "" {
+		return true, nil
+	}
+
+	s, err := avn.StaticIPs.Get(ip.Spec.Project, ip.Status.ID)
+	if aiven.IsNotFound(err) {
+		return true, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	switch s.State {
+	case "deleting", "deleted":
+		return true, nil
+	}
+
+	// The service must dissociate it first
+	if s.ServiceName != "" {
+		return false, fmt.Errorf("%w: static IP is associated with service %q", v1alpha1.ErrDeleteDependencies, s.ServiceName)
+	}
+
+	err = avn.StaticIPs.Delete(ip.Spec.Project, aiven.DeleteStaticIPRequest{StaticIPAddressID: ip.Status.ID})
+	if err != nil && !aiven.IsNotFound(err) {
+		return false, fmt.Errorf("failed to delete static IP: %w", err)
+	}
+	return true, nil
+}
+
+func (h staticIPHandler) get(avn *aiven.Client, obj client.Object) (*corev1.Secret, error) {
+	ip, err := h.convert(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := avn.StaticIPs.Get(ip.Spec.Project, ip.Status.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	ip.Status.IPAddress = s.IPAddress
+	ip.Status.State = s.State
+	ip.Status.ServiceName = s.ServiceName
+	switch s.State {
+	case "created", "available", "assigned":
+		meta.SetStatusCondition(&ip.Status.Conditions,
+			getRunningCondition(metav1.ConditionTrue, "CheckRunning",
+				"Instance is running on Aiven side"))
+
+		metav1.SetMetaDataAnnotation(&ip.ObjectMeta, instanceIsRunningAnnotation, "true")
+	}
+
+	return nil, nil
+}
+
+func (h staticIPHandler) checkPreconditions(_ *aiven.Client, _ client.Object) (bool, error) {
+	return true, nil
+}
+
+func (h staticIPHandler) convert(i client.Object) (*v1alpha1.StaticIP, error) {
+	ip, ok := i.(*v1alpha1.StaticIP)
+	if !ok {
+		return nil, fmt.Errorf("cannot convert object to StaticIP")
+	}
+
+	return ip, nil
+}
@@ -0,0 +1,52 @@
+package controllers
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func TestStaticIPHandler(t *testing.T) {
+	api := newFakeStaticIPsAPI(t)
+	avn := newFakeAivenClient(api)
+	ip := &v1alpha1.StaticIP{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-static-ip", Generation: 1},
+		Spec:       v1alpha1.StaticIPSpec{Project: "my-project", CloudName: "google-europe-west1"},
+	}
+
+	// Creates
+	require.NoError(t, staticIPHandler{}.createOrUpdate(avn, ip, nil))
+	assert.Equal(t, "ip9", ip.Status.ID)
+	_, err := staticIPHandler{}.get(avn, ip)
+	require.NoError(t, err)
+	assert.False(t, IsAlreadyRunning(ip))
+
+	api.ips["ip9"].State = "created"
+	_, err = staticIPHandler{}.get(avn, ip)
+	require.NoError(t, err)
+	assert.True(t, IsAlreadyRunning(ip))
+	assert.Equal(t, "203.0.113.9", ip.Status.IPAddress)
+
+	// Can't be deleted while associated
+	api.ips["ip9"].ServiceName = "my-pg"
+	_, err = staticIPHandler{}.get(avn, ip)
+	require.NoError(t, err)
+	assert.Equal(t, "my-pg", ip.Status.ServiceName)
+	assert.EqualError(t, ip.ValidateDelete(), `cannot delete StaticIP, it is associated with service "my-pg", remove it from the service staticIPRefs first`)
+
+	deleted, err := staticIPHandler{}.delete(avn, ip)
+	assert.False(t, deleted)
+	assert.True(t, errors.Is(err, v1alpha1.ErrDeleteDependencies))
+
+	// Deletes
+	api.ips["ip9"].ServiceName = ""
+	deleted, err = staticIPHandler{}.delete(avn, ip)
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	assert.Equal(t, []string{"delete ip9"}, api.calls)
+}
@@ -0,0 +1,193 @@
+// Copyright (c) 2023 Aiven, Helsinki, Finland. https://aiven.io/
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aiven/aiven-go-client"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// vpcPeeringConnectionResyncInterval how often the peering state is checked,
+// the peer side accepts or rejects the connection outside the operator
+const vpcPeeringConnectionResyncInterval = 5 * time.Minute
+
+// VPCPeeringConnectionReconciler reconciles a VPCPeeringConnection object
+type VPCPeeringConnectionReconciler struct {
+	Controller
+}
+
+//+kubebuilder:rbac:groups=aiven.io,resources=vpcpeeringconnections,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=aiven.io,resources=vpcpeeringconnections/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=aiven.io,resources=vpcpeeringconnections/finalizers,verbs=update
+
+func (r *VPCPeeringConnectionReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	result, err := r.reconcileInstance(ctx, req, vpcPeeringConnectionHandler{}, &v1alpha1.VPCPeeringConnection{})
+	if err == nil && result.IsZero() {
+		result.RequeueAfter = vpcPeeringConnectionResyncInterval
+	}
+	return result, err
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *VPCPeeringConnectionReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.VPCPeeringConnection{}).
+		Complete(r)
+}
+
+type vpcPeeringConnectionHandler struct{}
+
+func (h vpcPeeringConnectionHandler) createOrUpdate(avn *aiven.Client, obj client.Object, refs []client.Object) error {
+	conn, err := h.convert(obj)
+	if err != nil {
+		return err
+	}
+
+	vpc := v1alpha1.FindProjectVPC(refs)
+	if vpc == nil || vpc.Status.ID == "" {
+		return fmt.Errorf("project VPC %q is not ready", conn.Spec.ProjectVPCRef.Name)
+	}
+
+	// The spec is immutable, creates once.
+	// The connection might exist already, if the status was lost
+	reason := "Updated"
+	_, err = h.getPeering(avn, conn, vpc.Status.ID)
+	if aiven.IsNotFound(err) {
+		reason = "Created"
+		_, err = avn.VPCPeeringConnections.Create(conn.Spec.Project, vpc.Status.ID, aiven.CreateVPCPeeringConnectionRequest{
+			PeerCloudAccount:     conn.Spec.PeerCloudAccount,
+			PeerVPC:              conn.Spec.PeerVPC,
+			PeerRegion:           toOptionalStringPointer(conn.Spec.PeerRegion),
+			PeerAzureAppId:       conn.Spec.PeerAzureAppID,
+			PeerAzureTenantId:    conn.Spec.PeerAzureTenantID,
+			PeerResourceGroup:    conn.Spec.PeerResourceGroup,
+			UserPeerNetworkCIDRs: conn.Spec.UserPeerNetworkCIDRs,
+		})
+	}
+
+	if err != nil {
+		return err
+	}
+
+	conn.Status.ProjectVPCID = vpc.Status.ID
+
+	meta.SetStatusCondition(&conn.Status.Conditions,
+		getInitializedCondition(reason,
+			"Instance was created or update on Aiven side"))
+
+	meta.SetStatusCondition(&conn.Status.Conditions,
+		getRunningCondition(metav1.ConditionUnknown, reason,
+			"Instance was created or update on Aiven side, status remains unknown"))
+
+	metav1.SetMetaDataAnnotation(&conn.ObjectMeta,
+		processedGenerationAnnotation, strconv.FormatInt(conn.GetGeneration(), formatIntBaseDecimal))
+
+	return nil
+}
+
+func (h vpcPeeringConnectionHandler) delete(avn *aiven.Client, obj client.Object) (bool, error) {
+	conn, err := h.convert(obj)
+	if err != nil {
+		return false, err
+	}
+
+	// Never created
+	if conn.Status.ProjectVPCID == "" {
+		return true, nil
+	}
+
+	s := conn.Spec
+	region := toOptionalStringPointer(s.PeerRegion)
+	if s.PeerResourceGroup != "" {
+		err = avn.VPCPeeringConnections.DeleteVPCPeeringWithResourceGroup(
+			s.Project, conn.Status.ProjectVPCID, s.PeerCloudAccount, s.PeerVPC, s.PeerResourceGroup, region)
+	} else {
+		err = avn.VPCPeeringConnections.DeleteVPCPeering(
+			s.Project, conn.Status.ProjectVPCID, s.PeerCloudAccount, s.PeerVPC, region)
+	}
+
+	if err != nil && !aiven.IsNotFound(err) {
+		return false, fmt.Errorf("failed to delete VPC peering connection: %w", err)
+	}
+	return true, nil
+}
+
+func (h vpcPeeringConnectionHandler) get(avn *aiven.Client, obj client.Object) (*corev1.Secret, error) {
+	conn, err := h.convert(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	pc, err := h.getPeering(avn, conn, conn.Status.ProjectVPCID)
+	if err != nil {
+		return nil, err
+	}
+
+	conn.Status.State = pc.State
+	conn.Status.StateInfo = nil
+	conn.Status.AWSVPCPeeringConnectionID = ""
+	if pc.StateInfo != nil {
+		conn.Status.StateInfo = make(map[string]string, len(*pc.StateInfo))
+		for k, v := range *pc.StateInfo {
+			s, ok := v.(string)
+			if !ok {
+				b, err := json.Marshal(v)
+				if err != nil {
+					return nil, err
+				}
+				s = string(b)
+			}
+			conn.Status.StateInfo[k] = s
+		}
+		conn.Status.AWSVPCPeeringConnectionID = conn.Status.StateInfo["aws_vpc_peering_connection_id"]
+	}
+
+	switch pc.State {
+	case "ACTIVE", "PENDING_PEER":
+		// PENDING_PEER waits for the peer side to accept the connection,
+		// which can't be done by the operator
+		meta.SetStatusCondition(&conn.Status.Conditions,
+			getRunningCondition(metav1.ConditionTrue, "CheckRunning",
+				"Instance is running on Aiven side"))
+
+		metav1.SetMetaDataAnnotation(&conn.ObjectMeta, instanceIsRunningAnnotation, "true")
+	case "INVALID_SPECIFICATION", "REJECTED_BY_PEER", "DELETED_BY_PEER":
+		return nil, fmt.Errorf("VPC peering connection is in state %s: %s", pc.State, conn.Status.StateInfo["message"])
+	}
+
+	return nil, nil
+}
+
+func (h vpcPeeringConnectionHandler) checkPreconditions(_ *aiven.Client, _ client.Object) (bool, error) {
+	return true, nil
+}
+
+// getPeering returns the peering connection of the given project VPC
+func (h vpcPeeringConnectionHandler) getPeering(avn *aiven.Client, conn *v1alpha1.VPCPeeringConnection, vpcID string) (*aiven.VPCPeeringConnection, error) {
+	s := conn.Spec
+	return avn.VPCPeeringConnections.GetVPCPeeringWithResourceGroup(
+		s.Project, vpcID, s.PeerCloudAccount, s.PeerVPC,
+		toOptionalStringPointer(s.PeerRegion), toOptionalStringPointer(s.PeerResourceGroup),
+	)
+}
+
+func (h vpcPeeringConnectionHandler) convert(i client.Object) (*v1alpha1.VPCPeeringConnection, error) {
+	conn, ok := i.(*v1alpha1.VPCPeeringConnection)
+	if !ok {
+		return nil, fmt.Errorf("cannot convert object to VPCPeeringConnection")
+	}
+
+	return conn, nil
+}
@@ -0,0 +1,117 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+// fakeVPCAPI serves a single project VPC with its peering connections
+type fakeVPCAPI struct {
+	*fakeAivenAPI
+	vpc aiven.VPC
+}
+
+func newFakeVPCAPI(t *testing.T, vpc aiven.VPC) *fakeVPCAPI {
+	const peerPath = "/v1/project/my-project/vpcs/vpc1/peering-connections/peer-accounts/*"
+
+	f := &fakeVPCAPI{vpc: vpc}
+	remove := func(r *http.Request, _ []string) (int, any) {
+		f.call("delete %s", r.URL.Path)
+		return http.StatusOK, map[string]any{}
+	}
+	f.fakeAivenAPI = newFakeAivenAPI(t, fakeRoutes{
+		"GET /v1/project/my-project/vpcs/vpc1": func(*http.Request, []string) (int, any) {
+			return http.StatusOK, f.vpc
+		},
+		"POST /v1/project/my-project/vpcs/vpc1/peering-connections": func(r *http.Request, _ []string) (int, any) {
+			pc := &aiven.VPCPeeringConnection{}
+			f.decode(r, pc)
+			pc.State = "APPROVED"
+			f.vpc.PeeringConnections = append(f.vpc.PeeringConnections, pc)
+			f.call("create %s", pc.PeerVPC)
+			return http.StatusOK, pc
+		},
+		"DELETE " + peerPath + "/peer-vpcs/*":                                       remove,
+		"DELETE " + peerPath + "/peer-vpcs/*/peer-regions/*":                        remove,
+		"DELETE " + peerPath + "/peer-resource-groups/*/peer-vpcs/*":                remove,
+		"DELETE " + peerPath + "/peer-resource-groups/*/peer-vpcs/*/peer-regions/*": remove,
+	})
+	return f
+}
+
+func TestVPCPeeringConnectionHandler(t *testing.T) {
+	api := newFakeVPCAPI(t, aiven.VPC{ProjectVPCID: "vpc1", State: "ACTIVE"})
+	avn := newFakeAivenClient(api)
+	h := vpcPeeringConnectionHandler{}
+	vpc := &v1alpha1.ProjectVPC{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-vpc", Namespace: "default"},
+		Status:     v1alpha1.ProjectVPCStatus{ID: "vpc1"},
+	}
+	conn := &v1alpha1.VPCPeeringConnection{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-peering", Namespace: "default", Generation: 1},
+		Spec: v1alpha1.VPCPeeringConnectionSpec{
+			Project:          "my-project",
+			ProjectVPCRef:    v1alpha1.ResourceReference{Name: "my-vpc"},
+			PeerCloudAccount: "123456789012",
+			PeerVPC:          "vpc-peer",
+			PeerRegion:       "eu-west-1",
+		},
+	}
+
+	// Creates once
+	require.NoError(t, h.createOrUpdate(avn, conn, []client.Object{vpc}))
+	require.NoError(t, h.createOrUpdate(avn, conn, []client.Object{vpc}))
+	assert.Equal(t, []string{"create vpc-peer"}, api.calls)
+	assert.Equal(t, "vpc1", conn.Status.ProjectVPCID)
+
+	_, err := h.get(avn, conn)
+	require.NoError(t, err)
+	assert.Equal(t, "APPROVED", conn.Status.State)
+	assert.False(t, IsAlreadyRunning(conn))
+
+	// AWS waits for the peer to accept the connection
+	api.vpc.PeeringConnections[0].State = "PENDING_PEER"
+	api.vpc.PeeringConnections[0].StateInfo = &map[string]any{
+		"message":                       "Pending peer",
+		"aws_vpc_peering_connection_id": "pcx-123",
+		"warnings":                      []any{},
+	}
+	_, err = h.get(avn, conn)
+	require.NoError(t, err)
+	assert.True(t, IsAlreadyRunning(conn))
+	assert.Equal(t, "pcx-123", conn.Status.AWSVPCPeeringConnectionID)
+	assert.Equal(t, "[]", conn.Status.StateInfo["warnings"])
+
+	api.vpc.PeeringConnections[0].State = "INVALID_SPECIFICATION"
+	api.vpc.PeeringConnections[0].StateInfo = &map[string]any{"message": "Invalid VPC"}
+	_, err = h.get(avn, conn)
+	assert.EqualError(t, err, "VPC peering connection is in state INVALID_SPECIFICATION: Invalid VPC")
+
+	// The parent VPC waits for the connection
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+	k8s := fake.NewClientBuilder().WithScheme(scheme).WithObjects(conn).Build()
+	vpc.Spec.Project = "my-project"
+	deleted, err := (&ProjectVPCHandler{k8s: k8s}).delete(avn, vpc)
+	assert.False(t, deleted)
+	assert.True(t, errors.Is(err, v1alpha1.ErrDeleteDependencies))
+	assert.EqualError(t, err, "object has dependencies and cannot be deleted: VPC has peering connections default/my-peering")
+
+	deleted, err = h.delete(avn, conn)
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	assert.Equal(t, "delete /v1/project/my-project/vpcs/vpc1/peering-connections/peer-accounts/123456789012/peer-vpcs/vpc-peer/peer-regions/eu-west-1", api.calls[1])
+}
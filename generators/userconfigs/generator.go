@@ -16,8 +16,9 @@ import (
 	"gopkg.in/yaml.v3"
 )
 
-// generate writes to file a service user config for a given serviceList
-func generate(dstDir string, serviceTypes []byte, serviceList []string) error {
+// generate writes to file a service user config for a given serviceList.
+// optional fields, like "datadog.datadog_api_key", are not required
+func generate(dstDir string, serviceTypes []byte, serviceList []string, optional []string) error {
 	// root level object
 	var root map[string]*object
 
@@ -33,6 +34,14 @@ func generate(dstDir string, serviceTypes []byte, serviceList []string) error {
 			continue
 		}
 
+		required := make([]string, 0, len(v.RequiredFields))
+		for _, f := range v.RequiredFields {
+			if !slices.Contains(optional, k+"."+f) {
+				required = append(required, f)
+			}
+		}
+		v.RequiredFields = required
+
 		dirPath := filepath.Join(dstDir, k)
 		err = os.MkdirAll(dirPath, os.ModePerm)
 		if err != nil {
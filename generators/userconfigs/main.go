@@ -11,24 +11,38 @@ import (
 const destination = "./api/v1alpha1/userconfig"
 
 func main() {
-	var serviceList, integrationList string
+	var serviceList, integrationList, endpointList, optionalList string
 	flag.StringVar(&serviceList, "services", "", "Comma separated service list of names to generate for")
 	flag.StringVar(&integrationList, "integrations", "", "Comma separated integrations list of names to generate for")
+	flag.StringVar(&endpointList, "endpoints", "", "Comma separated integration endpoints list of names to generate for")
+	flag.StringVar(&optionalList, "optional", "", "Comma separated list of required fields to make optional, like datadog.datadog_api_key, when they can be set from secrets")
 	flag.Parse()
 
-	if serviceList+integrationList == "" {
-		log.Fatal("--service or --integrations must be provided")
+	if serviceList+integrationList+endpointList == "" {
+		log.Fatal("--service, --integrations or --endpoints must be provided")
+	}
+
+	optional := make([]string, 0)
+	if optionalList != "" {
+		optional = strings.Split(optionalList, ",")
 	}
 
 	if serviceList != "" {
-		err := generate(destination+"/service", dist.ServiceTypes, strings.Split(serviceList, ","))
+		err := generate(destination+"/service", dist.ServiceTypes, strings.Split(serviceList, ","), optional)
 		if err != nil {
 			log.Fatal(err)
 		}
 	}
 
 	if integrationList != "" {
-		err := generate(destination+"/integration", dist.IntegrationTypes, strings.Split(integrationList, ","))
+		err := generate(destination+"/integration", dist.IntegrationTypes, strings.Split(integrationList, ","), optional)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	if endpointList != "" {
+		err := generate(destination+"/integrationendpoint", dist.IntegrationEndpointTypes, strings.Split(endpointList, ","), optional)
 		if err != nil {
 			log.Fatal(err)
 		}
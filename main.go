@@ -20,8 +20,9 @@ import (
 	//+kubebuilder:scaffold:imports
 )
 
-//go:generate go run ./generators/userconfigs/... --services mysql,cassandra,grafana,pg,kafka,redis,clickhouse,opensearch,kafka_connect
+//go:generate go run ./generators/userconfigs/... --services mysql,cassandra,grafana,pg,kafka,redis,clickhouse,opensearch,kafka_connect,flink,kafka_mirrormaker
 //go:generate go run ./generators/userconfigs/... --integrations clickhouse_kafka,clickhouse_postgresql,datadog,kafka_connect,kafka_logs,kafka_mirrormaker,logs,metrics,external_aws_cloudwatch_metrics
+//go:generate go run ./generators/userconfigs/... --endpoints datadog,external_elasticsearch_logs,external_google_cloud_logging,external_kafka,external_opensearch_logs,external_schema_registry,prometheus,rsyslog --optional datadog.datadog_api_key,external_elasticsearch_logs.url,external_google_cloud_logging.service_account_credentials,external_opensearch_logs.url,external_schema_registry.url
 
 var (
 	scheme   = runtime.NewScheme()
@@ -80,7 +81,17 @@ func main() {
 	}
 
 	defaultToken := os.Getenv("DEFAULT_AIVEN_TOKEN")
-	err = controllers.SetupControllers(mgr, defaultToken)
+	serviceTags := controllers.ServiceTagsConfig{
+		Labels:      controllers.ParseServiceTagsKeys(os.Getenv("SERVICE_TAGS_FROM_LABELS")),
+		Annotations: controllers.ParseServiceTagsKeys(os.Getenv("SERVICE_TAGS_FROM_ANNOTATIONS")),
+		ClusterName: os.Getenv("SERVICE_TAGS_CLUSTER_NAME"),
+	}
+	switch strings.ToLower(os.Getenv("SERVICE_TAGS_NAMESPACE")) {
+	case "true", "1", "t":
+		serviceTags.Namespace = true
+	}
+
+	err = controllers.SetupControllers(mgr, defaultToken, serviceTags)
 	if err != nil {
 		setupLog.Error(err, "controllers setup error")
 	}
@@ -89,7 +100,14 @@ func main() {
 	switch strings.ToLower(os.Getenv("ENABLE_WEBHOOKS")) {
 	case "false", "0", "f":
 	default:
-		err = v1alpha1.SetupWebhooks(mgr)
+		catalog := controllers.NewServiceCatalog(mgr, defaultToken)
+		err = mgr.Add(catalog)
+		if err != nil {
+			setupLog.Error(err, "unable to add service catalog")
+			os.Exit(1)
+		}
+
+		err = v1alpha1.SetupWebhooks(mgr, catalog)
 		if err != nil {
 			setupLog.Error(err, "unable to create webhook")
 			os.Exit(1)
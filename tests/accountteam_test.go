@@ -0,0 +1,145 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func getAccountTeamYaml(project, accountID, teamName, memberName, email, teamProjectName string) string {
+	return fmt.Sprintf(`
+apiVersion: aiven.io/v1alpha1
+kind: AccountTeam
+metadata:
+  name: %[3]s
+spec:
+  authSecretRef:
+    name: aiven-token
+    key: token
+
+  accountId: %[2]s
+  teamName: %[3]s
+
+---
+
+apiVersion: aiven.io/v1alpha1
+kind: AccountTeamMember
+metadata:
+  name: %[4]s
+spec:
+  authSecretRef:
+    name: aiven-token
+    key: token
+
+  teamRef:
+    name: %[3]s
+  userEmail: %[5]s
+
+---
+
+apiVersion: aiven.io/v1alpha1
+kind: AccountTeamProject
+metadata:
+  name: %[6]s
+spec:
+  authSecretRef:
+    name: aiven-token
+    key: token
+
+  teamRef:
+    name: %[3]s
+  project: %[1]s
+  teamType: read_only
+`, project, accountID, teamName, memberName, email, teamProjectName)
+}
+
+func TestAccountTeam(t *testing.T) {
+	t.Parallel()
+	defer recoverPanic(t)
+
+	accountID := os.Getenv("AIVEN_ACCOUNT_ID")
+	if accountID == "" {
+		t.Skip("Provide AIVEN_ACCOUNT_ID for this test")
+	}
+
+	// GIVEN
+	teamName := randName("account-team")
+	memberName := randName("account-team")
+	teamProjectName := randName("account-team")
+	email := memberName + "@example.com"
+	yml := getAccountTeamYaml(testProject, accountID, teamName, memberName, email, teamProjectName)
+	s, err := NewSession(k8sClient, avnClient, testProject, yml)
+	require.NoError(t, err)
+
+	// Cleans test afterwards
+	defer s.Destroy()
+
+	// WHEN
+	// Applies given manifest
+	require.NoError(t, s.Apply())
+
+	// Waits kube objects
+	team := new(v1alpha1.AccountTeam)
+	require.NoError(t, s.GetRunning(team, teamName))
+
+	member := new(v1alpha1.AccountTeamMember)
+	require.NoError(t, s.GetRunning(member, memberName))
+
+	teamProject := new(v1alpha1.AccountTeamProject)
+	require.NoError(t, s.GetRunning(teamProject, teamProjectName))
+
+	// THEN
+	// Validates AccountTeam
+	teamAvn, err := avnClient.AccountTeams.Get(accountID, team.Status.ID)
+	require.NoError(t, err)
+	assert.Equal(t, teamName, teamAvn.Team.Name)
+
+	// Validates AccountTeamMember, the user can't accept the invitation
+	assert.Equal(t, v1alpha1.AccountTeamMemberStateInvited, member.Status.State)
+	assert.Equal(t, accountID, member.Status.AccountID)
+	assert.Equal(t, team.Status.ID, member.Status.TeamID)
+	getInvite := func() error {
+		invites, err := avnClient.AccountTeamInvites.List(accountID, team.Status.ID)
+		if err != nil {
+			return err
+		}
+		for _, i := range invites.Invites {
+			if i.UserEmail == email {
+				return nil
+			}
+		}
+		return aiven.Error{Message: "invite not found", Status: http.StatusNotFound}
+	}
+	require.NoError(t, getInvite())
+
+	// Validates AccountTeamProject
+	getTeamProject := func() error {
+		projects, err := avnClient.AccountTeamProjects.List(accountID, team.Status.ID)
+		if err != nil {
+			return err
+		}
+		for _, p := range projects.Projects {
+			if p.ProjectName == testProject {
+				assert.Equal(t, "read_only", p.TeamType)
+				return nil
+			}
+		}
+		return aiven.Error{Message: "team project not found", Status: http.StatusNotFound}
+	}
+	require.NoError(t, getTeamProject())
+
+	// Validates the controllers cancel the invitation and remove the project from the team
+	assert.NoError(t, s.Delete(member, getInvite))
+	assert.NoError(t, s.Delete(teamProject, getTeamProject))
+	assert.NoError(t, s.Delete(team, func() error {
+		_, err := avnClient.AccountTeams.Get(accountID, team.Status.ID)
+		return err
+	}))
+}
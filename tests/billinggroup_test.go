@@ -0,0 +1,100 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func getBillingGroupYaml(groupName, projectName string) string {
+	return fmt.Sprintf(`
+apiVersion: aiven.io/v1alpha1
+kind: BillingGroup
+metadata:
+  name: %[1]s
+spec:
+  authSecretRef:
+    name: aiven-token
+    key: token
+
+  billingCurrency: EUR
+  billingEmails:
+    - billing@example.com
+  company: Example Ltd
+  addressLines:
+    - Example Street 1
+  city: Helsinki
+  zipCode: "00100"
+  countryCode: FI
+
+---
+
+apiVersion: aiven.io/v1alpha1
+kind: Project
+metadata:
+  name: %[2]s
+spec:
+  authSecretRef:
+    name: aiven-token
+    key: token
+
+  billingGroupRef:
+    name: %[1]s
+  cloud: google-europe-west1
+`, groupName, projectName)
+}
+
+func TestBillingGroup(t *testing.T) {
+	t.Parallel()
+	defer recoverPanic(t)
+
+	// GIVEN
+	groupName := randName("billing-group")
+	projectName := randName("billing-group")
+	yml := getBillingGroupYaml(groupName, projectName)
+	s, err := NewSession(k8sClient, avnClient, testProject, yml)
+	require.NoError(t, err)
+
+	// Cleans test afterwards
+	defer s.Destroy()
+
+	// WHEN
+	// Applies given manifest
+	require.NoError(t, s.Apply())
+
+	// Waits kube objects
+	group := new(v1alpha1.BillingGroup)
+	require.NoError(t, s.GetRunning(group, groupName))
+
+	project := new(v1alpha1.Project)
+	require.NoError(t, s.GetRunning(project, projectName))
+
+	// THEN
+	// Validates BillingGroup
+	groupAvn, err := avnClient.BillingGroup.Get(group.Status.ID)
+	require.NoError(t, err)
+	assert.Equal(t, groupName, groupAvn.BillingGroupName)
+	assert.Equal(t, "EUR", *groupAvn.BillingCurrency)
+	assert.Equal(t, "Example Ltd", *groupAvn.Company)
+	assert.Equal(t, "FI", *groupAvn.CountryCode)
+
+	// Validates the project is assigned to the group
+	assert.Equal(t, group.Status.ID, project.Status.BillingGroupID)
+	projects, err := avnClient.BillingGroup.GetProjects(group.Status.ID)
+	require.NoError(t, err)
+	assert.Contains(t, projects, projectName)
+
+	// The group can be deleted once it has no projects
+	assert.NoError(t, s.Delete(project, func() error {
+		_, err := avnClient.Projects.Get(projectName)
+		return err
+	}))
+	assert.NoError(t, s.Delete(group, func() error {
+		_, err := avnClient.BillingGroup.Get(group.Status.ID)
+		return err
+	}))
+}
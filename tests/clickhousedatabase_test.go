@@ -0,0 +1,84 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func getClickhouseDatabaseYaml(project, chName, dbName string) string {
+	return fmt.Sprintf(`
+apiVersion: aiven.io/v1alpha1
+kind: Clickhouse
+metadata:
+  name: %[2]s
+spec:
+  authSecretRef:
+    name: aiven-token
+    key: token
+
+  project: %[1]s
+  cloudName: google-europe-west1
+  plan: startup-16
+
+---
+
+apiVersion: aiven.io/v1alpha1
+kind: ClickhouseDatabase
+metadata:
+  name: %[3]s
+spec:
+  authSecretRef:
+    name: aiven-token
+    key: token
+
+  project: %[1]s
+  serviceName: %[2]s
+  serviceRef:
+    name: %[2]s
+  databaseName: analytics
+`, project, chName, dbName)
+}
+
+func TestClickhouseDatabase(t *testing.T) {
+	t.Parallel()
+	defer recoverPanic(t)
+
+	// GIVEN
+	chName := randName("clickhouse-database")
+	dbName := randName("clickhouse-database")
+	yml := getClickhouseDatabaseYaml(testProject, chName, dbName)
+	s, err := NewSession(k8sClient, avnClient, testProject, yml)
+	require.NoError(t, err)
+
+	// Cleans test afterwards
+	defer s.Destroy()
+
+	// WHEN
+	// Applies given manifest
+	require.NoError(t, s.Apply())
+
+	// Waits kube objects
+	ch := new(v1alpha1.Clickhouse)
+	require.NoError(t, s.GetRunning(ch, chName))
+
+	db := new(v1alpha1.ClickhouseDatabase)
+	require.NoError(t, s.GetRunning(db, dbName))
+
+	// THEN
+	dbAvn, err := avnClient.ClickhouseDatabase.Get(testProject, chName, "analytics")
+	require.NoError(t, err)
+	assert.Equal(t, "analytics", dbAvn.Name)
+	assert.Equal(t, dbAvn.Engine, db.Status.Engine)
+	assert.False(t, db.Status.ManagedByIntegration)
+
+	// Validates the controller deletes the database, not the service
+	assert.NoError(t, s.Delete(db, func() error {
+		_, err = avnClient.ClickhouseDatabase.Get(testProject, chName, "analytics")
+		return err
+	}))
+}
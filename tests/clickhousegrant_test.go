@@ -0,0 +1,190 @@
+package tests
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/aiven/aiven-go-client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func getClickhouseGrantYaml(project, chName, userName, roleName, dbName, grantName string) string {
+	return fmt.Sprintf(`
+apiVersion: aiven.io/v1alpha1
+kind: Clickhouse
+metadata:
+  name: %[2]s
+spec:
+  authSecretRef:
+    name: aiven-token
+    key: token
+
+  project: %[1]s
+  cloudName: google-europe-west1
+  plan: startup-16
+
+---
+
+apiVersion: aiven.io/v1alpha1
+kind: ClickhouseUser
+metadata:
+  name: %[3]s
+spec:
+  authSecretRef:
+    name: aiven-token
+    key: token
+
+  connInfoSecretTarget:
+    name: %[3]s
+
+  project: %[1]s
+  serviceName: %[2]s
+
+---
+
+apiVersion: aiven.io/v1alpha1
+kind: ClickhouseRole
+metadata:
+  name: %[4]s
+spec:
+  authSecretRef:
+    name: aiven-token
+    key: token
+
+  project: %[1]s
+  serviceName: %[2]s
+  role: readers
+
+---
+
+apiVersion: aiven.io/v1alpha1
+kind: ClickhouseDatabase
+metadata:
+  name: %[5]s
+spec:
+  authSecretRef:
+    name: aiven-token
+    key: token
+
+  project: %[1]s
+  serviceName: %[2]s
+  databaseName: analytics
+
+---
+
+apiVersion: aiven.io/v1alpha1
+kind: ClickhouseGrant
+metadata:
+  name: %[6]s
+spec:
+  authSecretRef:
+    name: aiven-token
+    key: token
+
+  project: %[1]s
+  serviceName: %[2]s
+
+  grantee:
+    userRef:
+      name: %[3]s
+
+  privilegeGrants:
+    - privilege: SELECT
+      database: analytics
+
+  roleGrants:
+    - roleRef:
+        name: %[4]s
+`, project, chName, userName, roleName, dbName, grantName)
+}
+
+// queryClickhouse returns rows of the query run against the "system" database
+func queryClickhouse(chName, query string) ([]any, error) {
+	rsp, err := avnClient.ClickHouseQuery.Query(testProject, chName, "system", query)
+	if err != nil {
+		return nil, err
+	}
+	return rsp.Data, nil
+}
+
+// existsClickhouse returns 404 error when the query returns no rows
+func existsClickhouse(chName, query string) error {
+	rows, err := queryClickhouse(chName, query)
+	if err == nil && len(rows) == 0 {
+		err = aiven.Error{Message: "not found", Status: http.StatusNotFound}
+	}
+	return err
+}
+
+func TestClickhouseGrant(t *testing.T) {
+	t.Parallel()
+	defer recoverPanic(t)
+
+	// GIVEN
+	chName := randName("clickhouse-grant")
+	userName := randName("clickhouse-grant")
+	roleName := randName("clickhouse-grant")
+	dbName := randName("clickhouse-grant")
+	grantName := randName("clickhouse-grant")
+	yml := getClickhouseGrantYaml(testProject, chName, userName, roleName, dbName, grantName)
+	s, err := NewSession(k8sClient, avnClient, testProject, yml)
+	require.NoError(t, err)
+
+	// Cleans test afterwards
+	defer s.Destroy()
+
+	// WHEN
+	// Applies given manifest
+	require.NoError(t, s.Apply())
+
+	// Waits kube objects
+	ch := new(v1alpha1.Clickhouse)
+	require.NoError(t, s.GetRunning(ch, chName))
+
+	user := new(v1alpha1.ClickhouseUser)
+	require.NoError(t, s.GetRunning(user, userName))
+
+	role := new(v1alpha1.ClickhouseRole)
+	require.NoError(t, s.GetRunning(role, roleName))
+
+	db := new(v1alpha1.ClickhouseDatabase)
+	require.NoError(t, s.GetRunning(db, dbName))
+
+	grant := new(v1alpha1.ClickhouseGrant)
+	require.NoError(t, s.GetRunning(grant, grantName))
+
+	// THEN
+	// Validates ClickhouseRole
+	rolesQuery := "SELECT name FROM system.roles WHERE name = 'readers'"
+	roles, err := queryClickhouse(chName, rolesQuery)
+	require.NoError(t, err)
+	assert.Len(t, roles, 1)
+
+	// Validates ClickhouseGrant
+	grantsQuery := fmt.Sprintf("SELECT access_type, database FROM system.grants WHERE user_name = '%s'", userName)
+	grants, err := queryClickhouse(chName, grantsQuery)
+	require.NoError(t, err)
+	assert.Equal(t, []any{[]any{"SELECT", "analytics"}}, grants)
+
+	roleGrantsQuery := fmt.Sprintf("SELECT granted_role_name FROM system.role_grants WHERE user_name = '%s'", userName)
+	roleGrants, err := queryClickhouse(chName, roleGrantsQuery)
+	require.NoError(t, err)
+	assert.Equal(t, []any{[]any{"readers"}}, roleGrants)
+
+	// Validates the controller revokes the grants
+	assert.NoError(t, s.Delete(grant, func() error {
+		return existsClickhouse(chName, grantsQuery)
+	}))
+	roleGrants, err = queryClickhouse(chName, roleGrantsQuery)
+	require.NoError(t, err)
+	assert.Empty(t, roleGrants)
+
+	// Validates the controller drops the role
+	assert.NoError(t, s.Delete(role, func() error {
+		return existsClickhouse(chName, rolesQuery)
+	}))
+}
@@ -0,0 +1,231 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func getFlinkYaml(project, flinkName, kafkaName, siName string) string {
+	return fmt.Sprintf(`
+apiVersion: aiven.io/v1alpha1
+kind: Flink
+metadata:
+  name: %[2]s
+spec:
+  authSecretRef:
+    name: aiven-token
+    key: token
+
+  connInfoSecretTarget:
+    name: %[2]s
+
+  project: %[1]s
+  cloudName: google-europe-west1
+  plan: business-4
+
+---
+
+apiVersion: aiven.io/v1alpha1
+kind: Kafka
+metadata:
+  name: %[3]s
+spec:
+  authSecretRef:
+    name: aiven-token
+    key: token
+
+  project: %[1]s
+  cloudName: google-europe-west1
+  plan: startup-2
+
+---
+
+apiVersion: aiven.io/v1alpha1
+kind: KafkaTopic
+metadata:
+  name: %[3]s-cpu-in
+spec:
+  authSecretRef:
+    name: aiven-token
+    key: token
+
+  project: %[1]s
+  serviceName: %[3]s
+  topicName: cpu_in
+  replication: 2
+  partitions: 1
+
+---
+
+apiVersion: aiven.io/v1alpha1
+kind: KafkaTopic
+metadata:
+  name: %[3]s-cpu-high
+spec:
+  authSecretRef:
+    name: aiven-token
+    key: token
+
+  project: %[1]s
+  serviceName: %[3]s
+  topicName: cpu_high
+  replication: 2
+  partitions: 1
+
+---
+
+apiVersion: aiven.io/v1alpha1
+kind: ServiceIntegration
+metadata:
+  name: %[4]s
+spec:
+  authSecretRef:
+    name: aiven-token
+    key: token
+
+  project: %[1]s
+  integrationType: flink
+  sourceServiceName: %[3]s
+  destinationServiceName: %[2]s
+`, project, flinkName, kafkaName, siName)
+}
+
+func getFlinkApplicationYaml(project, flinkName, appName, integrationID string) string {
+	return fmt.Sprintf(`
+apiVersion: aiven.io/v1alpha1
+kind: FlinkApplication
+metadata:
+  name: %[3]s
+spec:
+  authSecretRef:
+    name: aiven-token
+    key: token
+
+  project: %[1]s
+  serviceName: %[2]s
+  serviceRef:
+    name: %[2]s
+
+  versions:
+    - name: v1
+      statement: |
+        INSERT INTO cpu_high SELECT * FROM cpu_in WHERE usage > 80
+      sources:
+        - integrationId: %[4]s
+          createTable: |
+            CREATE TABLE cpu_in (hostname STRING, usage INT) WITH (
+              'connector' = 'kafka',
+              'properties.bootstrap.servers' = '',
+              'scan.startup.mode' = 'earliest-offset',
+              'topic' = 'cpu_in',
+              'value.format' = 'json'
+            )
+      sinks:
+        - integrationId: %[4]s
+          createTable: |
+            CREATE TABLE cpu_high (hostname STRING, usage INT) WITH (
+              'connector' = 'kafka',
+              'properties.bootstrap.servers' = '',
+              'topic' = 'cpu_high',
+              'value.format' = 'json'
+            )
+
+  deployment:
+    version: v1
+    parallelism: 1
+`, project, flinkName, appName, integrationID)
+}
+
+func TestFlink(t *testing.T) {
+	t.Parallel()
+	defer recoverPanic(t)
+
+	// GIVEN
+	flinkName := randName("flink")
+	kafkaName := randName("flink")
+	siName := randName("flink")
+	yml := getFlinkYaml(testProject, flinkName, kafkaName, siName)
+	s, err := NewSession(k8sClient, avnClient, testProject, yml)
+	require.NoError(t, err)
+
+	// Cleans test afterwards
+	defer s.Destroy()
+
+	// WHEN
+	// Applies given manifest
+	require.NoError(t, s.Apply())
+
+	// Waits kube objects
+	flink := new(v1alpha1.Flink)
+	require.NoError(t, s.GetRunning(flink, flinkName))
+
+	kafka := new(v1alpha1.Kafka)
+	require.NoError(t, s.GetRunning(kafka, kafkaName))
+
+	for _, name := range []string{kafkaName + "-cpu-in", kafkaName + "-cpu-high"} {
+		topic := new(v1alpha1.KafkaTopic)
+		require.NoError(t, s.GetRunning(topic, name))
+	}
+
+	si := new(v1alpha1.ServiceIntegration)
+	require.NoError(t, s.GetRunning(si, siName))
+
+	// THEN
+	// Validates Flink
+	flinkAvn, err := avnClient.Services.Get(testProject, flinkName)
+	require.NoError(t, err)
+	assert.Equal(t, flinkAvn.Name, flink.GetName())
+	assert.Equal(t, "RUNNING", flink.Status.State)
+	assert.Equal(t, flinkAvn.State, flink.Status.State)
+	assert.Equal(t, flinkAvn.Plan, flink.Spec.Plan)
+	assert.Equal(t, flinkAvn.CloudName, flink.Spec.CloudName)
+
+	// Validates Secret
+	secret, err := s.GetSecret(flink.Spec.ConnInfoSecretTarget.Name)
+	require.NoError(t, err)
+	assert.NotEmpty(t, secret.Data["FLINK_HOST"])
+	assert.NotEmpty(t, secret.Data["FLINK_PORT"])
+	assert.NotEmpty(t, secret.Data["FLINK_USER"])
+	assert.NotEmpty(t, secret.Data["FLINK_PASSWORD"])
+	assert.NotEmpty(t, secret.Data["FLINK_URI"])
+
+	// GIVEN
+	// The application uses the integration to read and write the topics
+	appName := randName("flink")
+	appYaml := getFlinkApplicationYaml(testProject, flinkName, appName, si.Status.ID)
+	appSession, err := NewSession(k8sClient, avnClient, testProject, appYaml)
+	require.NoError(t, err)
+
+	// Cleans test afterwards
+	defer appSession.Destroy()
+
+	// WHEN
+	// Applies given manifest
+	require.NoError(t, appSession.Apply())
+
+	// Waits the job is running
+	app := new(v1alpha1.FlinkApplication)
+	require.NoError(t, appSession.GetRunning(app, appName))
+
+	// THEN
+	// Validates FlinkApplication
+	appAvn, err := avnClient.FlinkApplications.Get(testProject, flinkName, app.Status.ID)
+	require.NoError(t, err)
+	assert.Equal(t, appName, appAvn.Name)
+	require.Len(t, app.Status.Versions, 1)
+	assert.Equal(t, "v1", app.Status.Versions[0].Name)
+	assert.Equal(t, "v1", app.Status.DeploymentVersion)
+	assert.NotEmpty(t, app.Status.DeploymentID)
+	assert.Equal(t, "RUNNING", app.Status.JobState)
+
+	// Validates the controller stops the job and deletes the application
+	assert.NoError(t, appSession.Delete(app, func() error {
+		_, err := avnClient.FlinkApplications.Get(testProject, flinkName, app.Status.ID)
+		return err
+	}))
+}
@@ -0,0 +1,160 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func getKafkaMirrorMakerYaml(project, mmName, sourceName, targetName, flowName string) string {
+	return fmt.Sprintf(`
+apiVersion: aiven.io/v1alpha1
+kind: KafkaMirrorMaker
+metadata:
+  name: %[2]s
+spec:
+  authSecretRef:
+    name: aiven-token
+    key: token
+
+  project: %[1]s
+  cloudName: google-europe-west1
+  plan: startup-4
+
+---
+
+apiVersion: aiven.io/v1alpha1
+kind: Kafka
+metadata:
+  name: %[3]s
+spec:
+  authSecretRef:
+    name: aiven-token
+    key: token
+
+  project: %[1]s
+  cloudName: google-europe-west1
+  plan: startup-2
+
+---
+
+apiVersion: aiven.io/v1alpha1
+kind: Kafka
+metadata:
+  name: %[4]s
+spec:
+  authSecretRef:
+    name: aiven-token
+    key: token
+
+  project: %[1]s
+  cloudName: google-europe-west1
+  plan: startup-2
+
+---
+
+apiVersion: aiven.io/v1alpha1
+kind: KafkaReplicationFlow
+metadata:
+  name: %[5]s
+spec:
+  authSecretRef:
+    name: aiven-token
+    key: token
+
+  project: %[1]s
+  serviceName: %[2]s
+  serviceRef:
+    name: %[2]s
+
+  sourceKafkaRef:
+    name: %[3]s
+  sourceClusterAlias: primary
+
+  targetKafkaRef:
+    name: %[4]s
+  targetClusterAlias: backup
+
+  topics:
+    - orders.*
+  topicsBlacklist:
+    - .*[\-\.]internal
+
+  syncGroupOffsetsEnabled: true
+  syncGroupOffsetsIntervalSeconds: 60
+  emitHeartbeatsEnabled: true
+`, project, mmName, sourceName, targetName, flowName)
+}
+
+func TestKafkaMirrorMaker(t *testing.T) {
+	t.Parallel()
+	defer recoverPanic(t)
+
+	// GIVEN
+	mmName := randName("mirrormaker")
+	sourceName := randName("mirrormaker")
+	targetName := randName("mirrormaker")
+	flowName := randName("mirrormaker")
+	yml := getKafkaMirrorMakerYaml(testProject, mmName, sourceName, targetName, flowName)
+	s, err := NewSession(k8sClient, avnClient, testProject, yml)
+	require.NoError(t, err)
+
+	// Cleans test afterwards
+	defer s.Destroy()
+
+	// WHEN
+	// Applies given manifest
+	require.NoError(t, s.Apply())
+
+	// Waits kube objects
+	mm := new(v1alpha1.KafkaMirrorMaker)
+	require.NoError(t, s.GetRunning(mm, mmName))
+
+	source := new(v1alpha1.Kafka)
+	require.NoError(t, s.GetRunning(source, sourceName))
+
+	target := new(v1alpha1.Kafka)
+	require.NoError(t, s.GetRunning(target, targetName))
+
+	flow := new(v1alpha1.KafkaReplicationFlow)
+	require.NoError(t, s.GetRunning(flow, flowName))
+
+	// THEN
+	// Validates KafkaMirrorMaker
+	mmAvn, err := avnClient.Services.Get(testProject, mmName)
+	require.NoError(t, err)
+	assert.Equal(t, mmAvn.Name, mm.GetName())
+	assert.Equal(t, "RUNNING", mm.Status.State)
+	assert.Equal(t, mmAvn.State, mm.Status.State)
+	assert.Equal(t, mmAvn.Plan, mm.Spec.Plan)
+	assert.Equal(t, mmAvn.CloudName, mm.Spec.CloudName)
+
+	// Validates KafkaReplicationFlow
+	flowAvn, err := avnClient.KafkaMirrorMakerReplicationFlow.Get(testProject, mmName, "primary", "backup")
+	require.NoError(t, err)
+	assert.Equal(t, "primary", flowAvn.ReplicationFlow.SourceCluster)
+	assert.Equal(t, "backup", flowAvn.ReplicationFlow.TargetCluster)
+	assert.Equal(t, []string{"orders.*"}, flowAvn.ReplicationFlow.Topics)
+	assert.Equal(t, []string{`.*[\-\.]internal`}, flowAvn.ReplicationFlow.TopicsBlacklist)
+	assert.True(t, flowAvn.ReplicationFlow.SyncGroupOffsetsEnabled)
+	assert.Equal(t, 60, flowAvn.ReplicationFlow.SyncGroupOffsetsIntervalSeconds)
+	assert.True(t, flowAvn.ReplicationFlow.EmitHeartbeatsEnabled)
+
+	// Validates the clusters are integrated with the MirrorMaker service
+	for _, id := range []string{flow.Status.SourceIntegrationID, flow.Status.TargetIntegrationID} {
+		siAvn, err := avnClient.ServiceIntegrations.Get(testProject, id)
+		require.NoError(t, err)
+		assert.Equal(t, "kafka_mirrormaker", siAvn.IntegrationType)
+		assert.Equal(t, mmName, *siAvn.DestinationService)
+	}
+
+	// Validates the controller deletes the flow
+	assert.NoError(t, s.Delete(flow, func() error {
+		_, err := avnClient.KafkaMirrorMakerReplicationFlow.Get(testProject, mmName, "primary", "backup")
+		return err
+	}))
+}
@@ -0,0 +1,145 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func getServiceIntegrationEndpointYaml(project, endpointName, pgName, siName string) string {
+	return fmt.Sprintf(`
+apiVersion: v1
+kind: Secret
+metadata:
+  name: %[2]s
+stringData:
+  password: prometheus-password
+
+---
+
+apiVersion: aiven.io/v1alpha1
+kind: ServiceIntegrationEndpoint
+metadata:
+  name: %[2]s
+spec:
+  authSecretRef:
+    name: aiven-token
+    key: token
+
+  project: %[1]s
+  endpointName: %[2]s
+  endpointType: prometheus
+
+  prometheus:
+    basic_auth_username: prometheus
+
+  configFrom:
+    - key: basic_auth_password
+      secretKeyRef:
+        name: %[2]s
+        key: password
+
+---
+
+apiVersion: aiven.io/v1alpha1
+kind: PostgreSQL
+metadata:
+  name: %[3]s
+spec:
+  authSecretRef:
+    name: aiven-token
+    key: token
+
+  project: %[1]s
+  cloudName: google-europe-west1
+  plan: startup-4
+
+---
+
+apiVersion: aiven.io/v1alpha1
+kind: ServiceIntegration
+metadata:
+  name: %[4]s
+spec:
+  authSecretRef:
+    name: aiven-token
+    key: token
+
+  project: %[1]s
+  integrationType: prometheus
+  sourceServiceName: %[3]s
+  destinationEndpointRef:
+    name: %[2]s
+`, project, endpointName, pgName, siName)
+}
+
+func TestServiceIntegrationEndpoint(t *testing.T) {
+	t.Parallel()
+	defer recoverPanic(t)
+
+	// GIVEN
+	endpointName := randName("integration-endpoint")
+	pgName := randName("integration-endpoint")
+	siName := randName("integration-endpoint")
+	yml := getServiceIntegrationEndpointYaml(testProject, endpointName, pgName, siName)
+	s, err := NewSession(k8sClient, avnClient, testProject, yml)
+	require.NoError(t, err)
+
+	// Cleans test afterwards
+	defer s.Destroy()
+
+	// WHEN
+	// Applies given manifest
+	require.NoError(t, s.Apply())
+
+	// Waits kube objects
+	endpoint := new(v1alpha1.ServiceIntegrationEndpoint)
+	require.NoError(t, s.GetRunning(endpoint, endpointName))
+
+	pg := new(v1alpha1.PostgreSQL)
+	require.NoError(t, s.GetRunning(pg, pgName))
+
+	si := new(v1alpha1.ServiceIntegration)
+	require.NoError(t, s.GetRunning(si, siName))
+
+	// THEN
+	// Validates ServiceIntegrationEndpoint
+	endpointAvn, err := avnClient.ServiceIntegrationEndpoints.Get(testProject, endpoint.Status.ID)
+	require.NoError(t, err)
+	assert.Equal(t, endpointName, endpointAvn.EndpointName)
+	assert.Equal(t, "prometheus", endpointAvn.EndpointType)
+	assert.Equal(t, "prometheus", endpointAvn.UserConfig["basic_auth_username"])
+	assert.NotEmpty(t, endpoint.Status.ConfigHash)
+
+	// Validates ServiceIntegration
+	siAvn, err := avnClient.ServiceIntegrations.Get(testProject, si.Status.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "prometheus", siAvn.IntegrationType)
+	assert.Equal(t, pgName, *siAvn.SourceService)
+	assert.Equal(t, endpoint.Status.ID, *siAvn.DestinationEndpointID)
+
+	// The endpoint is kept while the integration uses it
+	endpointDeleted := make(chan error, 1)
+	go func() {
+		endpointDeleted <- s.Delete(endpoint, func() error {
+			_, err := avnClient.ServiceIntegrationEndpoints.Get(testProject, endpoint.Status.ID)
+			return err
+		})
+	}()
+
+	time.Sleep(retryInterval * 3)
+	_, err = avnClient.ServiceIntegrationEndpoints.Get(testProject, endpoint.Status.ID)
+	assert.NoError(t, err)
+
+	// Deletes the integration, then the endpoint goes
+	assert.NoError(t, s.Delete(si, func() error {
+		_, err := avnClient.ServiceIntegrations.Get(testProject, si.Status.ID)
+		return err
+	}))
+	assert.NoError(t, <-endpointDeleted)
+}
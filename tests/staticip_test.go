@@ -0,0 +1,62 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func getStaticIPYaml(project, name string) string {
+	return fmt.Sprintf(`
+apiVersion: aiven.io/v1alpha1
+kind: StaticIP
+metadata:
+  name: %[2]s
+spec:
+  authSecretRef:
+    name: aiven-token
+    key: token
+
+  project: %[1]s
+  cloudName: google-europe-west1
+`, project, name)
+}
+
+func TestStaticIP(t *testing.T) {
+	t.Parallel()
+	defer recoverPanic(t)
+
+	// GIVEN
+	name := randName("static-ip")
+	yml := getStaticIPYaml(testProject, name)
+	s, err := NewSession(k8sClient, avnClient, testProject, yml)
+	require.NoError(t, err)
+
+	// Cleans test afterwards
+	defer s.Destroy()
+
+	// WHEN
+	// Applies given manifest
+	require.NoError(t, s.Apply())
+
+	// Waits kube object
+	ip := new(v1alpha1.StaticIP)
+	require.NoError(t, s.GetRunning(ip, name))
+
+	// THEN
+	ipAvn, err := avnClient.StaticIPs.Get(testProject, ip.Status.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "google-europe-west1", ipAvn.CloudName)
+	assert.Equal(t, ipAvn.IPAddress, ip.Status.IPAddress)
+	assert.Equal(t, ipAvn.State, ip.Status.State)
+	assert.Empty(t, ip.Status.ServiceName)
+
+	assert.NoError(t, s.Delete(ip, func() error {
+		_, err = avnClient.StaticIPs.Get(testProject, ip.Status.ID)
+		return err
+	}))
+}
@@ -118,12 +118,18 @@ func setupSuite() error {
 		return err
 	}
 
-	err = controllers.SetupControllers(mgr, aivenToken)
+	err = controllers.SetupControllers(mgr, aivenToken, controllers.ServiceTagsConfig{})
 	if err != nil {
 		return fmt.Errorf("unable to setup controllers: %w", err)
 	}
 
-	err = v1alpha1.SetupWebhooks(mgr)
+	catalog := controllers.NewServiceCatalog(mgr, aivenToken)
+	err = mgr.Add(catalog)
+	if err != nil {
+		return fmt.Errorf("unable to add service catalog: %w", err)
+	}
+
+	err = v1alpha1.SetupWebhooks(mgr, catalog)
 	if err != nil {
 		return fmt.Errorf("unable to setup webhooks: %w", err)
 	}
@@ -0,0 +1,100 @@
+package tests
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aiven/aiven-operator/api/v1alpha1"
+)
+
+func getVPCPeeringConnectionYaml(project, vpcName, peeringName, peerAccount, peerVPC, peerRegion string) string {
+	return fmt.Sprintf(`
+apiVersion: aiven.io/v1alpha1
+kind: ProjectVPC
+metadata:
+  name: %[2]s
+spec:
+  authSecretRef:
+    name: aiven-token
+    key: token
+
+  project: %[1]s
+  cloudName: aws-%[6]s
+  networkCidr: 10.0.1.0/24
+
+---
+
+apiVersion: aiven.io/v1alpha1
+kind: VPCPeeringConnection
+metadata:
+  name: %[3]s
+spec:
+  authSecretRef:
+    name: aiven-token
+    key: token
+
+  project: %[1]s
+  projectVPCRef:
+    name: %[2]s
+
+  peerCloudAccount: "%[4]s"
+  peerVPC: %[5]s
+  peerRegion: %[6]s
+`, project, vpcName, peeringName, peerAccount, peerVPC, peerRegion)
+}
+
+func TestVPCPeeringConnection(t *testing.T) {
+	t.Parallel()
+	defer recoverPanic(t)
+
+	peerAccount := os.Getenv("AWS_PEER_ACCOUNT_ID")
+	peerVPC := os.Getenv("AWS_PEER_VPC_ID")
+	peerRegion := os.Getenv("AWS_PEER_REGION")
+	if peerAccount == "" || peerVPC == "" || peerRegion == "" {
+		t.Skip("Provide AWS_PEER_ACCOUNT_ID, AWS_PEER_VPC_ID and AWS_PEER_REGION for this test")
+	}
+
+	// GIVEN
+	vpcName := randName("vpc-peering")
+	peeringName := randName("vpc-peering")
+	yml := getVPCPeeringConnectionYaml(testProject, vpcName, peeringName, peerAccount, peerVPC, peerRegion)
+	s, err := NewSession(k8sClient, avnClient, testProject, yml)
+	require.NoError(t, err)
+
+	// Cleans test afterwards
+	defer s.Destroy()
+
+	// WHEN
+	// Applies given manifest
+	require.NoError(t, s.Apply())
+
+	// Waits kube objects
+	vpc := new(v1alpha1.ProjectVPC)
+	require.NoError(t, s.GetRunning(vpc, vpcName))
+
+	peering := new(v1alpha1.VPCPeeringConnection)
+	require.NoError(t, s.GetRunning(peering, peeringName))
+
+	// THEN
+	getPeering := func() error {
+		_, err := avnClient.VPCPeeringConnections.GetVPCPeering(testProject, vpc.Status.ID, peerAccount, peerVPC, &peerRegion)
+		return err
+	}
+
+	peeringAvn, err := avnClient.VPCPeeringConnections.GetVPCPeering(testProject, vpc.Status.ID, peerAccount, peerVPC, &peerRegion)
+	require.NoError(t, err)
+	assert.Equal(t, vpc.Status.ID, peering.Status.ProjectVPCID)
+	assert.Equal(t, peeringAvn.State, peering.Status.State)
+	assert.Contains(t, []string{"ACTIVE", "PENDING_PEER"}, peering.Status.State)
+	if peering.Status.State == "PENDING_PEER" {
+		// AWS waits for the peer to accept the connection
+		assert.NotEmpty(t, peering.Status.AWSVPCPeeringConnectionID)
+	}
+
+	// Validates the controller deletes the connection, not the VPC
+	assert.NoError(t, s.Delete(peering, getPeering))
+}